@@ -0,0 +1,94 @@
+package v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/acceptance/clients"
+	"github.com/huaweicloud/golangsdk/acceptance/tools"
+	"github.com/huaweicloud/golangsdk/openstack/compute/v2/servers"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+func TestServersCRUD(t *testing.T) {
+	imageID := os.Getenv("OS_IMAGE_ID")
+	flavorID := os.Getenv("OS_FLAVOR_ID")
+	networkID := os.Getenv("OS_NETWORK_ID")
+	if imageID == "" || flavorID == "" || networkID == "" {
+		t.Skip("OS_IMAGE_ID, OS_FLAVOR_ID and OS_NETWORK_ID must be set to create servers")
+	}
+
+	client, err := clients.NewComputeV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a compute client: %v", err)
+	}
+	t.Cleanup(func() { tools.CheckLeaks(t, "server", listServerNames(client)) })
+	cleaner := tools.NewCleaner(t)
+
+	serverName := tools.RandomName("server")
+
+	t.Logf("Attempting to create server: %s", serverName)
+
+	server, err := servers.Create(client, servers.CreateOpts{
+		Name:      serverName,
+		ImageRef:  imageID,
+		FlavorRef: flavorID,
+		Networks:  []servers.Network{{UUID: networkID}},
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to create server: %v", err)
+	}
+	cleaner.Add("server "+server.ID, func() error {
+		if err := servers.Delete(client, server.ID).ExtractErr(); err != nil {
+			return err
+		}
+		return waitForServerToDelete(client, server.ID, 300)
+	})
+
+	if err := servers.WaitForStatus(client, server.ID, "ACTIVE", 300); err != nil {
+		t.Fatalf("Server is not active: %v", err)
+	}
+	t.Logf("Created server: %s", server.ID)
+
+	newName := tools.RandomName("server")
+	_, err = servers.Update(client, server.ID, servers.UpdateOpts{Name: newName}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to update server: %v", err)
+	}
+
+	newServer, err := servers.Get(client, server.ID).Extract()
+	if err != nil {
+		t.Fatalf("Unable to retrieve server: %v", err)
+	}
+	tools.PrintResource(t, newServer)
+}
+
+func waitForServerToDelete(client *golangsdk.ServiceClient, id string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := servers.Get(client, id).Extract()
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// listServerNames returns a lister of the names of the servers for
+// CheckLeaks.
+func listServerNames(client *golangsdk.ServiceClient) func() ([]string, error) {
+	return func() ([]string, error) {
+		var names []string
+		err := servers.List(client, servers.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+			allServers, err := servers.ExtractServers(page)
+			if err != nil {
+				return false, err
+			}
+			for _, server := range allServers {
+				names = append(names, server.Name)
+			}
+			return true, nil
+		})
+		return names, err
+	}
+}
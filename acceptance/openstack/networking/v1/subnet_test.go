@@ -8,7 +8,6 @@ import (
 	"github.com/huaweicloud/golangsdk/acceptance/clients"
 	"github.com/huaweicloud/golangsdk/acceptance/tools"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v1/subnets"
-	"github.com/huaweicloud/golangsdk/openstack/networking/v1/vpcs"
 )
 
 func TestSubnetList(t *testing.T) {
@@ -27,13 +26,13 @@ func TestSubnetsCRUD(t *testing.T) {
 		t.Fatalf("Unable to create a subnet : %v", err)
 	}
 
+	cleaner := tools.NewCleaner(t)
+
 	// Create a subnet
-	subnet, err := createSubnetNResources(t, client)
+	subnet, err := createSubnetNResources(t, client, cleaner)
 	if err != nil {
 		t.Fatalf("Unable to create subnet: %v", err)
 	}
-	// Delete a subnet
-	defer deleteSubnetNResources(t, client, subnet.VPC_ID, subnet.ID)
 
 	tools.PrintResource(t, subnet)
 
@@ -44,7 +43,7 @@ func TestSubnetsCRUD(t *testing.T) {
 	}
 
 	// Update a subnet
-	newName := tools.RandomString("ACPTTEST-", 8)
+	newName := tools.RandomName("subnet")
 	updateOpts := &subnets.UpdateOpts{
 		Name: newName,
 	}
@@ -63,24 +62,15 @@ func TestSubnetsCRUD(t *testing.T) {
 	tools.PrintResource(t, newSubnet)
 }
 
-func createSubnetNResources(t *testing.T, client *golangsdk.ServiceClient) (*subnets.Subnet, error) {
-
-	vpcName := tools.RandomString("TESTACC-", 8)
-
-	createOpts := vpcs.CreateOpts{
-		Name: vpcName,
-		CIDR: "192.168.20.0/24",
-	}
-
-	t.Logf("Attempting to create vpc: %s", vpcName)
-
-	vpc, err := vpcs.Create(client, createOpts).Extract()
+// createSubnetNResources creates a subnet and its vpc, which are deleted by
+// cleaner.
+func createSubnetNResources(t *testing.T, client *golangsdk.ServiceClient, cleaner *tools.Cleaner) (*subnets.Subnet, error) {
+	vpc, err := createVpc(t, client, cleaner)
 	if err != nil {
 		return nil, err
 	}
-	t.Logf("Created vpc: %s", vpcName)
 
-	subnetName := tools.RandomString("ACPTTEST-", 8)
+	subnetName := tools.RandomName("subnet")
 
 	createSubnetOpts := subnets.CreateOpts{
 		Name:       subnetName,
@@ -98,31 +88,14 @@ func createSubnetNResources(t *testing.T, client *golangsdk.ServiceClient) (*sub
 	}
 	t.Logf("Created subnet: %v", subnet)
 
-	return subnet, nil
-}
-
-func deleteSubnetNResources(t *testing.T, client *golangsdk.ServiceClient, vpcID string, id string) {
-	t.Logf("Attempting to delete subnet: %s", id)
-
-	err := subnets.Delete(client, vpcID, id).ExtractErr()
-	if err != nil {
-		t.Fatalf("Error deleting subnet: %v", err)
-	}
-
-	t.Logf("waitting for subnet %s to delete", id)
-	if err := waitForSubnetToDelete(client, id, 60); err != nil {
-		t.Fatalf("Error deleting subnet: %v", err)
-	}
-
-	t.Logf("Deleted subnet: %s", id)
-	t.Logf("Attempting to delete vpc: %s", vpcID)
-
-	err = vpcs.Delete(client, vpcID).ExtractErr()
-	if err != nil {
-		t.Fatalf("Error deleting vpc: %v", err)
-	}
+	cleaner.Add("subnet "+subnet.ID, func() error {
+		if err := subnets.Delete(client, vpc.ID, subnet.ID).ExtractErr(); err != nil {
+			return err
+		}
+		return waitForSubnetToDelete(client, subnet.ID, 60)
+	})
 
-	t.Logf("Deleted vpc: %s", vpcID)
+	return subnet, nil
 }
 
 func waitForSubnetToDelete(client *golangsdk.ServiceClient, subnetID string, secs int) error {
@@ -30,17 +30,18 @@ func TestVpcsCRUD(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Unable to create a vpc client: %v", err)
 	}
+	t.Cleanup(func() { tools.CheckLeaks(t, "vpc", listVpcNames(client)) })
+	cleaner := tools.NewCleaner(t)
 
 	// Create a vpc
-	vpc, err := createVpc(t, client)
+	vpc, err := createVpc(t, client, cleaner)
 	if err != nil {
 		t.Fatalf("Unable to create create: %v", err)
 	}
-	defer deleteVpc(t, client, vpc.ID)
 
 	tools.PrintResource(t, vpc)
 
-	newName := tools.RandomString("TESTACC-", 8)
+	newName := tools.RandomName("vpc")
 	updateOpts := &vpcs.UpdateOpts{
 		Name: newName,
 	}
@@ -58,9 +59,10 @@ func TestVpcsCRUD(t *testing.T) {
 	tools.PrintResource(t, newVpc)
 }
 
-func createVpc(t *testing.T, client *golangsdk.ServiceClient) (*vpcs.Vpc, error) {
+// createVpc creates a vpc, which is deleted by cleaner.
+func createVpc(t *testing.T, client *golangsdk.ServiceClient, cleaner *tools.Cleaner) (*vpcs.Vpc, error) {
 
-	vpcName := tools.RandomString("TESTACC-", 8)
+	vpcName := tools.RandomName("vpc")
 
 	createOpts := vpcs.CreateOpts{
 		Name: vpcName,
@@ -75,16 +77,24 @@ func createVpc(t *testing.T, client *golangsdk.ServiceClient) (*vpcs.Vpc, error)
 	}
 	t.Logf("Created vpc: %s", vpcName)
 
+	cleaner.Add("vpc "+vpc.ID, func() error {
+		return vpcs.Delete(client, vpc.ID).ExtractErr()
+	})
+
 	return vpc, nil
 }
 
-func deleteVpc(t *testing.T, client *golangsdk.ServiceClient, vpcID string) {
-	t.Logf("Attempting to delete vpc: %s", vpcID)
-
-	err := vpcs.Delete(client, vpcID).ExtractErr()
-	if err != nil {
-		t.Fatalf("Error deleting vpc: %v", err)
+// listVpcNames returns a lister of the names of the vpcs for CheckLeaks.
+func listVpcNames(client *golangsdk.ServiceClient) func() ([]string, error) {
+	return func() ([]string, error) {
+		allVpcs, err := vpcs.List(client, vpcs.ListOpts{})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(allVpcs))
+		for i, vpc := range allVpcs {
+			names[i] = vpc.Name
+		}
+		return names, nil
 	}
-
-	t.Logf("Deleted vpc: %s", vpcID)
 }
@@ -0,0 +1,133 @@
+package lbaas_v2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/acceptance/tools"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// lbTimeout is the longest time to wait for a load balancer to be ACTIVE.
+const lbTimeout = 5 * time.Minute
+
+// CreateLoadBalancer creates a load balancer on subnetID and waits for it to
+// be ACTIVE. It is deleted by cleaner.
+func CreateLoadBalancer(t *testing.T, client *golangsdk.ServiceClient, cleaner *tools.Cleaner, subnetID string) (*loadbalancers.LoadBalancer, error) {
+	lbName := tools.RandomName("lb")
+
+	t.Logf("Attempting to create load balancer: %s", lbName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), lbTimeout)
+	defer cancel()
+	lb, err := loadbalancers.Create(client, loadbalancers.CreateOpts{
+		Name:        lbName,
+		VipSubnetID: subnetID,
+	}).Operation(client).Wait(ctx)
+	if lb != nil {
+		cleaner.Add("load balancer "+lb.ID, func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), lbTimeout)
+			defer cancel()
+			_, err := loadbalancers.DeleteOperation(client, lb.ID).Wait(ctx)
+			return err
+		})
+	}
+	if err != nil {
+		return lb, err
+	}
+
+	t.Logf("Created load balancer: %s", lb.ID)
+
+	return lb, nil
+}
+
+// CreatePool creates an HTTP pool on a load balancer. It is deleted by
+// cleaner.
+func CreatePool(t *testing.T, client *golangsdk.ServiceClient, cleaner *tools.Cleaner, lbID string) (*pools.Pool, error) {
+	poolName := tools.RandomName("pool")
+
+	t.Logf("Attempting to create pool: %s", poolName)
+
+	pool, err := pools.Create(client, pools.CreateOpts{
+		Name:           poolName,
+		LBMethod:       pools.LBMethodRoundRobin,
+		Protocol:       pools.ProtocolHTTP,
+		LoadbalancerID: lbID,
+	}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	cleaner.Add("pool "+pool.ID, func() error {
+		if err := pools.Delete(client, pool.ID).ExtractErr(); err != nil {
+			return err
+		}
+		return WaitForLoadBalancer(client, lbID)
+	})
+
+	t.Logf("Created pool: %s", pool.ID)
+
+	return pool, WaitForLoadBalancer(client, lbID)
+}
+
+// CreateMember adds a member on subnetID to a pool of a load balancer. It is
+// deleted by cleaner.
+func CreateMember(t *testing.T, client *golangsdk.ServiceClient, cleaner *tools.Cleaner, lbID, poolID, subnetID string) (*pools.Member, error) {
+	memberName := tools.RandomName("member")
+
+	t.Logf("Attempting to create member: %s", memberName)
+
+	member, err := pools.CreateMember(client, poolID, pools.CreateMemberOpts{
+		Name:         memberName,
+		Address:      "192.168.0.10",
+		ProtocolPort: 80,
+		SubnetID:     subnetID,
+	}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	cleaner.Add("member "+member.ID, func() error {
+		if err := pools.DeleteMember(client, poolID, member.ID).ExtractErr(); err != nil {
+			return err
+		}
+		return WaitForLoadBalancer(client, lbID)
+	})
+
+	t.Logf("Created member: %s", member.ID)
+
+	return member, WaitForLoadBalancer(client, lbID)
+}
+
+// WaitForLoadBalancer waits for a load balancer to be ACTIVE again after a
+// change of its listeners, pools or members.
+func WaitForLoadBalancer(client *golangsdk.ServiceClient, lbID string) error {
+	return golangsdk.WaitFor(int(lbTimeout/time.Second), func() (bool, error) {
+		lb, err := loadbalancers.Get(client, lbID).Extract()
+		if err != nil {
+			return false, err
+		}
+		switch loadbalancers.ProvisioningStatus(lb.ProvisioningStatus) {
+		case loadbalancers.StatusActive:
+			return true, nil
+		case loadbalancers.StatusError:
+			return false, fmt.Errorf("Load balancer %s went into ERROR provisioning status", lbID)
+		}
+		return false, nil
+	})
+}
+
+// ListLoadBalancerNames returns a lister of the names of the load balancers
+// for CheckLeaks.
+func ListLoadBalancerNames(client *golangsdk.ServiceClient) func() ([]string, error) {
+	return func() ([]string, error) {
+		var names []string
+		err := loadbalancers.EachLB(client, loadbalancers.ListOpts{}, func(lb loadbalancers.LoadBalancer) error {
+			names = append(names, lb.Name)
+			return nil
+		})
+		return names, err
+	}
+}
@@ -0,0 +1,88 @@
+package lbaas_v2
+
+import (
+	"os"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/acceptance/clients"
+	"github.com/huaweicloud/golangsdk/acceptance/tools"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func TestLoadBalancersCRUD(t *testing.T) {
+	subnetID := os.Getenv("OS_SUBNET_ID")
+	if subnetID == "" {
+		t.Skip("OS_SUBNET_ID must be set to create load balancers")
+	}
+
+	client, err := clients.NewNetworkV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a network client: %v", err)
+	}
+	t.Cleanup(func() { tools.CheckLeaks(t, "load balancer", ListLoadBalancerNames(client)) })
+	cleaner := tools.NewCleaner(t)
+
+	lb, err := CreateLoadBalancer(t, client, cleaner, subnetID)
+	if err != nil {
+		t.Fatalf("Unable to create load balancer: %v", err)
+	}
+	tools.PrintResource(t, lb)
+
+	newName := tools.RandomName("lb")
+	_, err = loadbalancers.Update(client, lb.ID, loadbalancers.UpdateOpts{Name: newName}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to update load balancer: %v", err)
+	}
+	if err := WaitForLoadBalancer(client, lb.ID); err != nil {
+		t.Fatalf("Load balancer is not active: %v", err)
+	}
+
+	newLB, err := loadbalancers.Get(client, lb.ID).Extract()
+	if err != nil {
+		t.Fatalf("Unable to retrieve load balancer: %v", err)
+	}
+	tools.PrintResource(t, newLB)
+}
+
+func TestMembersCRUD(t *testing.T) {
+	subnetID := os.Getenv("OS_SUBNET_ID")
+	if subnetID == "" {
+		t.Skip("OS_SUBNET_ID must be set to create load balancers")
+	}
+
+	client, err := clients.NewNetworkV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a network client: %v", err)
+	}
+	cleaner := tools.NewCleaner(t)
+
+	lb, err := CreateLoadBalancer(t, client, cleaner, subnetID)
+	if err != nil {
+		t.Fatalf("Unable to create load balancer: %v", err)
+	}
+	pool, err := CreatePool(t, client, cleaner, lb.ID)
+	if err != nil {
+		t.Fatalf("Unable to create pool: %v", err)
+	}
+	member, err := CreateMember(t, client, cleaner, lb.ID, pool.ID, subnetID)
+	if err != nil {
+		t.Fatalf("Unable to create member: %v", err)
+	}
+	tools.PrintResource(t, member)
+
+	newName := tools.RandomName("member")
+	_, err = pools.UpdateMember(client, pool.ID, member.ID, pools.UpdateMemberOpts{Name: newName}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to update member: %v", err)
+	}
+	if err := WaitForLoadBalancer(client, lb.ID); err != nil {
+		t.Fatalf("Load balancer is not active: %v", err)
+	}
+
+	newMember, err := pools.GetMember(client, pool.ID, member.ID).Extract()
+	if err != nil {
+		t.Fatalf("Unable to retrieve member: %v", err)
+	}
+	tools.PrintResource(t, newMember)
+}
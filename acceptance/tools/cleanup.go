@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// ResourcePrefix is the prefix of the names of the resources created by the
+// acceptance tests. It allows to recognize leaked resources in a shared
+// account.
+const ResourcePrefix = "TESTACC-"
+
+// RandomName returns a random resource name made of ResourcePrefix and kind,
+// e.g. "TESTACC-vpc-Hx8aTz0q".
+func RandomName(kind string) string {
+	return RandomString(fmt.Sprintf("%s%s-", ResourcePrefix, kind), 8)
+}
+
+// Cleaner tracks the resources created by a test and deletes them when the
+// test completes, even if it fails or panics.
+type Cleaner struct {
+	t     *testing.T
+	mut   sync.Mutex
+	tasks []cleanupTask
+}
+
+type cleanupTask struct {
+	description string
+	fn          func() error
+}
+
+// NewCleaner returns a Cleaner which runs when t completes.
+func NewCleaner(t *testing.T) *Cleaner {
+	c := &Cleaner{t: t}
+	t.Cleanup(c.Run)
+	return c
+}
+
+// Add registers fn to delete the resource described by description. The
+// resources are deleted in the reverse order of their registration, so that
+// a resource is deleted before the resources it depends on.
+func (c *Cleaner) Add(description string, fn func() error) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.tasks = append(c.tasks, cleanupTask{description: description, fn: fn})
+}
+
+// Run deletes the registered resources. Failures are reported as test
+// errors, and don't stop the deletion of the other resources. Run may be
+// called before the test completes; each resource is only deleted once.
+func (c *Cleaner) Run() {
+	c.mut.Lock()
+	tasks := c.tasks
+	c.tasks = nil
+	c.mut.Unlock()
+
+	for i := len(tasks) - 1; i >= 0; i-- {
+		task := tasks[i]
+		if err := runCleanupTask(task); err != nil {
+			c.t.Errorf("Unable to delete %s: %v", task.description, err)
+			continue
+		}
+		c.t.Logf("Deleted %s", task.description)
+	}
+}
+
+func runCleanupTask(task cleanupTask) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return task.fn()
+}
+
+// FindLeaks returns the names starting with ResourcePrefix.
+func FindLeaks(names []string) []string {
+	var leaks []string
+	for _, name := range names {
+		if strings.HasPrefix(name, ResourcePrefix) {
+			leaks = append(leaks, name)
+		}
+	}
+	return leaks
+}
+
+// CheckLeaks lists the names of the resources of the given kind and reports a
+// test error for each of them which was created by an acceptance test and
+// not deleted.
+func CheckLeaks(t *testing.T, kind string, list func() ([]string, error)) {
+	names, err := list()
+	if err != nil {
+		t.Errorf("Unable to list %s resources: %v", kind, err)
+		return
+	}
+	for _, name := range FindLeaks(names) {
+		t.Errorf("Leaked %s resource: %s", kind, name)
+	}
+}
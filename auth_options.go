@@ -45,6 +45,12 @@ type AuthOptions struct {
 
 	Password string `json:"password,omitempty"`
 
+	// APIKey allows users to authenticate with a Username and an API key
+	// instead of a password, through the RAX-KSKEY:apiKeyCredentials
+	// extension of the Identity V2 API. It is mutually exclusive with
+	// Password, and it is not accepted by the Identity V3 API.
+	APIKey string `json:"-"`
+
 	// At most one of DomainID and DomainName must be provided if using Username
 	// with Identity V3. Otherwise, either are optional.
 	DomainID   string `json:"-"`
@@ -98,7 +104,18 @@ func (opts AuthOptions) ToTokenV2CreateMap() (map[string]interface{}, error) {
 	// Populate the request map.
 	authMap := make(map[string]interface{})
 
-	if opts.Username != "" {
+	if opts.APIKey != "" {
+		if opts.Password != "" {
+			return nil, ErrAPIKeyWithPassword{}
+		}
+		if opts.Username == "" {
+			return nil, ErrMissingInput{Argument: "Username"}
+		}
+		authMap["RAX-KSKEY:apiKeyCredentials"] = map[string]interface{}{
+			"username": opts.Username,
+			"apiKey":   opts.APIKey,
+		}
+	} else if opts.Username != "" {
 		if opts.Password != "" {
 			authMap["passwordCredentials"] = map[string]interface{}{
 				"username": opts.Username,
@@ -170,6 +187,10 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	// if insufficient or incompatible information is present.
 	var req request
 
+	if opts.APIKey != "" {
+		return nil, ErrAPIKeyProvided{}
+	}
+
 	if opts.Password == "" {
 		if opts.TokenID != "" {
 			// Because we aren't using password authentication, it's an error to also provide any of the user-based authentication
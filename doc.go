@@ -119,5 +119,22 @@ An example retry backoff function, which respects the 429 HTTP response code:
 		return nil
 	}
 
+The responses to the GET requests of a service client can be cached, e.g. for
+dashboards listing resources which rarely change. The cached responses are
+dropped once the TTL has elapsed, or as soon as a request of the client
+modifies a resource:
+
+	computeClient.Cache = golangsdk.NewMemoryResponseCache()
+	computeClient.CacheTTL = 5 * time.Minute
+
+A request ID of the client can be sent along with a call, to find it in the
+logs of the services and of the application. It is reported to the
+Instrumentation and in the errors of the failed requests:
+
+	err := listeners.Delete(networkClient.WithRequestID(requestID), listenerID).ExtractErr()
+	if err, ok := err.(golangsdk.ErrUnexpectedResponseCode); ok {
+		log.Printf("request %s failed: %s", err.ClientRequestID, err)
+	}
+
 */
 package golangsdk
@@ -1,8 +1,12 @@
 package golangsdk
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // BaseError is an error type that all other error types embed.
@@ -50,11 +54,112 @@ func (e ErrInvalidInput) Error() string {
 // those listed in OkCodes is encountered.
 type ErrUnexpectedResponseCode struct {
 	BaseError
-	URL      string
-	Method   string
-	Expected []int
-	Actual   int
-	Body     []byte
+	URL            string
+	Method         string
+	Expected       []int
+	Actual         int
+	Body           []byte
+	ResponseHeader http.Header
+	// ClientRequestID is the request ID sent by the client, see RequestOpts.RequestID.
+	ClientRequestID string
+	// APIError is the error decoded from Body, or nil if the body isn't a known error format.
+	APIError *APIError
+}
+
+// APIError is the error reported by a service in the body of a failed request, e.g. the reason why
+// a load balancer can't be deleted. Services have different formats for it, such as
+// {"error_code": ..., "error_msg": ...}, {"NeutronError": {"type": ..., "message": ...}} or
+// {"conflictingRequest": {"code": ..., "message": ...}}; APIError gives them the same fields.
+type APIError struct {
+	Code    string
+	Message string
+	Details string
+}
+
+// APIErrorFrom returns the APIError of a failed request. Errors embedding ErrUnexpectedResponseCode,
+// such as ErrDefault404, have one too. It returns nil for other errors, or if the body has no
+// known error format:
+//
+//	err := loadbalancers.Delete(client, id).ExtractErr()
+//	if apiErr := golangsdk.APIErrorFrom(err); apiErr != nil {
+//		log.Printf("cannot delete load balancer %s: %s", id, apiErr.Message)
+//	}
+func APIErrorFrom(err error) *APIError {
+	if e, ok := err.(interface{ apiError() *APIError }); ok {
+		return e.apiError()
+	}
+	return nil
+}
+
+func (e ErrUnexpectedResponseCode) apiError() *APIError {
+	return e.APIError
+}
+
+// parseAPIError decodes the error in a response body, if any.
+func parseAPIError(body []byte) *APIError {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil
+	}
+	if e := apiErrorFromFields(fields); e != nil {
+		return e
+	}
+
+	// The error may be wrapped in an object, e.g. {"badRequest": {...}}, or be a plain string, e.g.
+	// {"error": "..."}.
+	if len(fields) != 1 {
+		return nil
+	}
+	for _, v := range fields {
+		var inner map[string]json.RawMessage
+		if err := json.Unmarshal(v, &inner); err == nil {
+			return apiErrorFromFields(inner)
+		}
+		var message string
+		if err := json.Unmarshal(v, &message); err == nil && message != "" {
+			return &APIError{Message: message}
+		}
+	}
+	return nil
+}
+
+// apiErrorFromFields returns the error described by the fields of a JSON object, or nil if it has
+// no message.
+func apiErrorFromFields(fields map[string]json.RawMessage) *APIError {
+	e := &APIError{
+		Message: firstField(fields, "message", "error_msg", "faultstring"),
+		Code:    firstField(fields, "code", "error_code", "faultcode", "type"),
+		Details: firstField(fields, "details", "detail", "debuginfo"),
+	}
+	if e.Message == "" {
+		return nil
+	}
+	return e
+}
+
+// firstField returns the first of the named fields which is a string or a number.
+func firstField(fields map[string]json.RawMessage, names ...string) string {
+	for _, name := range names {
+		v, ok := fields[name]
+		if !ok {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil && s != "" {
+			return s
+		}
+		var n json.Number
+		if err := json.Unmarshal(v, &n); err == nil {
+			return n.String()
+		}
+	}
+	return ""
+}
+
+// RequestID returns the ID the service gave to the failed request, see
+// RequestIDFromHeader.
+func (e ErrUnexpectedResponseCode) RequestID() string {
+	return RequestIDFromHeader(e.ResponseHeader)
 }
 
 func (e ErrUnexpectedResponseCode) Error() string {
@@ -62,9 +167,71 @@ func (e ErrUnexpectedResponseCode) Error() string {
 		"Expected HTTP response code %v when accessing [%s %s], but got %d instead\n%s",
 		e.Expected, e.Method, e.URL, e.Actual, e.Body,
 	)
+	if e.ClientRequestID != "" {
+		e.DefaultErrString += fmt.Sprintf(" (request ID %s)", e.ClientRequestID)
+	}
 	return e.choseErrString()
 }
 
+// ErrDryRun is the error returned instead of sending a request other than GET or HEAD by a
+// ServiceClient in DryRun mode. It describes the request which would have been sent, without its
+// authentication headers.
+type ErrDryRun struct {
+	BaseError
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+func (e ErrDryRun) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Dry run: [%s %s] was not sent", e.Method, e.URL)
+	return e.choseErrString()
+}
+
+// ErrUnexpectedResponse is returned by the Request method when the body of a successful response
+// can't be decoded as JSON, e.g. because it was truncated or is the HTML page of a proxy.
+type ErrUnexpectedResponse struct {
+	BaseError
+	URL         string
+	Method      string
+	StatusCode  int
+	ContentType string
+	// Snippet is the beginning of the body, up to ResponseSnippetSize bytes.
+	Snippet []byte
+	// Err is the error of the JSON decoder.
+	Err error
+}
+
+func (e ErrUnexpectedResponse) Error() string {
+	e.DefaultErrString = fmt.Sprintf(
+		"Unable to decode the response of [%s %s], status %d, content type %q: %s. Body: %q",
+		e.Method, e.URL, e.StatusCode, e.ContentType, e.Err, e.Snippet,
+	)
+	return e.choseErrString()
+}
+
+// ErrResponseTooLarge is returned by the Request method when the body of a response is larger than
+// the MaxResponseSize of the ProviderClient.
+type ErrResponseTooLarge struct {
+	BaseError
+	URL    string
+	Method string
+	Limit  int64
+}
+
+func (e ErrResponseTooLarge) Error() string {
+	e.DefaultErrString = fmt.Sprintf("The response of [%s %s] is larger than %d bytes", e.Method, e.URL, e.Limit)
+	return e.choseErrString()
+}
+
+// ErrNotModified is the error type returned on a 304 HTTP response code, when the resource of a
+// conditional request still matches the ETag or the time given in its If-None-Match or
+// If-Modified-Since header.
+type ErrNotModified struct {
+	ErrUnexpectedResponseCode
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -95,6 +262,20 @@ type ErrDefault408 struct {
 	ErrUnexpectedResponseCode
 }
 
+// ErrDefault412 is the default error type returned on a 412 HTTP response code, when the resource
+// of a conditional request no longer matches the ETag given in its If-Match header.
+type ErrDefault412 struct {
+	ErrUnexpectedResponseCode
+}
+
+// ErrOverLimit is the error type returned on a 413 HTTP response code, which services such as
+// Compute use to report that a rate or an absolute limit has been exceeded. RetryAfter is the time
+// after which the request may succeed, or the zero time if the response doesn't tell.
+type ErrOverLimit struct {
+	ErrUnexpectedResponseCode
+	RetryAfter time.Time
+}
+
 // ErrDefault429 is the default error type returned on a 429 HTTP response code.
 type ErrDefault429 struct {
 	ErrUnexpectedResponseCode
@@ -110,6 +291,10 @@ type ErrDefault503 struct {
 	ErrUnexpectedResponseCode
 }
 
+func (e ErrNotModified) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Resource not modified: [%s %s]", e.Method, e.URL)
+	return e.choseErrString()
+}
 func (e ErrDefault400) Error() string {
 	e.DefaultErrString = fmt.Sprintf(
 		"Bad request with: [%s %s], error message: %s",
@@ -152,6 +337,24 @@ func (e ErrDefault405) Error() string {
 func (e ErrDefault408) Error() string {
 	return "The server timed out waiting for the request"
 }
+func (e ErrDefault412) Error() string {
+	e.DefaultErrString = fmt.Sprintf(
+		"Precondition failed: [%s %s], error message: %s",
+		e.Method, e.URL, e.Body,
+	)
+	return e.choseErrString()
+}
+func (e ErrOverLimit) Error() string {
+	retry := "unknown"
+	if !e.RetryAfter.IsZero() {
+		retry = e.RetryAfter.Format(time.RFC3339)
+	}
+	e.DefaultErrString = fmt.Sprintf(
+		"Over limit: [%s %s], retry after: %s, error message: %s",
+		e.Method, e.URL, retry, e.Body,
+	)
+	return e.choseErrString()
+}
 func (e ErrDefault429) Error() string {
 	e.DefaultErrString = fmt.Sprintf(
 		"Too many requests: [%s %s], error message: %s",
@@ -207,6 +410,18 @@ type Err408er interface {
 	Error408(ErrUnexpectedResponseCode) error
 }
 
+// Err412er is the interface resource error types implement to override the error message
+// from a 412 error.
+type Err412er interface {
+	Error412(ErrUnexpectedResponseCode) error
+}
+
+// Err413er is the interface resource error types implement to override the error returned
+// on a 413 response.
+type Err413er interface {
+	Error413(ErrOverLimit) error
+}
+
 // Err429er is the interface resource error types implement to override the error message
 // from a 429 error.
 type Err429er interface {
@@ -342,6 +557,13 @@ func (e ErrAPIKeyProvided) Error() string {
 	return unacceptedAttributeErr("APIKey")
 }
 
+// ErrAPIKeyWithPassword indicates that both an APIKey and a Password were provided.
+type ErrAPIKeyWithPassword struct{ BaseError }
+
+func (e ErrAPIKeyWithPassword) Error() string {
+	return "Exactly one of Password and APIKey must be provided"
+}
+
 // ErrTenantIDProvided indicates that a TenantID was provided but can't be used.
 type ErrTenantIDProvided struct{ BaseError }
 
@@ -447,3 +669,40 @@ type ErrScopeEmpty struct{ BaseError }
 func (e ErrScopeEmpty) Error() string {
 	return "You must provide either a Project or Domain in a Scope"
 }
+
+// parseRetryAfter returns the time after which an over limit request may be sent again. It is read
+// from the Retry-After header, either a number of seconds or an HTTP date, or else from the
+// retryAfter attribute of the overLimit fault of the body. It returns the zero time if neither is
+// set.
+func parseRetryAfter(header http.Header, body []byte, now time.Time) time.Time {
+	if v := header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			return now.Add(time.Duration(seconds) * time.Second)
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return t
+		}
+	}
+
+	var faults map[string]struct {
+		RetryAfter json.RawMessage `json:"retryAfter"`
+	}
+	if json.Unmarshal(body, &faults) != nil {
+		return time.Time{}
+	}
+	for _, key := range []string{"overLimit", "overLimitFault"} {
+		raw := faults[key].RetryAfter
+		if len(raw) == 0 {
+			continue
+		}
+		// retryAfter is either a number or a string holding a number.
+		var seconds json.Number
+		if json.Unmarshal(raw, &seconds) != nil {
+			continue
+		}
+		if n, err := seconds.Int64(); err == nil {
+			return now.Add(time.Duration(n) * time.Second)
+		}
+	}
+	return time.Time{}
+}
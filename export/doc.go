@@ -0,0 +1,46 @@
+/*
+Package export encodes the results of the SDK, such as usage records or
+statistics, as CSV or JSON lines, so that they can be fed to billing or
+reporting pipelines without writing a mapper for each of them.
+
+The records are flattened: each exported field is a column named after its
+JSON field, nested structs are prefixed by the name of their parent, and the
+columns keep the order of the fields, so that the output is stable between
+runs.
+
+Example to Export the Usage of All Tenants as CSV
+
+	encoder := export.NewCSVEncoder(os.Stdout)
+
+	err := usage.AllTenants(computeClient, usage.AllTenantsOpts{Detailed: true}).EachPage(func(page pagination.Page) (bool, error) {
+		tenantUsages, err := usage.ExtractAllTenants(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tenantUsage := range tenantUsages {
+			if err := encoder.Encode(tenantUsage.ServerUsages); err != nil {
+				return false, err
+			}
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
+Example to Export Hypervisor Statistics as JSON Lines
+
+	stats, err := hypervisors.GetStatistics(computeClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = export.NewJSONLinesEncoder(os.Stdout).Encode(stats)
+	if err != nil {
+		panic(err)
+	}
+*/
+package export
@@ -0,0 +1,306 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrTypeMismatch is returned when an encoder is given records of another
+// type than its first one, which would not match the columns already written.
+type ErrTypeMismatch struct {
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+func (e ErrTypeMismatch) Error() string {
+	return fmt.Sprintf("export: got a record of type %s after records of type %s", e.Actual, e.Expected)
+}
+
+// column is a flattened field of a record type.
+type column struct {
+	name  string
+	index []int
+}
+
+// Columns returns the names of the columns of the records of the type of v,
+// which is a struct, a pointer to a struct, or a slice of them. The names are
+// those of the JSON fields, or the snake case of the Go fields when they are
+// decoded by hand, and the fields of nested structs are prefixed by the name
+// of their parent and a dot. A nested struct of the type of one of its
+// parents is a single column holding its JSON.
+func Columns(v interface{}) ([]string, error) {
+	t, err := recordType(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	columns := columnsOf(t, nil, "", nil)
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return names, nil
+}
+
+func recordType(t reflect.Type) (reflect.Type, error) {
+	if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("export: records must be structs, got %v", t)
+	}
+	return t, nil
+}
+
+// columnsOf returns the columns of the struct type t. The struct types being
+// flattened are kept in parents, and a field of one of them, as in a tree of
+// self-referential types, is a single column instead of being flattened again.
+func columnsOf(t reflect.Type, index []int, prefix string, parents map[reflect.Type]bool) []column {
+	if parents == nil {
+		parents = make(map[reflect.Type]bool)
+	}
+	parents[t] = true
+	defer delete(parents, t)
+
+	var columns []column
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && ft.Kind() == reflect.Struct {
+			if !parents[ft] {
+				columns = append(columns, columnsOf(ft, fieldIndex, prefix, parents)...)
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		name := fieldName(f)
+		if ft.Kind() == reflect.Struct && ft != timeType && !parents[ft] {
+			columns = append(columns, columnsOf(ft, fieldIndex, prefix+name+".", parents)...)
+			continue
+		}
+		columns = append(columns, column{name: prefix + name, index: fieldIndex})
+	}
+	return columns
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// fieldName returns the JSON name of f, or the snake case of its Go name if
+// it isn't decoded by encoding/json.
+func fieldName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name != "" && name != "-" {
+		return name
+	}
+	var b strings.Builder
+	runes := []rune(f.Name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// fieldValue returns the value of a column of a record, or an invalid value
+// if a pointer on its path is nil.
+func fieldValue(record reflect.Value, index []int) reflect.Value {
+	v := record
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// encoder holds the columns shared by the CSV and JSON lines encoders.
+type encoder struct {
+	t       reflect.Type
+	columns []column
+}
+
+// records returns the records of v, and sets the columns on the first call.
+func (e *encoder) records(v interface{}) ([]reflect.Value, error) {
+	t, err := recordType(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	if e.t == nil {
+		e.t, e.columns = t, columnsOf(t, nil, "", nil)
+	} else if e.t != t {
+		return nil, ErrTypeMismatch{Expected: e.t, Actual: t}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []reflect.Value{rv}, nil
+	}
+	records := make([]reflect.Value, rv.Len())
+	for i := range records {
+		records[i] = rv.Index(i)
+	}
+	return records, nil
+}
+
+// CSVEncoder writes records as CSV, with a header row holding their Columns.
+// Times are formatted as RFC 3339, and slices and maps as JSON.
+type CSVEncoder struct {
+	encoder
+	w *csv.Writer
+}
+
+// NewCSVEncoder returns a CSVEncoder writing to w.
+func NewCSVEncoder(w io.Writer) *CSVEncoder {
+	return &CSVEncoder{w: csv.NewWriter(w)}
+}
+
+// Encode writes a record, or each record of a slice. All the records given to
+// an encoder must be of the same type. The header row is written by the first
+// call.
+func (e *CSVEncoder) Encode(v interface{}) error {
+	header := e.t == nil
+	records, err := e.records(v)
+	if err != nil {
+		return err
+	}
+	if header {
+		names := make([]string, len(e.columns))
+		for i, c := range e.columns {
+			names[i] = c.name
+		}
+		if err := e.w.Write(names); err != nil {
+			return err
+		}
+	}
+
+	row := make([]string, len(e.columns))
+	for _, record := range records {
+		for i, c := range e.columns {
+			if row[i], err = formatCSV(fieldValue(record, c.index)); err != nil {
+				return err
+			}
+		}
+		if err := e.w.Write(row); err != nil {
+			return err
+		}
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func formatCSV(v reflect.Value) (string, error) {
+	if !v.IsValid() {
+		return "", nil
+	}
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return "", nil
+		}
+		return t.Format(time.RFC3339Nano), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	}
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil() {
+		return "", nil
+	}
+	b, err := json.Marshal(v.Interface())
+	return string(b), err
+}
+
+// JSONLinesEncoder writes records as flattened JSON objects, one per line,
+// with the keys in the order of their Columns.
+type JSONLinesEncoder struct {
+	encoder
+	w io.Writer
+}
+
+// NewJSONLinesEncoder returns a JSONLinesEncoder writing to w.
+func NewJSONLinesEncoder(w io.Writer) *JSONLinesEncoder {
+	return &JSONLinesEncoder{w: w}
+}
+
+// Encode writes a record, or each record of a slice. All the records given to
+// an encoder must be of the same type.
+func (e *JSONLinesEncoder) Encode(v interface{}) error {
+	records, err := e.records(v)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		buf.Reset()
+		buf.WriteByte('{')
+		for i, c := range e.columns {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, _ := json.Marshal(c.name)
+			buf.Write(key)
+			buf.WriteByte(':')
+
+			var value interface{}
+			if fv := fieldValue(record, c.index); fv.IsValid() {
+				value = fv.Interface()
+				if t, ok := value.(time.Time); ok && t.IsZero() {
+					value = nil
+				}
+			}
+			b, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			buf.Write(b)
+		}
+		buf.WriteString("}\n")
+		if _, err := e.w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
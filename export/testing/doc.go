@@ -0,0 +1,2 @@
+// export unit tests
+package testing
@@ -0,0 +1,120 @@
+package testing
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/export"
+	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/usage"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+type listener struct {
+	ID   string `json:"id"`
+	Port int    `json:"port"`
+}
+
+type stats struct {
+	Name       string            `json:"name"`
+	Listener   listener          `json:"listener"`
+	Backup     *listener         `json:"backup"`
+	Bytes      uint64            `json:"bytes_in"`
+	Ratio      float64           `json:"ratio"`
+	Tags       []string          `json:"tags"`
+	Metadata   map[string]string `json:"metadata"`
+	UpdatedAt  time.Time         `json:"-"`
+	unexported string
+}
+
+var statsRecords = []stats{
+	{
+		Name:      "web, \"front\"",
+		Listener:  listener{ID: "l1", Port: 80},
+		Bytes:     1024,
+		Ratio:     0.5,
+		Tags:      []string{"a", "b"},
+		UpdatedAt: time.Date(2017, 1, 21, 10, 4, 20, 0, time.UTC),
+	},
+	{
+		Name:     "db",
+		Listener: listener{ID: "l2", Port: 5432},
+		Backup:   &listener{ID: "l3", Port: 5433},
+	},
+}
+
+func TestColumns(t *testing.T) {
+	columns, err := export.Columns([]stats{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{
+		"name", "listener.id", "listener.port", "backup.id", "backup.port",
+		"bytes_in", "ratio", "tags", "metadata", "updated_at",
+	}, columns)
+
+	columns, err = export.Columns(&usage.ServerUsage{})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "ended_at", columns[0])
+
+	_, err = export.Columns("name")
+	if err == nil {
+		t.Error("Expected an error for a record which isn't a struct")
+	}
+}
+
+func TestCSVEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := export.NewCSVEncoder(&buf)
+	th.AssertNoErr(t, encoder.Encode(statsRecords[0]))
+	th.AssertNoErr(t, encoder.Encode(statsRecords[1:]))
+
+	expected := `name,listener.id,listener.port,backup.id,backup.port,bytes_in,ratio,tags,metadata,updated_at
+"web, ""front""",l1,80,,,1024,0.5,"[""a"",""b""]",,2017-01-21T10:04:20Z
+db,l2,5432,l3,5433,0,0,,,
+`
+	th.AssertEquals(t, expected, buf.String())
+
+	err := encoder.Encode(listener{})
+	if _, ok := err.(export.ErrTypeMismatch); !ok {
+		t.Errorf("Expected ErrTypeMismatch, got %v", err)
+	}
+}
+
+func TestJSONLinesEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	th.AssertNoErr(t, export.NewJSONLinesEncoder(&buf).Encode(statsRecords))
+
+	expected := `{"name":"web, \"front\"","listener.id":"l1","listener.port":80,"backup.id":null,"backup.port":null,"bytes_in":1024,"ratio":0.5,"tags":["a","b"],"metadata":null,"updated_at":"2017-01-21T10:04:20Z"}
+{"name":"db","listener.id":"l2","listener.port":5432,"backup.id":"l3","backup.port":5433,"bytes_in":0,"ratio":0,"tags":null,"metadata":null,"updated_at":null}
+`
+	th.AssertEquals(t, expected, buf.String())
+}
+
+func TestEncodeNil(t *testing.T) {
+	var buf bytes.Buffer
+	if err := export.NewCSVEncoder(&buf).Encode(nil); err == nil {
+		t.Error("Expected an error for a nil record")
+	}
+	if err := export.NewJSONLinesEncoder(&buf).Encode(nil); err == nil {
+		t.Error("Expected an error for a nil record")
+	}
+}
+
+type node struct {
+	Name   string `json:"name"`
+	Parent *node  `json:"parent"`
+}
+
+func TestColumnsSelfReferential(t *testing.T) {
+	columns, err := export.Columns(node{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"name", "parent"}, columns)
+
+	var buf bytes.Buffer
+	root := &node{Name: "root"}
+	th.AssertNoErr(t, export.NewCSVEncoder(&buf).Encode([]node{*root, {Name: "leaf", Parent: root}}))
+	expected := `name,parent
+root,
+leaf,"{""name"":""root"",""parent"":null}"
+`
+	th.AssertEquals(t, expected, buf.String())
+}
@@ -0,0 +1,44 @@
+package golangsdk
+
+import "time"
+
+// Instrumentation receives an event before and after each request sent by a
+// ProviderClient or by the ServiceClients built from it. It allows operators
+// to collect metrics, such as request counts, latencies and error rates,
+// without wrapping the HTTP transport.
+//
+// Implementations must be safe for concurrent use. A request which is retried
+// after a re-authentication or a backoff is reported once.
+type Instrumentation interface {
+	// OnRequestStart is called before the request is sent.
+	OnRequestStart(event RequestEvent)
+
+	// OnRequestEnd is called once the request has completed, successfully or
+	// not. StatusCode, Duration and Err are set.
+	OnRequestEnd(event RequestEvent)
+}
+
+// RequestEvent describes a request reported to an Instrumentation.
+type RequestEvent struct {
+	// Service is the type of the ServiceClient which sent the request (e.g.
+	// "compute"). It is empty for requests sent directly by the
+	// ProviderClient, such as authentication requests.
+	Service string
+
+	Method string
+	URL    string
+
+	// RequestID is the request ID sent by the client, if any. See
+	// RequestOpts.RequestID and ContextWithRequestID.
+	RequestID string
+
+	// StatusCode is the HTTP status of the response, or 0 if no response was
+	// received.
+	StatusCode int
+
+	// Duration is the time taken by the request, including retries.
+	Duration time.Duration
+
+	// Err is the error returned by the request, if any.
+	Err error
+}
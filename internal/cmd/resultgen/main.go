@@ -0,0 +1,53 @@
+// Command resultgen generates the result structs of a resource from a sample
+// of the JSON responses of its API. See the internal/resultgen package.
+//
+// Usage:
+//
+//	resultgen -package <package> -type <Type> [-key <key>] [-o <file>] <sample.json>
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/huaweicloud/golangsdk/internal/resultgen"
+)
+
+func main() {
+	var opts resultgen.Options
+	flag.StringVar(&opts.Package, "package", os.Getenv("GOPACKAGE"), "name of the package of the generated file")
+	flag.StringVar(&opts.Type, "type", "", "name of the generated struct, e.g. Server")
+	flag.StringVar(&opts.Key, "key", "", "key wrapping the resource in the sample, e.g. server")
+	output := flag.String("o", "", "file to write, instead of the standard output")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: resultgen -package <package> -type <Type> [-key <key>] [-o <file>] <sample.json>")
+		os.Exit(2)
+	}
+
+	sample, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	opts.Source = filepath.Base(flag.Arg(0))
+
+	src, err := resultgen.Generate(opts, sample)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Package resultgen generates the result structs of a resource from a sample of
+the JSON responses of its API, to start covering a new endpoint with the same
+layout as the hand-written results.
+
+The generated code is meant to be reviewed: field types are inferred from
+the values of the sample, so a value which is null or an empty array in the
+sample is generated as interface{}, and objects with arbitrary keys such as
+metadata are generated as structs unless they are empty in the sample.
+
+It is used through the resultgen command, usually from a go:generate
+directive in the package of the resource:
+
+	//go:generate go run github.com/huaweicloud/golangsdk/internal/cmd/resultgen -package servers -type Server -o server_gen.go testdata/server.json
+
+Example to Generate the Results of a Resource
+
+	sample := []byte(`{"server": {"id": "uuid", "created": "2020-01-01T00:00:00.000000"}}`)
+
+	src, err := resultgen.Generate(resultgen.Options{Package: "servers", Type: "Server"}, sample)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(src))
+*/
+package resultgen
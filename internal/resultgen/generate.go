@@ -0,0 +1,319 @@
+package resultgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+)
+
+// Options configures the code generated from a sample.
+type Options struct {
+	// Package is the name of the package of the generated file.
+	Package string
+
+	// Type is the name of the generated struct, e.g. "Server".
+	Type string
+
+	// Key is the key wrapping the resource in the sample, e.g. "server". If
+	// empty and the sample has a single key holding an object or an array,
+	// that key is used.
+	Key string
+
+	// Source is the name of the sample, mentioned in the generated file.
+	Source string
+}
+
+// Generate returns the gofmt-ed source of the result struct of Type inferred
+// from a JSON response sample, along with the functions extracting it.
+//
+// If the sample holds a single resource, a <Type>Result type with an Extract
+// method is generated. If it holds a list of resources, a <Type>Page type and
+// an Extract<Type>s function are generated instead, and the struct gets the
+// fields of all the resources of the sample.
+//
+// Nested objects get their own struct, named after their parent and their key.
+// Timestamps without time zone, as returned by most OpenStack services, are
+// converted by an UnmarshalJSON method as the hand-written results do.
+func Generate(opts Options, sample []byte) ([]byte, error) {
+	if opts.Package == "" || opts.Type == "" {
+		return nil, fmt.Errorf("Both the package and the type must be set")
+	}
+
+	s, err := parseSample(sample)
+	if err != nil {
+		return nil, err
+	}
+	if s.kind != kindObject {
+		return nil, fmt.Errorf("The sample must be a JSON object")
+	}
+
+	key := opts.Key
+	if key == "" && len(s.keys) == 1 {
+		if f := s.fields[s.keys[0]]; f.kind == kindObject || f.kind == kindArray {
+			key = s.keys[0]
+		}
+	}
+
+	resource := s
+	if key != "" {
+		var ok bool
+		if resource, ok = s.fields[key]; !ok {
+			return nil, fmt.Errorf("The sample has no %q key", key)
+		}
+	}
+
+	list := resource.kind == kindArray
+	if list {
+		if key == "" {
+			return nil, fmt.Errorf("A list must be wrapped in a key")
+		}
+		resource = resource.elem
+		if resource == nil {
+			return nil, fmt.Errorf("The list of the sample is empty")
+		}
+	}
+	if resource.kind != kindObject {
+		return nil, fmt.Errorf("The resource of the sample must be a JSON object")
+	}
+
+	g := &generator{names: map[string]bool{}}
+	g.structType(opts.Type, resource)
+
+	var b bytes.Buffer
+	source := opts.Source
+	if source == "" {
+		source = "a sample"
+	}
+	fmt.Fprintf(&b, "// Code generated by resultgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&b, "package %s\n\n", opts.Package)
+
+	b.WriteString("import (\n")
+	if g.unmarshal {
+		b.WriteString("\"encoding/json\"\n")
+	}
+	if g.time {
+		b.WriteString("\"time\"\n")
+	}
+	b.WriteString("\n\"github.com/huaweicloud/golangsdk\"\n")
+	if list {
+		b.WriteString("\"github.com/huaweicloud/golangsdk/pagination\"\n")
+	}
+	b.WriteString(")\n")
+
+	b.Write(g.types.Bytes())
+
+	if list {
+		writePage(&b, opts.Type, key)
+	} else {
+		writeResult(&b, opts.Type, key)
+	}
+
+	return format.Source(b.Bytes())
+}
+
+type generator struct {
+	types     bytes.Buffer
+	names     map[string]bool
+	time      bool
+	unmarshal bool
+}
+
+// structType writes the struct of an object schema, and the ones of its
+// nested objects, and returns its name.
+func (g *generator) structType(name string, s *schema) string {
+	for base, i := name, 2; g.names[name]; i++ {
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+	g.names[name] = true
+	start := g.types.Len()
+
+	type field struct {
+		name, typ, key string
+		noZ            bool
+	}
+	var fields []field
+	used := map[string]bool{}
+	for _, k := range s.keys {
+		f := field{name: fieldName(k), key: k}
+		for base, i := f.name, 2; used[f.name]; i++ {
+			f.name = fmt.Sprintf("%s%d", base, i)
+		}
+		used[f.name] = true
+		f.typ = g.typeExpr(name+f.name, s.fields[k])
+		f.noZ = s.fields[k].kind == kindTimeNoZ
+		fields = append(fields, f)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "\n// %s was generated from a sample of the API response.\n", name)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	var noZ []field
+	for _, f := range fields {
+		tag := f.key
+		if f.noZ {
+			tag = "-"
+			noZ = append(noZ, f)
+		}
+		fmt.Fprintf(&b, "%s %s `json:%q`\n", f.name, f.typ, tag)
+	}
+	b.WriteString("}\n")
+
+	if len(noZ) > 0 {
+		g.unmarshal = true
+		fmt.Fprintf(&b, "\n// UnmarshalJSON converts the timestamps of a %s.\n", name)
+		fmt.Fprintf(&b, "func (r *%s) UnmarshalJSON(b []byte) error {\n", name)
+		fmt.Fprintf(&b, "type tmp %s\nvar s struct {\ntmp\n", name)
+		for _, f := range noZ {
+			fmt.Fprintf(&b, "%s golangsdk.JSONRFC3339MilliNoZ `json:%q`\n", f.name, f.key)
+		}
+		b.WriteString("}\nerr := json.Unmarshal(b, &s)\nif err != nil {\nreturn err\n}\n")
+		fmt.Fprintf(&b, "*r = %s(s.tmp)\n\n", name)
+		for _, f := range noZ {
+			fmt.Fprintf(&b, "r.%s = time.Time(s.%s)\n", f.name, f.name)
+		}
+		b.WriteString("\nreturn nil\n}\n")
+	}
+
+	// The nested structs were written while computing the field types, so
+	// insert the struct before them.
+	nested := append([]byte(nil), g.types.Bytes()[start:]...)
+	g.types.Truncate(start)
+	g.types.Write(b.Bytes())
+	g.types.Write(nested)
+	return name
+}
+
+// typeExpr returns the Go type of a schema, writing the structs it needs.
+func (g *generator) typeExpr(name string, s *schema) string {
+	switch s.kind {
+	case kindObject:
+		if len(s.keys) == 0 {
+			return "map[string]interface{}"
+		}
+		return g.structType(name, s)
+	case kindArray:
+		if s.elem == nil {
+			return "[]interface{}"
+		}
+		return "[]" + g.typeExpr(singular(name), s.elem)
+	case kindString:
+		return "string"
+	case kindInt:
+		return "int"
+	case kindFloat:
+		return "float64"
+	case kindBool:
+		return "bool"
+	case kindTime, kindTimeNoZ:
+		g.time = true
+		return "time.Time"
+	}
+	return "interface{}"
+}
+
+func writeResult(b *bytes.Buffer, typ, key string) {
+	fmt.Fprintf(b, "\n// %sResult is the result of a request returning a %s.\n", typ, typ)
+	fmt.Fprintf(b, "type %sResult struct {\ngolangsdk.Result\n}\n", typ)
+	fmt.Fprintf(b, "\n// Extract interprets a %sResult as a %s.\n", typ, typ)
+	fmt.Fprintf(b, "func (r %sResult) Extract() (*%s, error) {\n", typ, typ)
+	if key == "" {
+		fmt.Fprintf(b, "var s %s\nerr := r.ExtractInto(&s)\nreturn &s, err\n}\n", typ)
+		return
+	}
+	fmt.Fprintf(b, "var s struct {\n%s *%s `json:%q`\n}\n", typ, typ, key)
+	fmt.Fprintf(b, "err := r.ExtractInto(&s)\nreturn s.%s, err\n}\n", typ)
+}
+
+func writePage(b *bytes.Buffer, typ, key string) {
+	plural := pluralize(typ)
+	fmt.Fprintf(b, "\n// %sPage is a single page of %s results.\n", typ, typ)
+	fmt.Fprintf(b, "type %sPage struct {\npagination.SinglePageBase\n}\n", typ)
+	fmt.Fprintf(b, "\n// IsEmpty determines whether or not a %sPage is empty.\n", typ)
+	fmt.Fprintf(b, "func (r %sPage) IsEmpty() (bool, error) {\n", typ)
+	fmt.Fprintf(b, "s, err := Extract%s(r)\nreturn len(s) == 0, err\n}\n", plural)
+	fmt.Fprintf(b, "\n// Extract%s interprets a page of results as a slice of %s.\n", plural, plural)
+	fmt.Fprintf(b, "func Extract%s(r pagination.Page) ([]%s, error) {\n", plural, typ)
+	fmt.Fprintf(b, "var s struct {\n%s []%s `json:%q`\n}\n", plural, typ, key)
+	fmt.Fprintf(b, "err := (r.(%sPage)).ExtractInto(&s)\nreturn s.%s, err\n}\n", typ, plural)
+}
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]bool{
+	"api": true, "az": true, "cpu": true, "dns": true, "gb": true, "http": true,
+	"https": true, "id": true, "ip": true, "json": true, "mb": true, "os": true,
+	"ram": true, "sla": true, "ssh": true, "tcp": true, "tls": true, "ttl": true,
+	"udp": true, "uri": true, "url": true, "uuid": true, "vcpu": true, "vm": true,
+	"vpc": true,
+}
+
+// fieldName converts a JSON key such as "volume_id", "volumeId" or
+// "os-extended:status" to a Go field name.
+func fieldName(key string) string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+	runes := []rune(key)
+	for i, c := range runes {
+		switch {
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			flush()
+		case unicode.IsUpper(c) && i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])):
+			flush()
+			word = append(word, c)
+		default:
+			word = append(word, c)
+		}
+	}
+	flush()
+
+	var name strings.Builder
+	for _, w := range words {
+		w = strings.ToLower(w)
+		if initialisms[w] {
+			name.WriteString(strings.ToUpper(w))
+			continue
+		}
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		name.WriteString(string(r))
+	}
+	if name.Len() == 0 {
+		return "Field"
+	}
+	if s := name.String(); unicode.IsDigit([]rune(s)[0]) {
+		return "X" + s
+	}
+	return name.String()
+}
+
+// singular returns the singular of a plural type name, used for the elements
+// of arrays.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"):
+		return strings.TrimSuffix(name, "es")
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss"):
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
+
+// pluralize returns the plural of a type name.
+func pluralize(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && !strings.HasSuffix(name, "ey"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	}
+	return name + "s"
+}
@@ -0,0 +1,169 @@
+package resultgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Kinds of the values of a sample, once inferred.
+const (
+	kindObject  = "object"
+	kindArray   = "array"
+	kindString  = "string"
+	kindInt     = "int"
+	kindFloat   = "float"
+	kindBool    = "bool"
+	kindNull    = "null"
+	kindTime    = "time"
+	kindTimeNoZ = "timeNoZ"
+	kindAny     = "any"
+)
+
+// schema is the inferred shape of a JSON value. Objects keep the order in
+// which their keys appear in the sample, so the generated fields do too.
+type schema struct {
+	kind   string
+	keys   []string
+	fields map[string]*schema
+	elem   *schema
+}
+
+// parseSample parses a JSON sample into a schema.
+func parseSample(sample []byte) (*schema, error) {
+	d := json.NewDecoder(bytes.NewReader(sample))
+	d.UseNumber()
+	s, err := parseValue(d)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, fmt.Errorf("Unexpected data after the JSON sample")
+	}
+	return s, nil
+}
+
+func parseValue(d *json.Decoder) (*schema, error) {
+	t, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := t.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			s := &schema{kind: kindObject, fields: map[string]*schema{}}
+			for d.More() {
+				k, err := d.Token()
+				if err != nil {
+					return nil, err
+				}
+				key := k.(string)
+				f, err := parseValue(d)
+				if err != nil {
+					return nil, err
+				}
+				if existing, ok := s.fields[key]; ok {
+					s.fields[key] = merge(existing, f)
+					continue
+				}
+				s.keys = append(s.keys, key)
+				s.fields[key] = f
+			}
+			_, err := d.Token()
+			return s, err
+		case '[':
+			s := &schema{kind: kindArray}
+			for d.More() {
+				e, err := parseValue(d)
+				if err != nil {
+					return nil, err
+				}
+				if s.elem == nil {
+					s.elem = e
+				} else {
+					s.elem = merge(s.elem, e)
+				}
+			}
+			_, err := d.Token()
+			return s, err
+		}
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			return &schema{kind: kindTime}, nil
+		}
+		if _, err := time.Parse(golangsdk.RFC3339MilliNoZ, v); err == nil {
+			return &schema{kind: kindTimeNoZ}, nil
+		}
+		return &schema{kind: kindString}, nil
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return &schema{kind: kindFloat}, nil
+		}
+		return &schema{kind: kindInt}, nil
+	case bool:
+		return &schema{kind: kindBool}, nil
+	case nil:
+		return &schema{kind: kindNull}, nil
+	}
+	return nil, fmt.Errorf("Unexpected JSON token %v", t)
+}
+
+// merge returns the schema of values which are sometimes shaped like a and
+// sometimes like b, e.g. the elements of an array.
+func merge(a, b *schema) *schema {
+	switch {
+	case a.kind == kindNull:
+		return b
+	case b.kind == kindNull:
+		return a
+	case a.kind == b.kind:
+	case isNumber(a.kind) && isNumber(b.kind):
+		return &schema{kind: kindFloat}
+	case isString(a.kind) && isString(b.kind):
+		return &schema{kind: kindString}
+	default:
+		return &schema{kind: kindAny}
+	}
+
+	switch a.kind {
+	case kindObject:
+		s := &schema{kind: kindObject, fields: map[string]*schema{}}
+		for _, k := range a.keys {
+			s.keys = append(s.keys, k)
+			s.fields[k] = a.fields[k]
+		}
+		for _, k := range b.keys {
+			if f, ok := s.fields[k]; ok {
+				s.fields[k] = merge(f, b.fields[k])
+				continue
+			}
+			s.keys = append(s.keys, k)
+			s.fields[k] = b.fields[k]
+		}
+		return s
+	case kindArray:
+		switch {
+		case a.elem == nil:
+			return b
+		case b.elem == nil:
+			return a
+		}
+		return &schema{kind: kindArray, elem: merge(a.elem, b.elem)}
+	}
+	return a
+}
+
+func isNumber(kind string) bool {
+	return kind == kindInt || kind == kindFloat
+}
+
+func isString(kind string) bool {
+	return kind == kindString || kind == kindTime || kind == kindTimeNoZ
+}
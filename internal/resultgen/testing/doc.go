@@ -0,0 +1,2 @@
+// resultgen unit tests
+package testing
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/internal/resultgen"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+const getSample = `
+{
+  "volume": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "volumeType": "SSD",
+    "size": 75,
+    "os-vol-host-attr:host": null,
+    "created_at": "2015-09-17T03:32:29.000000",
+    "attachments": [
+      {"server_id": "83ec2e3b-4321-422b-8706-a84185f52a0a", "device": "/dev/vdc"}
+    ]
+  }
+}
+`
+
+func TestGenerateResult(t *testing.T) {
+	src, err := resultgen.Generate(resultgen.Options{
+		Package: "volumes",
+		Type:    "Volume",
+		Source:  "volume.json",
+	}, []byte(getSample))
+	th.AssertNoErr(t, err)
+
+	actual := string(src)
+	for _, expected := range []string{
+		"// Code generated by resultgen from volume.json. DO NOT EDIT.",
+		"OSVolHostAttrHost interface{}        `json:\"os-vol-host-attr:host\"`",
+		"CreatedAt         time.Time          `json:\"-\"`",
+		"Attachments       []VolumeAttachment `json:\"attachments\"`",
+		"CreatedAt golangsdk.JSONRFC3339MilliNoZ `json:\"created_at\"`",
+		"type VolumeAttachment struct {",
+		"ServerID string `json:\"server_id\"`",
+		"func (r VolumeResult) Extract() (*Volume, error) {",
+		"Volume *Volume `json:\"volume\"`",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("Expected the generated code to contain %q, got:\n%s", expected, actual)
+		}
+	}
+}
+
+func TestGenerateList(t *testing.T) {
+	src, err := resultgen.Generate(resultgen.Options{
+		Package: "policies",
+		Type:    "Policy",
+	}, []byte(`{"policies": [{"id": 1, "ratio": 1}, {"id": 2, "ratio": 0.5, "enabled": true}]}`))
+	th.AssertNoErr(t, err)
+
+	actual := string(src)
+	for _, expected := range []string{
+		"\"github.com/huaweicloud/golangsdk/pagination\"",
+		"Ratio   float64 `json:\"ratio\"`",
+		"Enabled bool    `json:\"enabled\"`",
+		"func ExtractPolicies(r pagination.Page) ([]Policy, error) {",
+		"Policies []Policy `json:\"policies\"`",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("Expected the generated code to contain %q, got:\n%s", expected, actual)
+		}
+	}
+}
+
+func TestGenerateInvalidSample(t *testing.T) {
+	_, err := resultgen.Generate(resultgen.Options{Package: "volumes", Type: "Volume"}, []byte(`[]`))
+	th.AssertEquals(t, true, err != nil)
+
+	_, err = resultgen.Generate(resultgen.Options{Package: "volumes", Type: "Volume", Key: "server"}, []byte(getSample))
+	th.AssertEquals(t, true, err != nil)
+}
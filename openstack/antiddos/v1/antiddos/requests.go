@@ -44,42 +44,47 @@ func Create(client *golangsdk.ServiceClient, floatingIpId string, opts CreateOpt
 		return
 	}
 
-	_, r.Err = client.Post(CreateURL(client, floatingIpId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(CreateURL(client, floatingIpId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func DailyReport(client *golangsdk.ServiceClient, floatingIpId string) (r DailyReportResult) {
 	url := DailyReportURL(client, floatingIpId)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, floatingIpId string) (r DeleteResult) {
 	url := DeleteURL(client, floatingIpId)
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		JSONResponse: &r.Body,
 		OkCodes:      []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, floatingIpId string) (r GetResult) {
 	url := GetURL(client, floatingIpId)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func GetStatus(client *golangsdk.ServiceClient, floatingIpId string) (r GetStatusResult) {
 	url := GetStatusURL(client, floatingIpId)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -108,17 +113,19 @@ func GetTask(client *golangsdk.ServiceClient, opts GetTaskOptsBuilder) (r GetTas
 		url += query
 	}
 
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func ListConfigs(client *golangsdk.ServiceClient) (r ListConfigsResult) {
 	url := ListConfigsURL(client)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -153,9 +160,10 @@ func ListLogs(client *golangsdk.ServiceClient, floatingIpId string, opts ListLog
 		url += query
 	}
 
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -187,9 +195,10 @@ func ListStatus(client *golangsdk.ServiceClient, opts ListStatusOpts) ([]DdosSta
 	}
 	u := ListStatusURL(client) + q.String()
 
-	_, r.Err = client.Get(u, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(u, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allStatus, err := r.Extract()
 	if err != nil {
@@ -271,9 +280,10 @@ func Update(client *golangsdk.ServiceClient, floatingIpId string, opts UpdateOpt
 		return
 	}
 
-	_, r.Err = client.Put(UpdateURL(client, floatingIpId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(UpdateURL(client, floatingIpId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -302,8 +312,9 @@ func WeeklyReport(client *golangsdk.ServiceClient, opts WeeklyReportOptsBuilder)
 		url += query
 	}
 
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -6,8 +6,9 @@ import (
 
 func WarnAlert(client *golangsdk.ServiceClient) (r WarnAlertResult) {
 	url := WarnAlertURL(client)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -29,6 +29,7 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
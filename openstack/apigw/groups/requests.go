@@ -32,9 +32,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -66,21 +67,24 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(groupURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(groupURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will delete the existing group with the provided ID.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(groupURL(client, id), nil)
+	resp, err := client.Delete(groupURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the group with the provided ID. To extract the Group object
 // from the response, call the Extract method on the GetResult.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(groupURL(client, id), &r.Body, nil)
+	resp, err := client.Get(groupURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -32,7 +32,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts CreateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -43,15 +44,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, groupId string, opts Cr
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, groupId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, groupId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain the specified group according to the instanceId and appId.
 func Get(client *golangsdk.ServiceClient, instanceId, groupId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, groupId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, groupId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -100,6 +103,7 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing group.
 func Delete(client *golangsdk.ServiceClient, instanceId, groupId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, groupId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, groupId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -388,7 +388,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts APIOptsBuil
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -399,15 +400,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, appId string, opts APIO
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain the specified API according to the instanceId and API ID.
 func Get(client *golangsdk.ServiceClient, instanceId, apiId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, apiId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, apiId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -472,6 +475,7 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing custom API.
 func Delete(client *golangsdk.ServiceClient, instanceId, apiId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, apiId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, apiId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -36,7 +36,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts AppOptsBuil
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -46,15 +47,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, appId string, opts AppO
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Get is a method to obtain the specified application according to the instanceId and appId.
 func Get(client *golangsdk.ServiceClient, instanceId, appId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, appId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, appId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -129,15 +132,17 @@ func ResetAppSecret(client *golangsdk.ServiceClient, instanceId, appId string,
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resetSecretURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resetSecretURL(client, instanceId, appId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete is a method to delete an existing application.
 func Delete(client *golangsdk.ServiceClient, instanceId, appId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, appId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, appId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -165,22 +170,25 @@ func CreateAppCode(client *golangsdk.ServiceClient, instanceId, appId string,
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(codeURL(client, instanceId, appId), reqBody, &r.Body, nil)
+	resp, err := client.Post(codeURL(client, instanceId, appId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // AutoGenerateAppCode is a method used to automatically create code in a specified application.
 func AutoGenerateAppCode(client *golangsdk.ServiceClient, instanceId, appId string) (r AutoGenerateCodeResult) {
-	_, r.Err = client.Put(codeURL(client, instanceId, appId), nil, &r.Body, nil)
+	resp, err := client.Put(codeURL(client, instanceId, appId), nil, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetAppCode is a method to obtain the specified code of the specified application of the specified instance using
 // instanceId, appId and codeId.
 func GetAppCode(client *golangsdk.ServiceClient, instanceId, appId, codeId string) (r GetCodeResult) {
-	_, r.Err = client.Get(codeResourceURL(client, instanceId, appId, codeId), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(codeResourceURL(client, instanceId, appId, codeId), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -223,6 +231,7 @@ func ListAppCode(client *golangsdk.ServiceClient, instanceId, appId string, opts
 
 //RemoveAppCode is a method to delete an existing code from a specified application.
 func RemoveAppCode(client *golangsdk.ServiceClient, instanceId, appId, codeId string) (r DeleteResult) {
-	_, r.Err = client.Delete(codeResourceURL(client, instanceId, appId, codeId), nil)
+	resp, err := client.Delete(codeResourceURL(client, instanceId, appId, codeId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -57,7 +57,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts CustomAuthO
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -68,15 +69,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, authId string, opts Cus
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, authId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, authId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain an existing custom authorizer.
 func Get(client *golangsdk.ServiceClient, instanceId, authId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, authId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, authId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -129,6 +132,7 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing custom authorizer.
 func Delete(client *golangsdk.ServiceClient, instanceId, authId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, authId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, authId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
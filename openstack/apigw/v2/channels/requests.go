@@ -120,7 +120,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts ChannelOpts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -131,15 +132,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, chanId string, opts Cha
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, chanId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, chanId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain an existing APIG vpc channel by channel ID.
 func Get(client *golangsdk.ServiceClient, instanceId, chanId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, chanId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, chanId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -190,7 +193,8 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing vpc channel.
 func Delete(client *golangsdk.ServiceClient, instanceId, chanId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, chanId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, chanId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -215,7 +219,8 @@ func AddBackendServices(client *golangsdk.ServiceClient, instanceId, chanId stri
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(membersURL(client, instanceId, chanId), reqBody, &r.Body, nil)
+	resp, err := client.Post(membersURL(client, instanceId, chanId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -261,6 +266,7 @@ func ListBackendServices(client *golangsdk.ServiceClient, instanceId, chanId str
 
 // RemoveBackendService is a method to remove an existing backend instance form vpc channel.
 func RemoveBackendService(client *golangsdk.ServiceClient, instanceId, chanId, memberId string) (r RemoveResult) {
-	_, r.Err = client.Delete(memberURL(client, instanceId, chanId, memberId), nil)
+	resp, err := client.Delete(memberURL(client, instanceId, chanId, memberId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -32,7 +32,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts Environment
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId, "envs"), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId, "envs"), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -43,9 +44,10 @@ func Update(client *golangsdk.ServiceClient, instanceId, envId string, opts Envi
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, "envs", envId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, "envs", envId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -90,7 +92,8 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing group.
 func Delete(client *golangsdk.ServiceClient, instanceId, envId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, "envs", envId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, "envs", envId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -126,13 +129,15 @@ func CreateVariable(client *golangsdk.ServiceClient, instanceId string,
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId, "env-variables"), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId, "env-variables"), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetVariable is a method to obtain the specified environment variable according to the instance id and variable id.
 func GetVariable(client *golangsdk.ServiceClient, instanceId, varId string) (r VariableGetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, "env-variables", varId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, "env-variables", varId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -183,6 +188,7 @@ func ListVariables(client *golangsdk.ServiceClient, instanceId string, opts List
 
 // DeleteVariable is a method to delete an existing variable.
 func DeleteVariable(client *golangsdk.ServiceClient, instanceId, varId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, "env-variables", varId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, "env-variables", varId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
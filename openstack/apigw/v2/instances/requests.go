@@ -58,13 +58,15 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain the specified APIG dedicated instance according to the instance Id.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -142,15 +144,17 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete is a method to delete an existing APIG dedicated instance
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -176,9 +180,10 @@ func EnableEgressAccess(client *golangsdk.ServiceClient, id string, opts EgressA
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(egressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(egressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -189,15 +194,17 @@ func UpdateEgressBandwidth(client *golangsdk.ServiceClient, id string, opts Egre
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(egressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(egressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DisableEgressAccess is a method by which to disable the egress access of an existing APIG dedicated instance.
 func DisableEgressAccess(client *golangsdk.ServiceClient, id string) (r DisableEgressResult) {
-	_, r.Err = client.Delete(egressURL(client, id), nil)
+	resp, err := client.Delete(egressURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -223,16 +230,18 @@ func EnableIngressAccess(client *golangsdk.ServiceClient, id string, opts Ingres
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(ingressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(ingressURL(client, id), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DisableIngressAccess is a method to unbind the eip associated with an existing APIG dedicated instance.
 func DisableIngressAccess(client *golangsdk.ServiceClient, id string) (r DisableIngressResult) {
-	_, r.Err = client.Delete(ingressURL(client, id), &golangsdk.RequestOpts{
+	resp, err := client.Delete(ingressURL(client, id), &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -68,8 +68,9 @@ func Create(client *golangsdk.ServiceClient, opts ResponseOptsBuilder) (r Create
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId())),
+	resp, err := client.Post(rootURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId())),
 		reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -80,16 +81,18 @@ func Update(client *golangsdk.ServiceClient, respId string, opts ResponseOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId()), respId),
+	resp, err := client.Put(resourceURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId()), respId),
 		reqBody, &r.Body, &golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain the specified custom response according to the instanceId, appId and respId.
 func Get(client *golangsdk.ServiceClient, instanceId, groupId, respId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, buildResponsesPath(instanceId, groupId), respId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, buildResponsesPath(instanceId, groupId), respId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -146,7 +149,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 // Delete is a method to delete the existing custom response.
 func Delete(client *golangsdk.ServiceClient, instanceId, groupId, respId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, buildResponsesPath(instanceId, groupId), respId), nil)
+	resp, err := client.Delete(resourceURL(client, buildResponsesPath(instanceId, groupId), respId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -177,8 +181,9 @@ func (opts SpecRespOpts) GetResponseId() string {
 
 // GetSpecResp is a method to get the specifies custom response configuration from an group.
 func GetSpecResp(client *golangsdk.ServiceClient, respType string, opts SpecRespOptsBuilder) (r GetSpecRespResult) {
-	_, r.Err = client.Get(specResponsesURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId()),
+	resp, err := client.Get(specResponsesURL(client, buildResponsesPath(opts.GetInstanceId(), opts.GetGroupId()),
 		opts.GetResponseId(), respType), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -198,16 +203,18 @@ func UpdateSpecResp(client *golangsdk.ServiceClient, respType string, specOpts S
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(specResponsesURL(client, buildResponsesPath(specOpts.GetInstanceId(), specOpts.GetGroupId()),
+	resp, err := client.Put(specResponsesURL(client, buildResponsesPath(specOpts.GetInstanceId(), specOpts.GetGroupId()),
 		specOpts.GetResponseId(), respType), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DeleteSpecResp is a method to delete an existing custom response configuration from an group.
 func DeleteSpecResp(client *golangsdk.ServiceClient, respType string, specOpts SpecRespOptsBuilder) (r DeleteResult) {
-	_, r.Err = client.Delete(specResponsesURL(client, buildResponsesPath(specOpts.GetInstanceId(),
+	resp, err := client.Delete(specResponsesURL(client, buildResponsesPath(specOpts.GetInstanceId(),
 		specOpts.GetGroupId()), specOpts.GetResponseId(), respType), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -63,7 +63,8 @@ func Create(client *golangsdk.ServiceClient, instanceId string, opts ThrottlingP
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	resp, err := client.Post(rootURL(client, instanceId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -75,15 +76,17 @@ func Update(client *golangsdk.ServiceClient, instanceId, policyId string,
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, instanceId, policyId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, instanceId, policyId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtain an existing APIG throttling policy by policy ID.
 func Get(client *golangsdk.ServiceClient, instanceId, policyId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, instanceId, policyId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, instanceId, policyId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -131,7 +134,8 @@ func List(client *golangsdk.ServiceClient, instanceId string, opts ListOptsBuild
 
 // Delete is a method to delete an existing throttling policy.
 func Delete(client *golangsdk.ServiceClient, instanceId, policyId string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, instanceId, policyId), nil)
+	resp, err := client.Delete(resourceURL(client, instanceId, policyId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -164,7 +168,8 @@ func CreateSpecThrottle(client *golangsdk.ServiceClient, instanceId, policyId st
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(specRootURL(client, instanceId, policyId), reqBody, &r.Body, nil)
+	resp, err := client.Post(specRootURL(client, instanceId, policyId), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -193,10 +198,11 @@ func UpdateSpecThrottle(client *golangsdk.ServiceClient, instanceId, policyId, s
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(specResourceURL(client, instanceId, policyId, strategyId), reqBody, &r.Body,
+	resp, err := client.Put(specResourceURL(client, instanceId, policyId, strategyId), reqBody, &r.Body,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -248,6 +254,7 @@ func ListSpecThrottles(client *golangsdk.ServiceClient, instanceId, policyId str
 
 // DeleteSpecThrottle is a method to delete an existing special throttling policy.
 func DeleteSpecThrottle(client *golangsdk.ServiceClient, instanceId, policyId, strategyId string) (r DeleteResult) {
-	_, r.Err = client.Delete(specResourceURL(client, instanceId, policyId, strategyId), nil)
+	resp, err := client.Delete(specResourceURL(client, instanceId, policyId, strategyId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -58,21 +58,24 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //DeleteGroup is a method of deleting a group by group id
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //GetGroup is a method of getting the detailed information of the group by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -147,9 +150,10 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -171,9 +175,10 @@ func doAction(client *golangsdk.ServiceClient, id string, opts ActionOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(enableURL(client, id), &b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(enableURL(client, id), &b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -63,7 +63,8 @@ func Delete(client *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder)
 		}
 		url += q
 	}
-	_, r.Err = client.Delete(url, nil)
+	resp, err := client.Delete(url, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -90,9 +91,10 @@ func batch(client *golangsdk.ServiceClient, groupID string, opts BatchOptsBuilde
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(batchURL(client, groupID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(batchURL(client, groupID), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
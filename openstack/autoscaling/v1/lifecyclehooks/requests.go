@@ -42,21 +42,24 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder, groupID str
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client, groupID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, groupID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to obtains the hook detail of autoscaling service.
 func Get(client *golangsdk.ServiceClient, groupID, hookName string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, groupID, hookName), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, groupID, hookName), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // List is a method to obtains a hook array of the autoscaling service.
 func List(client *golangsdk.ServiceClient, groupID string) (r ListResult) {
-	_, r.Err = client.Get(listURL(client, groupID), &r.Body, nil)
+	resp, err := client.Get(listURL(client, groupID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -95,14 +98,16 @@ func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder, groupID, ho
 		return
 	}
 
-	_, r.Err = client.Put(resourceURL(client, groupID, hookName), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, groupID, hookName), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Delete is a method which can be able to access to delete the existing hook of the autoscaling service.
 func Delete(client *golangsdk.ServiceClient, groupID, hookName string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, groupID, hookName), nil)
+	resp, err := client.Delete(resourceURL(client, groupID, hookName), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
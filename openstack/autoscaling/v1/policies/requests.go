@@ -2,6 +2,7 @@ package policies
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 //CreateOptsBuilder is an interface by which can serialize the create parameters
@@ -46,9 +47,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -80,20 +82,108 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Delete is a method which can be able to access to delete a policy of autoscaling
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Get is a method which can be able to access to get a policy detailed information
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
+
+//ListOptsBuilder is an interface by which can build the query string of list function
+type ListOptsBuilder interface {
+	ToPolicyListQuery() (string, error)
+}
+
+//ListOpts is a struct which represents the filters of list function
+type ListOpts struct {
+	Name string `q:"scaling_policy_name"`
+	Type string `q:"scaling_policy_type"`
+	ID   string `q:"scaling_policy_id"`
+}
+
+// ToPolicyListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPolicyListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+//List is a method which can be able to list the policies of a scaling group
+func List(client *golangsdk.ServiceClient, groupID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, groupID)
+	if opts != nil {
+		q, err := opts.ToPolicyListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += q
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PolicyPage{pagination.SinglePageBase(r)}
+	})
+}
+
+//ActionOptsBuilder is an interface which can build the map paramter of action functions
+type ActionOptsBuilder interface {
+	ToPolicyActionMap() (map[string]interface{}, error)
+}
+
+//PolicyActionOpts is a struct which represents the parameters of action functions
+type PolicyActionOpts struct {
+	Action string `json:"action" required:"true"`
+}
+
+func (opts PolicyActionOpts) ToPolicyActionMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func doAction(client *golangsdk.ServiceClient, id string, opts ActionOptsBuilder) (r ActionResult) {
+	b, err := opts.ToPolicyActionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(actionURL(client, id), &b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+//Execute is an operation which triggers the scaling action of the policy immediately
+func Execute(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	opts := PolicyActionOpts{
+		Action: "execute",
+	}
+	return doAction(client, id, opts)
+}
+
+//Enable is an operation by which can resume a paused policy
+func Enable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	opts := PolicyActionOpts{
+		Action: "resume",
+	}
+	return doAction(client, id, opts)
+}
+
+//Disable is an operation by which can pause the policy
+func Disable(client *golangsdk.ServiceClient, id string) (r ActionResult) {
+	opts := PolicyActionOpts{
+		Action: "pause",
+	}
+	return doAction(client, id, opts)
+}
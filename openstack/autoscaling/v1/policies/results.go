@@ -2,6 +2,7 @@ package policies
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 //Create Result is a struct which represents the create result of policy
@@ -26,6 +27,7 @@ type DeleteResult struct {
 //Policy is a struct that represents the result of get policy
 type Policy struct {
 	ID             string         `json:"scaling_group_id"`
+	PolicyID       string         `json:"scaling_policy_id"`
 	Name           string         `json:"scaling_policy_name"`
 	Status         string         `json:"policy_status"`
 	Type           string         `json:"scaling_policy_type"`
@@ -73,3 +75,26 @@ func (r UpdateResult) Extract() (string, error) {
 	err := r.Result.ExtractInto(&a)
 	return a.ID, err
 }
+
+//PolicyPage is a single page of the policies of a scaling group
+type PolicyPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty returns true if a PolicyPage contains no policies.
+func (r PolicyPage) IsEmpty() (bool, error) {
+	policies, err := r.Extract()
+	return len(policies) == 0, err
+}
+
+//Extract will deserialize the page to a list of policies
+func (r PolicyPage) Extract() ([]Policy, error) {
+	var ps []Policy
+	err := r.Result.ExtractIntoSlicePtr(&ps, "scaling_policies")
+	return ps, err
+}
+
+//ActionResult is the result of execute, enable or disable operations
+type ActionResult struct {
+	golangsdk.ErrResult
+}
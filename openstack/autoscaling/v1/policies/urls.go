@@ -26,3 +26,15 @@ func getURL(client *golangsdk.ServiceClient, id string) string {
 func updateURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL(resourcePath, id)
 }
+
+//listURL will build the url of the policies of a scaling group
+//its pattern is endpoint/scaling_policy/<group-id>/list
+func listURL(c *golangsdk.ServiceClient, groupID string) string {
+	return c.ServiceURL(resourcePath, groupID, "list")
+}
+
+//actionURL will build the url of the policy actions
+//its pattern is endpoint/scaling_policy/<policy-id>/action
+func actionURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "action")
+}
@@ -26,9 +26,10 @@ func doAction(client *golangsdk.ServiceClient, id string, opts ActionOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -61,12 +62,14 @@ func Delete(client *golangsdk.ServiceClient, id string, tags []ResourceTag) (r A
 
 //Get is a method of getting the tags of the group by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //List is a method of getting the tags of all groups
 func List(client *golangsdk.ServiceClient) (r ListResult) {
-	_, r.Err = client.Get(listURL(client), &r.Body, nil)
+	resp, err := client.Get(listURL(client), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
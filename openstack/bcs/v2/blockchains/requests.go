@@ -131,9 +131,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -167,35 +168,40 @@ func Delete(client *golangsdk.ServiceClient, opts DeleteOptsBuilder, id string)
 		}
 		url += query
 	}
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		OkCodes:      []int{200, 202, 204},
 		JSONResponse: nil,
 		MoreHeaders:  map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Get is a method to obtain the detailed information of an existing bcs instance
 func Get(client *golangsdk.ServiceClient, id string) (r ShowResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //GetStatus is a method to obtain all block status of an existing bcs instance
 func GetStatus(client *golangsdk.ServiceClient, id string) (r StatusResult) {
-	_, r.Err = client.Get(extraURL(client, id, "status"), &r.Body, nil)
+	resp, err := client.Get(extraURL(client, id, "status"), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //List is a method to obtain the detailed information list of all existing bcs instance
 func List(client *golangsdk.ServiceClient) (r ListResult) {
-	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
+	resp, err := client.Get(rootURL(client), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //GetNodes is a method to obtain the node information list of an existing bcs instance
 func GetNodes(client *golangsdk.ServiceClient, id string) (r NodesResult) {
-	_, r.Err = client.Get(extraURL(client, id, "nodes"), &r.Body, nil)
+	resp, err := client.Get(extraURL(client, id, "nodes"), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -232,8 +238,9 @@ func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder, id string)
 		return
 	}
 
-	_, r.Err = client.Post(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
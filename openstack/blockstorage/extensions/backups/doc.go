@@ -0,0 +1,63 @@
+/*
+Package backups provides information and interaction with backups in the
+OpenStack Block Storage service. A backup is a full or incremental copy of a
+volume stored outside of it, from which a volume can be restored.
+
+Example to List Backups
+
+	listOpts := backups.ListOpts{
+		VolumeID: "uuid",
+	}
+
+	allPages, err := backups.ListDetail(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range allBackups {
+		fmt.Println(backup)
+	}
+
+Example to Create a Backup
+
+	createOpts := backups.CreateOpts{
+		VolumeID: "uuid",
+		Name:     "my-backup",
+	}
+
+	backup, err := backups.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = backups.WaitForStatus(client, backup.ID, "available", 600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Restore a Backup to a New Volume
+
+	restoreOpts := backups.RestoreOpts{
+		Name: "restored-volume",
+	}
+
+	restore, err := backups.RestoreFromBackup(client, "uuid", restoreOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(restore.VolumeID)
+
+Example to Delete a Backup
+
+	err := backups.Delete(client, "uuid").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package backups
@@ -0,0 +1,193 @@
+package backups
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a Backup. This object is passed to
+// the backups.Create function. For more information about these parameters,
+// see the Backup object.
+type CreateOpts struct {
+	// VolumeID is the ID of the volume to create the backup from.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Force will force the creation of a backup regardless of the volume's
+	// status, e.g. when it is in-use.
+	Force bool `json:"force,omitempty"`
+
+	// Name is the name of the backup.
+	Name string `json:"name,omitempty"`
+
+	// Description is the description of the backup.
+	Description string `json:"description,omitempty"`
+
+	// Metadata is metadata for the backup.
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Container is a container to store the backup.
+	Container string `json:"container,omitempty"`
+
+	// Incremental is whether the backup should be incremental or not.
+	Incremental bool `json:"incremental,omitempty"`
+
+	// SnapshotID is the ID of a snapshot to backup instead of the volume.
+	SnapshotID string `json:"snapshot_id,omitempty"`
+
+	// AvailabilityZone is an availability zone to locate the volume or snapshot.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToBackupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "backup")
+}
+
+// Create will create a new Backup based on the values in CreateOpts. To
+// extract the Backup object from the response, call the Extract method on the
+// CreateResult.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete will delete the existing Backup with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the Backup with the provided ID. To extract the Backup
+// object from the response, call the Extract method on the GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Backups. It is passed to the backups.List
+// and backups.ListDetail functions.
+type ListOpts struct {
+	// AllTenants will retrieve backups of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Name will filter by the specified backup name.
+	Name string `q:"name"`
+
+	// Status will filter by the specified status.
+	Status string `q:"status"`
+
+	// VolumeID will filter by the ID of the backed up volume.
+	VolumeID string `q:"volume_id"`
+
+	// TenantID will filter by a specific tenant/project ID.
+	// Setting AllTenants is required for this.
+	TenantID string `q:"project_id"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToBackupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Backups optionally limited by the conditions provided in
+// ListOpts. Only the ID, name and links of the backups are returned, use
+// ListDetail to get all their attributes.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns Backups with all their attributes, optionally limited by
+// the conditions provided in ListOpts.
+func ListDetail(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *golangsdk.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// RestoreOptsBuilder allows extensions to add additional parameters to the
+// Restore request.
+type RestoreOptsBuilder interface {
+	ToBackupRestoreMap() (map[string]interface{}, error)
+}
+
+// RestoreOpts contains options for restoring a Backup. This object is passed
+// to the backups.RestoreFromBackup function.
+type RestoreOpts struct {
+	// VolumeID is the ID of the existing volume to restore the backup to.
+	// If empty, a new volume is created.
+	VolumeID string `json:"volume_id,omitempty"`
+
+	// Name is the name of the new volume to restore the backup to. It is
+	// ignored when VolumeID is set.
+	Name string `json:"name,omitempty"`
+}
+
+// ToBackupRestoreMap assembles a request body based on the contents of a
+// RestoreOpts.
+func (opts RestoreOpts) ToBackupRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "restore")
+}
+
+// RestoreFromBackup will restore a Backup to a volume based on the values in
+// RestoreOpts. To extract the Restore object from the response, call the
+// Extract method on the RestoreResult.
+func RestoreFromBackup(client *golangsdk.ServiceClient, id string, opts RestoreOptsBuilder) (r RestoreResult) {
+	b, err := opts.ToBackupRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(restoreURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
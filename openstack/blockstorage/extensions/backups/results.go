@@ -0,0 +1,167 @@
+package backups
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Backup contains all the information associated with a Cinder Backup.
+type Backup struct {
+	// ID is the Unique identifier of the backup.
+	ID string `json:"id"`
+
+	// CreatedAt is the date the backup was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date the backup was updated.
+	UpdatedAt time.Time `json:"-"`
+
+	// Name is the display name of the backup.
+	Name string `json:"name"`
+
+	// Description is the description of the backup.
+	Description string `json:"description"`
+
+	// VolumeID is the ID of the Volume from which this backup was created.
+	VolumeID string `json:"volume_id"`
+
+	// SnapshotID is the ID of the snapshot from which this backup was created.
+	SnapshotID string `json:"snapshot_id"`
+
+	// Status is the status of the backup, e.g. "creating" or "available".
+	Status string `json:"status"`
+
+	// FailReason has the reason for the failure of the backup.
+	FailReason string `json:"fail_reason"`
+
+	// Size is the size of the backup, in GB.
+	Size int `json:"size"`
+
+	// ObjectCount is the number of objects in the backup.
+	ObjectCount int `json:"object_count"`
+
+	// Container is the container where the backup is stored.
+	Container string `json:"container"`
+
+	// AvailabilityZone is the availability zone of the backup.
+	AvailabilityZone string `json:"availability_zone"`
+
+	// HasDependentBackups is whether there are other backups
+	// depending on this backup.
+	HasDependentBackups bool `json:"has_dependent_backups"`
+
+	// IsIncremental is whether the backup is incremental.
+	IsIncremental bool `json:"is_incremental"`
+
+	// Links includes links about the backup.
+	Links []golangsdk.Link `json:"links"`
+}
+
+// UnmarshalJSON another unmarshalling function
+func (r *Backup) UnmarshalJSON(b []byte) error {
+	type tmp Backup
+	var s struct {
+		tmp
+		CreatedAt golangsdk.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt golangsdk.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Backup(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return err
+}
+
+// BackupPage is a pagination.Pager that is returned from a call to the List
+// and ListDetail functions.
+type BackupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a BackupPage contains no Backups.
+func (r BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(r)
+	return len(backups) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r BackupPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"backups_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(s.Links)
+}
+
+// ExtractBackups extracts and returns Backups. It is used while iterating over
+// a backups.List or backups.ListDetail call.
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s []Backup
+	err := r.(BackupPage).Result.ExtractIntoSlicePtr(&s, "backups")
+	return s, err
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the Backup object out of the commonResult object.
+func (r commonResult) Extract() (*Backup, error) {
+	var s Backup
+	err := r.ExtractIntoStructPtr(&s, "backup")
+	return &s, err
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// Restore contains all the information associated with a Cinder Backup
+// restore response.
+type Restore struct {
+	// BackupID is the Unique identifier of the backup.
+	BackupID string `json:"backup_id"`
+
+	// VolumeID is the Unique identifier of the volume the backup is
+	// restored to.
+	VolumeID string `json:"volume_id"`
+
+	// VolumeName is the name of the volume the backup is restored to.
+	VolumeName string `json:"volume_name"`
+}
+
+// RestoreResult contains the response body and error from a RestoreFromBackup
+// request.
+type RestoreResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the Restore object out of the RestoreResult object.
+func (r RestoreResult) Extract() (*Restore, error) {
+	var s Restore
+	err := r.ExtractIntoStructPtr(&s, "restore")
+	return &s, err
+}
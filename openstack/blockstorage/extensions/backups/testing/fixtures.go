@@ -0,0 +1,189 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/extensions/backups"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const backupID = "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+
+// GetResponse is a sample response to a Get request.
+const GetResponse = `
+{
+  "backup": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "backup-001",
+    "description": "Daily Backup",
+    "status": "available",
+    "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+    "size": 30,
+    "object_count": 2,
+    "container": "volumebackups",
+    "availability_zone": "nova",
+    "is_incremental": false,
+    "has_dependent_backups": false,
+    "fail_reason": null,
+    "snapshot_id": null,
+    "created_at": "2017-05-30T03:35:03.000000",
+    "updated_at": "2017-05-30T03:35:23.000000",
+    "links": []
+  }
+}
+`
+
+// ListDetailResponse is a sample response to a ListDetail request.
+const ListDetailResponse = `
+{
+  "backups": [
+    {
+      "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+      "name": "backup-001",
+      "description": "Daily Backup",
+      "status": "available",
+      "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+      "size": 30,
+      "object_count": 2,
+      "container": "volumebackups",
+      "availability_zone": "nova",
+      "is_incremental": false,
+      "has_dependent_backups": false,
+      "fail_reason": null,
+      "snapshot_id": null,
+      "created_at": "2017-05-30T03:35:03.000000",
+      "updated_at": "2017-05-30T03:35:23.000000",
+      "links": []
+    }
+  ],
+  "backups_links": [
+    {
+      "href": "%s/backups/detail?marker=d32019d3-bc6e-4319-9c1d-6722fc136a22",
+      "rel": "next"
+    }
+  ]
+}
+`
+
+// CreateRequest is the expected request body of a Create request.
+const CreateRequest = `
+{
+  "backup": {
+    "volume_id": "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+    "name": "backup-001",
+    "incremental": true
+  }
+}
+`
+
+// CreateResponse is a sample response to a Create request.
+const CreateResponse = `
+{
+  "backup": {
+    "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "name": "backup-001",
+    "links": []
+  }
+}
+`
+
+// RestoreRequest is the expected request body of a RestoreFromBackup request.
+const RestoreRequest = `
+{
+  "restore": {
+    "name": "vol-001"
+  }
+}
+`
+
+// RestoreResponse is a sample response to a RestoreFromBackup request.
+const RestoreResponse = `
+{
+  "restore": {
+    "backup_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+    "volume_id": "795114e8-7489-40be-a978-83797f2c1dd3",
+    "volume_name": "vol-001"
+  }
+}
+`
+
+// ExpectedBackup is the Backup of GetResponse and ListDetailResponse.
+var ExpectedBackup = backups.Backup{
+	ID:               backupID,
+	Name:             "backup-001",
+	Description:      "Daily Backup",
+	Status:           "available",
+	VolumeID:         "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+	Size:             30,
+	ObjectCount:      2,
+	Container:        "volumebackups",
+	AvailabilityZone: "nova",
+	CreatedAt:        time.Date(2017, 5, 30, 3, 35, 3, 0, time.UTC),
+	UpdatedAt:        time.Date(2017, 5, 30, 3, 35, 23, 0, time.UTC),
+	Links:            []golangsdk.Link{},
+}
+
+func MockListDetailResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, ListDetailResponse, th.Server.URL)
+		case backupID:
+			fmt.Fprintf(w, `{"backups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/"+backupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetResponse)
+	})
+}
+
+func MockCreateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+func MockRestoreResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/"+backupID+"/restore", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, RestoreRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, RestoreResponse)
+	})
+}
+
+func MockDeleteResponse(t *testing.T) {
+	th.Mux.HandleFunc("/backups/"+backupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
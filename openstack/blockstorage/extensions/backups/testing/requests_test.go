@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/extensions/backups"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListDetailResponse(t)
+
+	allPages, err := backups.ListDetail(client.ServiceClient(), backups.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := backups.ExtractBackups(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []backups.Backup{ExpectedBackup}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	actual, err := backups.Get(client.ServiceClient(), backupID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedBackup, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCreateResponse(t)
+
+	actual, err := backups.Create(client.ServiceClient(), backups.CreateOpts{
+		VolumeID:    "521752a6-acf6-4b2d-bc7a-119f9148cd8c",
+		Name:        "backup-001",
+		Incremental: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, backupID, actual.ID)
+	th.AssertEquals(t, "backup-001", actual.Name)
+}
+
+func TestRestoreFromBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockRestoreResponse(t)
+
+	actual, err := backups.RestoreFromBackup(client.ServiceClient(), backupID, backups.RestoreOpts{
+		Name: "vol-001",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &backups.Restore{
+		BackupID:   backupID,
+		VolumeID:   "795114e8-7489-40be-a978-83797f2c1dd3",
+		VolumeName: "vol-001",
+	}, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockDeleteResponse(t)
+
+	err := backups.Delete(client.ServiceClient(), backupID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
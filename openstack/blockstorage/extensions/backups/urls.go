@@ -0,0 +1,29 @@
+package backups
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "backups"
+
+func createURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func listDetailURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "detail")
+}
+
+func getURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func deleteURL(c *golangsdk.ServiceClient, id string) string {
+	return getURL(c, id)
+}
+
+func restoreURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "restore")
+}
@@ -8,20 +8,23 @@ import (
 
 // Get returns public data about a previously created QuotaSet.
 func Get(client *golangsdk.ServiceClient, projectID string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, projectID), &r.Body, nil)
+	resp, err := client.Get(getURL(client, projectID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetDefaults returns public data about the project's default block storage quotas.
 func GetDefaults(client *golangsdk.ServiceClient, projectID string) (r GetResult) {
-	_, r.Err = client.Get(getDefaultsURL(client, projectID), &r.Body, nil)
+	resp, err := client.Get(getDefaultsURL(client, projectID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetUsage returns detailed public data about a previously created QuotaSet.
 func GetUsage(client *golangsdk.ServiceClient, projectID string) (r GetUsageResult) {
 	u := fmt.Sprintf("%s?usage=true", getURL(client, projectID))
-	_, r.Err = client.Get(u, &r.Body, nil)
+	resp, err := client.Get(u, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -33,9 +36,10 @@ func Update(client *golangsdk.ServiceClient, projectID string, opts UpdateOptsBu
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, projectID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, projectID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return r
 }
 
@@ -87,8 +91,9 @@ type UpdateOpts struct {
 
 // Resets the quotas for the given tenant to their default values.
 func Delete(client *golangsdk.ServiceClient, projectID string) (r DeleteResult) {
-	_, r.Err = client.Delete(updateURL(client, projectID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(updateURL(client, projectID), &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
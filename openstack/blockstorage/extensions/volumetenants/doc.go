@@ -1,6 +1,7 @@
 /*
 Package volumetenants provides the ability to extend a volume result with
-tenant/project information. Example:
+tenant/project information. Administrators can list the volumes of all the
+projects with AllTenants, e.g. to run a cloud-wide inventory:
 
 	type VolumeWithTenant struct {
 		volumes.Volume
@@ -9,7 +10,7 @@ tenant/project information. Example:
 
 	var allVolumes []VolumeWithTenant
 
-	allPages, err := volumes.List(client, nil).AllPages()
+	allPages, err := volumes.List(client, volumes.ListOpts{AllTenants: true}).AllPages()
 	if err != nil {
 		panic("Unable to retrieve volumes: %s", err)
 	}
@@ -20,7 +21,7 @@ tenant/project information. Example:
 	}
 
 	for _, volume := range allVolumes {
-		fmt.Println(volume.TenantID)
+		fmt.Println(volume.ID, volume.TenantID)
 	}
 */
 package volumetenants
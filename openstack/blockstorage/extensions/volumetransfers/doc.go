@@ -0,0 +1,56 @@
+/*
+Package volumetransfers provides an interaction with volume transfers in the
+OpenStack Block Storage service. A volume transfer moves an available volume
+from one project to another: the owner creates the transfer, and a user of the
+receiving project accepts it with the transfer ID and authentication key.
+
+Example to Create a Volume Transfer
+
+	createOpts := volumetransfers.CreateOpts{
+		VolumeID: "uuid",
+		Name:     "my-volume-transfer",
+	}
+
+	transfer, err := volumetransfers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// Hand transfer.ID and transfer.AuthKey to the receiving project.
+	fmt.Println(transfer.ID, transfer.AuthKey)
+
+Example to Accept a Volume Transfer
+
+	acceptOpts := volumetransfers.AcceptOpts{
+		AuthKey: "auth-key",
+	}
+
+	transfer, err := volumetransfers.Accept(receiverClient, "transfer-uuid", acceptOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Volume Transfers
+
+	allPages, err := volumetransfers.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTransfers, err := volumetransfers.ExtractTransfers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, transfer := range allTransfers {
+		fmt.Println(transfer)
+	}
+
+Example to Cancel a Volume Transfer
+
+	err := volumetransfers.Delete(client, "transfer-uuid").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package volumetransfers
@@ -0,0 +1,141 @@
+package volumetransfers
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTransferCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains options for creating a Volume Transfer. This object is
+// passed to the volumetransfers.Create function.
+type CreateOpts struct {
+	// VolumeID is the ID of the volume to transfer. The volume must be
+	// available.
+	VolumeID string `json:"volume_id" required:"true"`
+
+	// Name is the name of the transfer.
+	Name string `json:"name,omitempty"`
+}
+
+// ToTransferCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToTransferCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "transfer")
+}
+
+// Create will offer a volume to another project. The AuthKey of the created
+// Transfer must be handed to the receiving project, it is returned only once.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTransferCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// AcceptOptsBuilder allows extensions to add additional parameters to the
+// Accept request.
+type AcceptOptsBuilder interface {
+	ToAcceptMap() (map[string]interface{}, error)
+}
+
+// AcceptOpts contains options for accepting a Volume Transfer. This object is
+// passed to the volumetransfers.Accept function.
+type AcceptOpts struct {
+	// AuthKey is the authentication key of the transfer.
+	AuthKey string `json:"auth_key" required:"true"`
+}
+
+// ToAcceptMap assembles a request body based on the contents of an
+// AcceptOpts.
+func (opts AcceptOpts) ToAcceptMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "accept")
+}
+
+// Accept will move the volume of a transfer to the project of the client.
+func Accept(client *golangsdk.ServiceClient, id string, opts AcceptOptsBuilder) (r AcceptResult) {
+	b, err := opts.ToAcceptMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(acceptURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete will cancel the Volume Transfer with the provided ID.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the Volume Transfer with the provided ID. To extract the
+// Transfer object from the response, call the Extract method on the
+// GetResult.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToTransferListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Volume Transfers. It is passed to the
+// volumetransfers.List function.
+type ListOpts struct {
+	// AllTenants will retrieve transfers of all tenants/projects.
+	AllTenants bool `q:"all_tenants"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToTransferListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTransferListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns the pending Volume Transfers optionally limited by the
+// conditions provided in ListOpts.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToTransferListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TransferPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
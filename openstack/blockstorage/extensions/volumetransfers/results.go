@@ -0,0 +1,113 @@
+package volumetransfers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Transfer represents a Volume Transfer record.
+type Transfer struct {
+	// ID is the unique identifier of the transfer.
+	ID string `json:"id"`
+
+	// AuthKey is the key the receiving project uses to accept the transfer.
+	// It is only returned when the transfer is created.
+	AuthKey string `json:"auth_key"`
+
+	// Name is the name of the transfer.
+	Name string `json:"name"`
+
+	// VolumeID is the ID of the transferred volume.
+	VolumeID string `json:"volume_id"`
+
+	// CreatedAt is the date the transfer was created.
+	CreatedAt time.Time `json:"-"`
+
+	// Links includes links about the transfer.
+	Links []golangsdk.Link `json:"links"`
+}
+
+// UnmarshalJSON is our unmarshalling helper
+func (r *Transfer) UnmarshalJSON(b []byte) error {
+	type tmp Transfer
+	var s struct {
+		tmp
+		CreatedAt golangsdk.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Transfer(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// TransferPage is a pagination.Pager that is returned from a call to the List
+// function.
+type TransferPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a TransferPage contains no Transfers.
+func (r TransferPage) IsEmpty() (bool, error) {
+	transfers, err := ExtractTransfers(r)
+	return len(transfers) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r TransferPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"transfers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(s.Links)
+}
+
+// ExtractTransfers extracts and returns Transfers. It is used while iterating
+// over a volumetransfers.List call.
+func ExtractTransfers(r pagination.Page) ([]Transfer, error) {
+	var s []Transfer
+	err := r.(TransferPage).Result.ExtractIntoSlicePtr(&s, "transfers")
+	return s, err
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract will get the Transfer object out of the commonResult object.
+func (r commonResult) Extract() (*Transfer, error) {
+	var s Transfer
+	err := r.ExtractIntoStructPtr(&s, "transfer")
+	return &s, err
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// AcceptResult contains the response body and error from an Accept request.
+type AcceptResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
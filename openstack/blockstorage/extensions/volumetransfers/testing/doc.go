@@ -0,0 +1,2 @@
+// volumetransfers unit tests
+package testing
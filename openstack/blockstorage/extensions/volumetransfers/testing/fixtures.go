@@ -0,0 +1,119 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/extensions/volumetransfers"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const transferID = "b8913bfd-a4d3-4ec5-bd8b-fe2dbeef9f4f"
+
+// CreateRequest is the expected request body of a Create request.
+const CreateRequest = `
+{
+  "transfer": {
+    "volume_id": "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+    "name": "my-transfer"
+  }
+}
+`
+
+// CreateResponse is a sample response to a Create request.
+const CreateResponse = `
+{
+  "transfer": {
+    "id": "b8913bfd-a4d3-4ec5-bd8b-fe2dbeef9f4f",
+    "auth_key": "cb67e0e7387d9eac",
+    "name": "my-transfer",
+    "volume_id": "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+    "created_at": "2020-02-28T12:44:28.051989",
+    "links": []
+  }
+}
+`
+
+// AcceptRequest is the expected request body of an Accept request.
+const AcceptRequest = `
+{
+  "accept": {
+    "auth_key": "cb67e0e7387d9eac"
+  }
+}
+`
+
+// AcceptResponse is a sample response to an Accept request.
+const AcceptResponse = `
+{
+  "transfer": {
+    "id": "b8913bfd-a4d3-4ec5-bd8b-fe2dbeef9f4f",
+    "name": "my-transfer",
+    "volume_id": "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+    "links": []
+  }
+}
+`
+
+// ListResponse is a sample response to a List request.
+const ListResponse = `
+{
+  "transfers": [
+    {
+      "id": "b8913bfd-a4d3-4ec5-bd8b-fe2dbeef9f4f",
+      "name": "my-transfer",
+      "volume_id": "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+      "created_at": "2020-02-28T12:44:28.051989",
+      "links": []
+    }
+  ]
+}
+`
+
+// ExpectedTransfer is the Transfer of CreateResponse.
+var ExpectedTransfer = volumetransfers.Transfer{
+	ID:        transferID,
+	AuthKey:   "cb67e0e7387d9eac",
+	Name:      "my-transfer",
+	VolumeID:  "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+	CreatedAt: time.Date(2020, 2, 28, 12, 44, 28, 51989000, time.UTC),
+	Links:     []golangsdk.Link{},
+}
+
+func MockCreateResponse(t *testing.T) {
+	th.Mux.HandleFunc("/os-volume-transfer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+func MockAcceptResponse(t *testing.T) {
+	th.Mux.HandleFunc("/os-volume-transfer/"+transferID+"/accept", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, AcceptRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, AcceptResponse)
+	})
+}
+
+func MockListResponse(t *testing.T) {
+	th.Mux.HandleFunc("/os-volume-transfer/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ListResponse)
+	})
+}
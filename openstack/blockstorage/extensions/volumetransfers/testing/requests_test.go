@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/extensions/volumetransfers"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCreateResponse(t)
+
+	actual, err := volumetransfers.Create(client.ServiceClient(), volumetransfers.CreateOpts{
+		VolumeID: "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e",
+		Name:     "my-transfer",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedTransfer, actual)
+}
+
+func TestAccept(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockAcceptResponse(t)
+
+	actual, err := volumetransfers.Accept(client.ServiceClient(), transferID, volumetransfers.AcceptOpts{
+		AuthKey: "cb67e0e7387d9eac",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2ed6c5ba-8d98-4c1b-b6c7-0a1b0b2c3d4e", actual.VolumeID)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListResponse(t)
+
+	allPages, err := volumetransfers.List(client.ServiceClient(), nil).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := volumetransfers.ExtractTransfers(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := ExpectedTransfer
+	expected.AuthKey = ""
+	th.CheckDeepEquals(t, []volumetransfers.Transfer{expected}, actual)
+}
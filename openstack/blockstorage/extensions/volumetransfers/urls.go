@@ -0,0 +1,25 @@
+package volumetransfers
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "os-volume-transfer"
+
+func createURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func acceptURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "accept")
+}
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath, "detail")
+}
+
+func getURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func deleteURL(c *golangsdk.ServiceClient, id string) string {
+	return getURL(c, id)
+}
@@ -201,3 +201,38 @@ func MockUpdateResponse(t *testing.T) {
         `)
 	})
 }
+
+// MockRequestIDResponses answers the Create, Get and Delete requests of a
+// volume with their own request ID in the X-Openstack-Request-Id header, and
+// the Get requests of an unknown volume with a 404 carrying one too.
+func MockRequestIDResponses(t *testing.T) {
+	th.Mux.HandleFunc("/volumes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.Header().Add("X-Openstack-Request-Id", "req-create")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"volume": {"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "status": "creating"}}`)
+	})
+
+	th.Mux.HandleFunc("/volumes/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.Header().Add("X-Openstack-Request-Id", "req-get")
+			fmt.Fprintf(w, `{"volume": {"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "status": "available"}}`)
+		case "DELETE":
+			w.Header().Add("X-Openstack-Request-Id", "req-delete")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	th.Mux.HandleFunc("/volumes/unknown", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("X-Openstack-Request-Id", "req-get-unknown")
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
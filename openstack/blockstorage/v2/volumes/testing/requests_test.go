@@ -225,6 +225,32 @@ func TestDelete(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockRequestIDResponses(t)
+
+	createResult := volumes.Create(client.ServiceClient(), volumes.CreateOpts{Size: 75})
+	th.AssertNoErr(t, createResult.Err)
+	th.CheckEquals(t, "req-create", createResult.Header.Get("X-Openstack-Request-Id"))
+
+	getResult := volumes.Get(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22")
+	th.AssertNoErr(t, getResult.Err)
+	th.CheckEquals(t, "req-get", getResult.Header.Get("X-Openstack-Request-Id"))
+
+	deleteResult := volumes.Delete(client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", volumes.DeleteOpts{})
+	th.AssertNoErr(t, deleteResult.Err)
+	th.CheckEquals(t, "req-delete", deleteResult.Header.Get("X-Openstack-Request-Id"))
+
+	// The headers are kept when the request fails too.
+	getResult = volumes.Get(client.ServiceClient(), "unknown")
+	if getResult.Err == nil {
+		t.Fatal("Expected an error for an unknown volume")
+	}
+	th.CheckEquals(t, "req-get-unknown", getResult.Header.Get("X-Openstack-Request-Id"))
+}
+
 func TestUpdate(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -154,15 +154,17 @@ func CreatePrePaid(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r O
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular Server based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(getURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -190,8 +192,9 @@ func Update(client *golangsdk.ServiceClient, id string, ops UpdateOptsBuilder) (
 		return
 	}
 
-	_, r.Err = client.Put(putURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(putURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -79,6 +79,7 @@ func getStructField(v *Nic, field string) string {
 
 // Get retrieves a particular nic based on its unique ID.
 func Get(c *golangsdk.ServiceClient, serverId string, id string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, serverId, id), &r.Body, nil)
+	resp, err := c.Get(getURL(c, serverId, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
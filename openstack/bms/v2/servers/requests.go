@@ -163,8 +163,9 @@ func getStructServerField(v *Server, field string) string {
 
 // Get requests details on a single server, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"X-OpenStack-Nova-API-Version": "2.26"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -29,20 +29,23 @@ func Create(c *golangsdk.ServiceClient, serverId string, opts CreateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, serverId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, serverId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular tag based on its unique ID.
 func Get(c *golangsdk.ServiceClient, serverId string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, serverId), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, serverId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular tag based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, serverId string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, serverId), nil)
+	resp, err := c.Delete(resourceURL(c, serverId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -23,11 +23,13 @@ func Unsubscribe(client *golangsdk.ServiceClient, opts UnsubscribeOptsBuilder) (
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(unsubscribeURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(unsubscribeURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,105 @@
+package backups
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+type ListOpts struct {
+	CheckpointID        string `q:"checkpoint_id"`
+	EndTime             string `q:"end_time"`
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+	ImageType           string `q:"image_type"`
+	Limit               int    `q:"limit"`
+	Marker              string `q:"marker"`
+	Name                string `q:"name"`
+	Offset              int    `q:"offset"`
+	ParentID            string `q:"parent_id"`
+	ResourceAZ          string `q:"resource_az"`
+	ResourceID          string `q:"resource_id"`
+	ResourceName        string `q:"resource_name"`
+	ResourceType        string `q:"resource_type"`
+	Sort                string `q:"sort"`
+	StartTime           string `q:"start_time"`
+	Status              string `q:"status"`
+	VaultID             string `q:"vault_id"`
+}
+
+type ListOptsBuilder interface {
+	ToBackupListQuery() (string, error)
+}
+
+func (opts ListOpts) ToBackupListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), err
+}
+
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToBackupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	})
+}
+
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+type RestoreOpts struct {
+	// ServerID is the server to restore a server backup to, and VolumeID the
+	// volume to restore a volume backup to.
+	ServerID string `json:"server_id,omitempty"`
+	VolumeID string `json:"volume_id,omitempty"`
+	// Mappings maps the volume backups of a server backup to the volumes of
+	// the server to restore them to.
+	Mappings []RestoreMapping `json:"mappings,omitempty"`
+	PowerOn  *bool            `json:"power_on,omitempty"`
+}
+
+type RestoreMapping struct {
+	BackupID string `json:"backup_id" required:"true"`
+	VolumeID string `json:"volume_id" required:"true"`
+}
+
+type RestoreOptsBuilder interface {
+	ToBackupRestoreMap() (map[string]interface{}, error)
+}
+
+func (opts RestoreOpts) ToBackupRestoreMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "restore")
+}
+
+// Restore restores a backup to a server or a volume. The restoration runs
+// asynchronously, and can be followed with the operation logs of the tasks
+// package.
+func Restore(client *golangsdk.ServiceClient, id string, opts RestoreOptsBuilder) (r RestoreResult) {
+	reqBody, err := opts.ToBackupRestoreMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(restoreURL(client, id), reqBody, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
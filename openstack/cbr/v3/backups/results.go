@@ -0,0 +1,82 @@
+package backups
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+type GetResult struct {
+	commonResult
+}
+
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+type RestoreResult struct {
+	golangsdk.ErrResult
+}
+
+type BackupPage struct {
+	pagination.SinglePageBase
+}
+
+type Backup struct {
+	CheckpointID        string           `json:"checkpoint_id"`
+	CreatedAt           string           `json:"created_at"`
+	Description         string           `json:"description"`
+	EnterpriseProjectID string           `json:"enterprise_project_id"`
+	ExpiredAt           string           `json:"expired_at"`
+	ExtendInfo          BackupExtendInfo `json:"extend_info"`
+	ID                  string           `json:"id"`
+	ImageType           string           `json:"image_type"`
+	Name                string           `json:"name"`
+	ParentID            string           `json:"parent_id"`
+	ProjectID           string           `json:"project_id"`
+	ProtectedAt         string           `json:"protected_at"`
+	ProviderID          string           `json:"provider_id"`
+	ResourceAZ          string           `json:"resource_az"`
+	ResourceID          string           `json:"resource_id"`
+	ResourceName        string           `json:"resource_name"`
+	ResourceSize        int              `json:"resource_size"`
+	ResourceType        string           `json:"resource_type"`
+	Status              string           `json:"status"`
+	UpdatedAt           string           `json:"updated_at"`
+	VaultID             string           `json:"vault_id"`
+	Children            []Backup         `json:"children"`
+}
+
+type BackupExtendInfo struct {
+	AutoTrigger          bool   `json:"auto_trigger"`
+	Bootable             bool   `json:"bootable"`
+	Incremental          bool   `json:"incremental"`
+	SnapshotID           string `json:"snapshot_id"`
+	SupportLLD           bool   `json:"support_lld"`
+	SupportedRestoreMode string `json:"supported_restore_mode"`
+	OsImagesData         []struct {
+		ImageID string `json:"image_id"`
+	} `json:"os_images_data"`
+	ContainSystemDisk bool `json:"contain_system_disk"`
+	Encrypted         bool `json:"encrypted"`
+	SystemDisk        bool `json:"system_disk"`
+}
+
+func (r commonResult) Extract() (*Backup, error) {
+	var s struct {
+		Backup *Backup `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Backup, err
+}
+
+func ExtractBackups(r pagination.Page) ([]Backup, error) {
+	var s struct {
+		Backups []Backup `json:"backups"`
+	}
+	err := r.(BackupPage).Result.ExtractInto(&s)
+	return s.Backups, err
+}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const (
+	expectedListResponse = `
+{
+  "backups" : [ {
+    "provider_id" : "0daac4c5-6707-4851-97ba-169e36266b66",
+    "checkpoint_id" : "8b0851a8-adf3-4f4c-a914-dead08bf9664",
+    "updated_at" : "2019-05-10T07:59:59.451+00:00",
+    "vault_id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce",
+    "id" : "6df2b54c-dd62-4059-a07c-1b8f24f2725d",
+    "resource_az" : "az1.dc1",
+    "image_type" : "backup",
+    "resource_id" : "94eba8b2-acc9-4d82-badc-127144cc5526",
+    "resource_size" : 40,
+    "children" : [ ],
+    "extend_info" : {
+      "auto_trigger" : false,
+      "supported_restore_mode" : "backup",
+      "contain_system_disk" : true,
+      "support_lld" : true
+    },
+    "project_id" : "4229d7a45436489f8c3dc2b1d35d4987",
+    "status" : "available",
+    "resource_name" : "ecs-1",
+    "description" : "backup before upgrade",
+    "name" : "manualbk_upgrade",
+    "created_at" : "2019-05-10T07:59:12.084+00:00",
+    "resource_type" : "OS::Nova::Server"
+  } ],
+  "count" : 1
+}`
+
+	expectedRestoreRequest = `
+{
+  "restore" : {
+    "mappings" : [ {
+      "backup_id" : "4ae9fd6c-5c9f-4a8e-86e6-30e1b1b6a8e0",
+      "volume_id" : "0af4d9f6-1a47-4b9c-9c9d-0c5c1b7b7e71"
+    } ],
+    "power_on" : true,
+    "server_id" : "94eba8b2-acc9-4d82-badc-127144cc5526"
+  }
+}`
+)
+
+func handleBackupList(t *testing.T) {
+	th.Mux.HandleFunc("/backups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"checkpoint_id": "8b0851a8-adf3-4f4c-a914-dead08bf9664"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedListResponse)
+	})
+}
+
+func handleBackupRestore(t *testing.T) {
+	th.Mux.HandleFunc("/backups/6df2b54c-dd62-4059-a07c-1b8f24f2725d/restore", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, expectedRestoreRequest)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
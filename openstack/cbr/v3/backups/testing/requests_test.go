@@ -0,0 +1,46 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/cbr/v3/backups"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestListV3Backup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleBackupList(t)
+
+	opts := backups.ListOpts{CheckpointID: "8b0851a8-adf3-4f4c-a914-dead08bf9664"}
+	pages, err := backups.List(client.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := backups.ExtractBackups(pages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "6df2b54c-dd62-4059-a07c-1b8f24f2725d", actual[0].ID)
+	th.AssertEquals(t, "available", actual[0].Status)
+	th.AssertEquals(t, 40, actual[0].ResourceSize)
+	th.AssertEquals(t, true, actual[0].ExtendInfo.ContainSystemDisk)
+}
+
+func TestRestoreV3Backup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleBackupRestore(t)
+
+	powerOn := true
+	opts := backups.RestoreOpts{
+		ServerID: "94eba8b2-acc9-4d82-badc-127144cc5526",
+		Mappings: []backups.RestoreMapping{
+			{
+				BackupID: "4ae9fd6c-5c9f-4a8e-86e6-30e1b1b6a8e0",
+				VolumeID: "0af4d9f6-1a47-4b9c-9c9d-0c5c1b7b7e71",
+			},
+		},
+		PowerOn: &powerOn,
+	}
+	err := backups.Restore(client.ServiceClient(), "6df2b54c-dd62-4059-a07c-1b8f24f2725d", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
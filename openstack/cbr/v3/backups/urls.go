@@ -0,0 +1,17 @@
+package backups
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "backups"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id)
+}
+
+func restoreURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id, "restore")
+}
@@ -0,0 +1,55 @@
+package checkpoints
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+type CreateOpts struct {
+	VaultID    string            `json:"vault_id" required:"true"`
+	Parameters *CheckpointParams `json:"parameters,omitempty"`
+}
+
+type CheckpointParams struct {
+	AutoTrigger     bool             `json:"auto_trigger,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	Incremental     *bool            `json:"incremental,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	ResourceDetails []ResourceDetail `json:"resource_details,omitempty"`
+	Resources       []string         `json:"resources,omitempty"`
+}
+
+type ResourceDetail struct {
+	ID   string `json:"id" required:"true"`
+	Type string `json:"type" required:"true"`
+	Name string `json:"name,omitempty"`
+}
+
+type CreateOptsBuilder interface {
+	ToCheckpointCreateMap() (map[string]interface{}, error)
+}
+
+func (opts CreateOpts) ToCheckpointCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "checkpoint")
+}
+
+// Create triggers a backup of the resources of a vault, all of them unless
+// the resources are given in the parameters. The backups are created
+// asynchronously; list them with the ID of the checkpoint with backups.List.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	reqBody, err := opts.ToCheckpointCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
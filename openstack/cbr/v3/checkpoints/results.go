@@ -0,0 +1,65 @@
+package checkpoints
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+type CreateResult struct {
+	commonResult
+}
+
+type GetResult struct {
+	commonResult
+}
+
+type Checkpoint struct {
+	CreatedAt string              `json:"created_at"`
+	ID        string              `json:"id"`
+	ProjectID string              `json:"project_id"`
+	Status    string              `json:"status"`
+	Vault     CheckpointVault     `json:"vault"`
+	ExtraInfo CheckpointExtraInfo `json:"extra_info"`
+}
+
+type CheckpointVault struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	Resources        []CheckpointResource `json:"resources"`
+	SkippedResources []SkippedResource    `json:"skipped_resources"`
+}
+
+type CheckpointResource struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	ProtectStatus string `json:"protect_status"`
+	ResourceSize  string `json:"resource_size"`
+	BackupSize    string `json:"backup_size"`
+	BackupCount   string `json:"backup_count"`
+}
+
+type SkippedResource struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
+
+type CheckpointExtraInfo struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	RetentionDuration int    `json:"retention_duration"`
+}
+
+func (r commonResult) Extract() (*Checkpoint, error) {
+	var s struct {
+		Checkpoint *Checkpoint `json:"checkpoint"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Checkpoint, err
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const (
+	expectedRequest = `
+{
+  "checkpoint" : {
+    "parameters" : {
+      "description" : "backup before upgrade",
+      "name" : "manualbk_upgrade",
+      "resources" : [ "94eba8b2-acc9-4d82-badc-127144cc5526" ]
+    },
+    "vault_id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce"
+  }
+}`
+
+	expectedResponse = `
+{
+  "checkpoint" : {
+    "status" : "protecting",
+    "created_at" : "2019-05-10T07:59:12.733+00:00",
+    "vault" : {
+      "id" : "3b5816b5-f29c-4172-9d9a-76c719a659ce",
+      "name" : "vault-8538",
+      "resources" : [ {
+        "id" : "94eba8b2-acc9-4d82-badc-127144cc5526",
+        "name" : "ecs-1",
+        "type" : "OS::Nova::Server",
+        "protect_status" : "available",
+        "resource_size" : "40",
+        "backup_size" : "0",
+        "backup_count" : "0"
+      } ],
+      "skipped_resources" : [ ]
+    },
+    "project_id" : "4229d7a45436489f8c3dc2b1d35d4987",
+    "id" : "8b0851a8-adf3-4f4c-a914-dead08bf9664",
+    "extra_info" : {
+      "name" : "manualbk_upgrade",
+      "description" : "backup before upgrade",
+      "retention_duration" : -1
+    }
+  }
+}`
+)
+
+func handleCheckpointCreate(t *testing.T) {
+	th.Mux.HandleFunc("/checkpoints", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, expectedRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprint(w, expectedResponse)
+	})
+}
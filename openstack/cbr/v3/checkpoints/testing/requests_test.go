@@ -0,0 +1,29 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/cbr/v3/checkpoints"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreateV3Checkpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleCheckpointCreate(t)
+
+	opts := checkpoints.CreateOpts{
+		VaultID: "3b5816b5-f29c-4172-9d9a-76c719a659ce",
+		Parameters: &checkpoints.CheckpointParams{
+			Description: "backup before upgrade",
+			Name:        "manualbk_upgrade",
+			Resources:   []string{"94eba8b2-acc9-4d82-badc-127144cc5526"},
+		},
+	}
+	actual, err := checkpoints.Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "8b0851a8-adf3-4f4c-a914-dead08bf9664", actual.ID)
+	th.AssertEquals(t, "protecting", actual.Status)
+	th.AssertEquals(t, "40", actual.Vault.Resources[0].ResourceSize)
+}
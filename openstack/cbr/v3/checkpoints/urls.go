@@ -0,0 +1,13 @@
+package checkpoints
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "checkpoints"
+
+func rootURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL(resourcePath)
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL(resourcePath, id)
+}
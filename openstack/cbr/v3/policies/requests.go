@@ -93,7 +93,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 //Get is a method to obtain the specified CBR policy according to the policy ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -128,6 +129,7 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 
 //Delete is a method to delete an existing CBR policy
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
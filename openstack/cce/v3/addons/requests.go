@@ -17,7 +17,8 @@ type ListOpts struct {
 // List returns collection of addons.
 func List(client *golangsdk.ServiceClient, clusterID string, opts ListOpts) ([]Addon, error) {
 	var r ListResult
-	_, r.Err = client.Get(resourceListURL(client, clusterID), &r.Body, nil)
+	resp, err := client.Get(resourceListURL(client, clusterID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allAddons, err := r.ExtractAddon()
 
@@ -140,19 +141,22 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder, cluster_id strin
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c, cluster_id), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, cluster_id), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular addon based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id, cluster_id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id, cluster_id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id, cluster_id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular addon based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id, cluster_id string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Delete(resourceURL(c, id, cluster_id), reqOpt)
+	resp, err := c.Delete(resourceURL(c, id, cluster_id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -22,10 +22,11 @@ type ListOpts struct {
 // List returns collection of clusters.
 func List(client *golangsdk.ServiceClient, opts ListOpts) ([]Clusters, error) {
 	var r ListResult
-	_, r.Err = client.Get(rootURL(client), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(rootURL(client), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allClusters, err := r.ExtractClusters()
 	if err != nil {
@@ -135,25 +136,28 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular cluster based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetCert retrieves a particular cluster certificate based on its unique ID.
 func GetCert(c *golangsdk.ServiceClient, id string) (r GetCertResult) {
-	_, r.Err = c.Get(certificateURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(certificateURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -185,9 +189,10 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -222,19 +227,21 @@ func DeleteWithOpts(c *golangsdk.ServiceClient, id string, opts DeleteOptsBuilde
 		}
 		url += query
 	}
-	_, r.Err = c.Delete(url, &golangsdk.RequestOpts{
+	resp, err := c.Delete(url, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular cluster based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), &golangsdk.RequestOpts{
+	resp, err := c.Delete(resourceURL(c, id), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -263,16 +270,18 @@ func UpdateMasterIp(c *golangsdk.ServiceClient, id string, opts UpdateIpOptsBuil
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(masterIpURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(masterIpURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Operation(c *golangsdk.ServiceClient, id, action string) (r OperationResult) {
-	_, r.Err = c.Post(operationURL(c, id, action), nil, nil, &golangsdk.RequestOpts{
+	resp, err := c.Post(operationURL(c, id, action), nil, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -21,10 +21,11 @@ type ListOpts struct {
 // List returns collection of node pools.
 func List(client *golangsdk.ServiceClient, clusterID string, opts ListOpts) ([]NodePool, error) {
 	var r ListResult
-	_, r.Err = client.Get(rootURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(rootURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allNodePools, err := r.ExtractNodePool()
 
@@ -141,16 +142,18 @@ func Create(c *golangsdk.ServiceClient, clusterid string, opts CreateOptsBuilder
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c, clusterid), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, clusterid), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular node pool based on its unique ID and cluster ID.
 func Get(c *golangsdk.ServiceClient, clusterid, nodepoolid string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, clusterid, nodepoolid), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, clusterid, nodepoolid), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -202,17 +205,19 @@ func Update(c *golangsdk.ServiceClient, clusterid, nodepoolid string, opts Updat
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, clusterid, nodepoolid), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, clusterid, nodepoolid), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular node pool based on its unique ID and cluster ID.
 func Delete(c *golangsdk.ServiceClient, clusterid, nodepoolid string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, clusterid, nodepoolid), &golangsdk.RequestOpts{
+	resp, err := c.Delete(resourceURL(c, clusterid, nodepoolid), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -20,10 +20,11 @@ type ListOpts struct {
 // List returns collection of nodes.
 func List(client *golangsdk.ServiceClient, clusterID string, opts ListOpts) ([]Nodes, error) {
 	var r ListResult
-	_, r.Err = client.Get(rootURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(rootURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allNodes, err := r.ExtractNode()
 
@@ -130,7 +131,8 @@ func Create(c *golangsdk.ServiceClient, clusterid string, opts CreateOptsBuilder
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c, clusterid), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, clusterid), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -192,16 +194,18 @@ func Add(c *golangsdk.ServiceClient, clusterid string, opts AddOptsBuilder) (r A
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(addNodeURL(c, clusterid), b, &r.Body, reqOpt)
+	resp, err := c.Post(addNodeURL(c, clusterid), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular nodes based on its unique ID and cluster ID.
 func Get(c *golangsdk.ServiceClient, clusterid, nodeid string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, clusterid, nodeid), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, clusterid, nodeid), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -232,18 +236,20 @@ func Update(c *golangsdk.ServiceClient, clusterid, nodeid string, opts UpdateOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, clusterid, nodeid), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, clusterid, nodeid), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular node based on its unique ID and cluster ID.
 func Delete(c *golangsdk.ServiceClient, clusterid, nodeid string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, clusterid, nodeid), &golangsdk.RequestOpts{
+	resp, err := c.Delete(resourceURL(c, clusterid, nodeid), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -280,19 +286,21 @@ func Remove(c *golangsdk.ServiceClient, clusterid string, opts RemoveOptsBuilder
 		return
 	}
 
-	_, r.Err = c.Put(removeNodeURL(c, clusterid), b, nil, &golangsdk.RequestOpts{
+	resp, err := c.Put(removeNodeURL(c, clusterid), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // GetJobDetails retrieves a particular job based on its unique ID
 func GetJobDetails(c *golangsdk.ServiceClient, jobid string) (r GetResult) {
-	_, r.Err = c.Get(getJobURL(c, jobid), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(getJobURL(c, jobid), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -3,6 +3,7 @@ package templates
 import "github.com/huaweicloud/golangsdk"
 
 func List(client *golangsdk.ServiceClient, cluster_id string) (r ListResutlt) {
-	_, r.Err = client.Get(templateURL(client, cluster_id), &r.Body, nil)
+	resp, err := client.Get(templateURL(client, cluster_id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -63,24 +63,27 @@ func Create(c *golangsdk.ServiceClient, ns string, opts CreateOptsBuilder) (r Cr
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c, ns), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, ns), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular network based on its unique ID.
 func Get(c *golangsdk.ServiceClient, ns, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, ns, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, ns, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular network based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, ns, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, ns, id), &golangsdk.RequestOpts{
+	resp, err := c.Delete(resourceURL(c, ns, id), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -84,9 +84,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder, ns string)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, ns), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, ns), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -125,8 +126,9 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder, ns string) pagi
 
 // Delete accepts to delete the specifies persistent volume claim form the namespace.
 func Delete(client *golangsdk.ServiceClient, ns, name string) (r DeleteResult) {
-	_, r.Err = client.DeleteWithBodyResp(resourceURL(client, ns, name), nil, r.Body, &golangsdk.RequestOpts{
+	resp, err := client.DeleteWithBodyResp(resourceURL(client, ns, name), nil, r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
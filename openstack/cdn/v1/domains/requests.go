@@ -74,7 +74,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -89,7 +90,8 @@ func Get(client *golangsdk.ServiceClient, id string, opts *ExtensionOpts) (r Get
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -106,7 +108,8 @@ func Delete(client *golangsdk.ServiceClient, id string, opts *ExtensionOpts) (r
 	}
 
 	// Delete requests will response 'domain' body, so we use DeleteWithResponse
-	_, r.Err = client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -122,7 +125,8 @@ func Enable(client *golangsdk.ServiceClient, id string, opts *ExtensionOpts) (r
 		url += query
 	}
 
-	_, r.Err = client.Put(url, nil, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(url, nil, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -138,7 +142,8 @@ func Disable(client *golangsdk.ServiceClient, id string, opts *ExtensionOpts) (r
 		url += query
 	}
 
-	_, r.Err = client.Put(url, nil, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(url, nil, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -162,6 +167,7 @@ func Origin(client *golangsdk.ServiceClient, id string, opts *ExtensionOpts, req
 		return
 	}
 
-	_, r.Err = client.Put(url, reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(url, reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,116 @@
+package tasks
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ExtensionOpts allows extensions to add parameters to some requests
+// the possible requests include refresh, preheat and get.
+type ExtensionOpts struct {
+	// specifies the enterprise_project_id.
+	EnterpriseProjectId string `q:"enterprise_project_id"`
+}
+
+// ToExtensionQuery formats a ExtensionOpts into a query string.
+func (opts ExtensionOpts) ToExtensionQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// RefreshOpts specifies the cached content to purge from the CDN nodes.
+type RefreshOpts struct {
+	// the type of the refresh, valid values are file and directory
+	Type string `json:"type,omitempty"`
+	// the URLs to refresh, a directory URL must end with a slash
+	Urls []string `json:"urls" required:"true"`
+}
+
+// RefreshOptsBuilder allows extensions to add additional parameters to the
+// Refresh request.
+type RefreshOptsBuilder interface {
+	ToCdnRefreshTaskMap() (map[string]interface{}, error)
+}
+
+// ToCdnRefreshTaskMap assembles a request body based on the contents of a
+// RefreshOpts.
+func (opts RefreshOpts) ToCdnRefreshTaskMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "refreshTask")
+}
+
+// PreheatOpts specifies the content to load into the CDN nodes in advance.
+type PreheatOpts struct {
+	// the URLs to preheat
+	Urls []string `json:"urls" required:"true"`
+}
+
+// PreheatOptsBuilder allows extensions to add additional parameters to the
+// Preheat request.
+type PreheatOptsBuilder interface {
+	ToCdnPreheatTaskMap() (map[string]interface{}, error)
+}
+
+// ToCdnPreheatTaskMap assembles a request body based on the contents of a
+// PreheatOpts.
+func (opts PreheatOpts) ToCdnPreheatTaskMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "preheatingTask")
+}
+
+func withExtension(url string, opts *ExtensionOpts) (string, error) {
+	if opts == nil {
+		return url, nil
+	}
+	query, err := opts.ToExtensionQuery()
+	if err != nil {
+		return "", err
+	}
+	return url + query, nil
+}
+
+// Refresh creates a task which purges cached content from the CDN nodes.
+func Refresh(client *golangsdk.ServiceClient, opts RefreshOptsBuilder, ext *ExtensionOpts) (r RefreshResult) {
+	reqBody, err := opts.ToCdnRefreshTaskMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url, err := withExtension(refreshURL(client), ext)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(url, reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Preheat creates a task which caches content in the CDN nodes in advance.
+func Preheat(client *golangsdk.ServiceClient, opts PreheatOptsBuilder, ext *ExtensionOpts) (r PreheatResult) {
+	reqBody, err := opts.ToCdnPreheatTaskMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url, err := withExtension(preheatURL(client), ext)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(url, reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the details of a refresh or preheating task.
+func Get(client *golangsdk.ServiceClient, id string, ext *ExtensionOpts) (r GetResult) {
+	url, err := withExtension(getURL(client, id), ext)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,74 @@
+package tasks
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Task is a refresh or preheating task.
+type Task struct {
+	// the task ID
+	ID string `json:"id"`
+	// the task type, refresh or preheating
+	TaskType string `json:"task_type"`
+	// the task status, task_done or task_inprocess
+	Status string `json:"status"`
+	// the number of URLs being processed
+	Processing int `json:"processing"`
+	// the number of URLs processed successfully
+	Succeed int `json:"succeed"`
+	// the number of URLs which failed to be processed
+	Failed int `json:"failed"`
+	// the total number of URLs of the task
+	Total int `json:"total"`
+	// the creation time, in milliseconds since the epoch
+	CreateTime int64 `json:"create_time"`
+}
+
+// TaskUrl is the processing state of an URL of a task.
+type TaskUrl struct {
+	ID         string `json:"id"`
+	Url        string `json:"url"`
+	Status     string `json:"status"`
+	Type       string `json:"type"`
+	TaskID     string `json:"task_id"`
+	CreateTime int64  `json:"create_time"`
+}
+
+// TaskDetail is a task along with the state of each of its URLs.
+type TaskDetail struct {
+	Task
+	Urls []TaskUrl `json:"urls"`
+}
+
+// RefreshResult is the result of a Refresh request.
+type RefreshResult struct {
+	golangsdk.Result
+}
+
+func (r RefreshResult) Extract() (*Task, error) {
+	var task Task
+	err := r.Result.ExtractIntoStructPtr(&task, "refreshTask")
+	return &task, err
+}
+
+// PreheatResult is the result of a Preheat request.
+type PreheatResult struct {
+	golangsdk.Result
+}
+
+func (r PreheatResult) Extract() (*Task, error) {
+	var task Task
+	err := r.Result.ExtractIntoStructPtr(&task, "preheatingTask")
+	return &task, err
+}
+
+// GetResult is the result of a Get request.
+type GetResult struct {
+	golangsdk.Result
+}
+
+func (r GetResult) Extract() (*TaskDetail, error) {
+	var task TaskDetail
+	err := r.ExtractInto(&task)
+	return &task, err
+}
@@ -0,0 +1,15 @@
+package tasks
+
+import "github.com/huaweicloud/golangsdk"
+
+func refreshURL(sc *golangsdk.ServiceClient) string {
+	return sc.ServiceURL("cdn", "refreshtasks")
+}
+
+func preheatURL(sc *golangsdk.ServiceClient) string {
+	return sc.ServiceURL("cdn", "preheatingtasks")
+}
+
+func getURL(sc *golangsdk.ServiceClient, taskId string) string {
+	return sc.ServiceURL("cdn", "historytasks", taskId, "detail")
+}
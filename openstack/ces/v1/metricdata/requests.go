@@ -53,8 +53,9 @@ func BatchQuery(client *golangsdk.ServiceClient, opts BatchQueryOptsBuilder) (r
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(batchQueryMetricDataURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(batchQueryMetricDataURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -154,9 +155,10 @@ func AddMetricData(client *golangsdk.ServiceClient, opts AddMetricDataOptsBuilde
 		return
 	}
 
-	_, r.Err = client.Post(addMetricDataURL(client), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(addMetricDataURL(client), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -167,9 +169,10 @@ func GetEventData(client *golangsdk.ServiceClient, opts GetEventDataOpts) (r Get
 		return
 	}
 	url := getEventDataURL(client) + q.String()
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -181,9 +184,10 @@ func Get(client *golangsdk.ServiceClient, opts GetOpts) (r GetResult) {
 		return
 	}
 	url := getURL(client) + q.String()
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
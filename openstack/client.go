@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -66,6 +67,7 @@ func NewClient(endpoint string) (*golangsdk.ProviderClient, error) {
 	p := new(golangsdk.ProviderClient)
 	p.IdentityBase = base
 	p.IdentityEndpoint = endpoint
+	p.TokenStore = golangsdk.NewMemoryTokenStore()
 	p.UseTokenLock()
 
 	return p, nil
@@ -104,9 +106,108 @@ func AuthenticatedClient(options golangsdk.AuthOptions) (*golangsdk.ProviderClie
 	return client, nil
 }
 
+/*
+AuthenticatedClientWithHTTPClient is like AuthenticatedClient, but the
+ProviderClient uses httpClient for every request, including the
+authentication itself. This allows to go through a proxy, trust a private
+CA or present a client certificate.
+
+Example:
+
+	transport, err := golangsdk.NewTransport(golangsdk.TransportOpts{
+		CACertFile: "/etc/ssl/private-ca.pem",
+	})
+	httpClient := http.Client{Transport: transport}
+	provider, err := openstack.AuthenticatedClientWithHTTPClient(ao, httpClient)
+*/
+func AuthenticatedClientWithHTTPClient(options golangsdk.AuthOptionsProvider, httpClient http.Client) (*golangsdk.ProviderClient, error) {
+	client, err := NewClient(options.GetIdentityEndpoint())
+	if err != nil {
+		return nil, err
+	}
+	client.HTTPClient = httpClient
+
+	err = Authenticate(client, options)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+/*
+NewProviderFromToken returns a ProviderClient which uses a token obtained
+elsewhere, e.g. from a secret store or another process, instead of
+authenticating. The identity endpoint must be a v3 endpoint.
+
+If catalog is nil, the token is validated against the identity service to
+fetch its service catalog and project. Since the client holds no
+credentials, it can't re-authenticate once the token expires.
+
+Example:
+
+	provider, err := openstack.NewProviderFromToken("https://iam.example.com/v3", tokenID, nil)
+	client, err := openstack.NewComputeV2(provider, golangsdk.EndpointOpts{
+		Region: os.Getenv("OS_REGION_NAME"),
+	})
+*/
+func NewProviderFromToken(identityEndpoint, tokenID string, catalog *tokens3.ServiceCatalog) (*golangsdk.ProviderClient, error) {
+	if tokenID == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "tokenID"}
+	}
+
+	client, err := NewClient(identityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	client.TokenID = tokenID
+
+	if catalog == nil {
+		v3Client, err := NewIdentityV3(client, golangsdk.EndpointOpts{})
+		if err != nil {
+			return nil, err
+		}
+
+		result := tokens3.Get(v3Client, tokenID)
+
+		token, err := result.ExtractToken()
+		if err != nil {
+			return nil, err
+		}
+		client.TokenExpiresAt = token.ExpiresAt
+
+		project, err := result.ExtractProject()
+		if err != nil {
+			return nil, err
+		}
+
+		catalog, err = result.ExtractServiceCatalog()
+		if err != nil {
+			return nil, err
+		}
+
+		if project != nil {
+			client.ProjectID = project.ID
+			client.DomainID = project.Domain.ID
+		}
+	}
+
+	client.EndpointLocator = func(opts golangsdk.EndpointOpts) (string, error) {
+		return V3EndpointURL(catalog, opts)
+	}
+	return client, nil
+}
+
 // Authenticate or re-authenticate against the most recent identity service
-// supported at the provided endpoint.
+// supported at the provided endpoint. The new token is saved to the client's
+// TokenStore, if any.
 func Authenticate(client *golangsdk.ProviderClient, options golangsdk.AuthOptionsProvider) error {
+	if err := authenticate(client, options); err != nil {
+		return err
+	}
+	return client.SaveToken()
+}
+
+func authenticate(client *golangsdk.ProviderClient, options golangsdk.AuthOptionsProvider) error {
 	versions := []*utils.Version{
 		{ID: v2, Priority: 20, Suffix: "/v2.0/"},
 		{ID: v3, Priority: 30, Suffix: "/v3/"},
@@ -166,6 +267,7 @@ func v2auth(client *golangsdk.ProviderClient, endpoint string, options golangsdk
 		IdentityEndpoint: options.IdentityEndpoint,
 		Username:         options.Username,
 		Password:         options.Password,
+		APIKey:           options.APIKey,
 		TenantID:         options.TenantID,
 		TenantName:       options.TenantName,
 		AllowReauth:      options.AllowReauth,
@@ -191,6 +293,7 @@ func v2auth(client *golangsdk.ProviderClient, endpoint string, options golangsdk
 		}
 	}
 	client.TokenID = token.ID
+	client.TokenExpiresAt = token.ExpiresAt
 	client.ProjectID = token.Tenant.ID
 	client.EndpointLocator = func(opts golangsdk.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
@@ -233,6 +336,7 @@ func v3auth(client *golangsdk.ProviderClient, endpoint string, opts tokens3.Auth
 	}
 
 	client.TokenID = token.ID
+	client.TokenExpiresAt = token.ExpiresAt
 	if project != nil {
 		client.ProjectID = project.ID
 		client.DomainID = project.Domain.ID
@@ -419,6 +523,7 @@ func authWithAgencyByAKSK(client *golangsdk.ProviderClient, endpoint string, opt
 	}
 
 	client.TokenID = token.ID
+	client.TokenExpiresAt = token.ExpiresAt
 	if project != nil {
 		client.ProjectID = project.ID
 	}
@@ -705,6 +810,14 @@ func NewLoadBalancerV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOp
 	return sc, err
 }
 
+// NewKeyManagerV1 creates a ServiceClient that may be used with the v1 key
+// manager service.
+func NewKeyManagerV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	sc, err := initClientOpts(client, eo, "key-manager")
+	sc.ResourceBase = sc.Endpoint + "v1/"
+	return sc, err
+}
+
 // NewElbV1 creates a ServiceClient that may be used with the v1 network package.
 func NewElbV1(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts, otctype string) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "compute")
@@ -827,6 +940,20 @@ func NewDNSV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*gol
 	return sc, err
 }
 
+// NewMessagingV2 creates a ServiceClient that may be used with the v2 messaging
+// service. The clientID identifies the client in the Client-ID header which is
+// required by every request of the messaging API, usually as a UUID. An empty
+// clientID returns ErrMissingInput.
+func NewMessagingV2(client *golangsdk.ProviderClient, clientID string, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	if clientID == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "clientID"}
+	}
+	sc, err := initClientOpts(client, eo, "messaging")
+	sc.MoreHeaders = map[string]string{"Client-ID": clientID}
+	sc.ResourceBase = sc.Endpoint + "v2/"
+	return sc, err
+}
+
 // NewNatV2 creates a ServiceClient that may be used with the v2 nat package.
 func NewNatV2(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
 	sc, err := initClientOpts(client, eo, "network")
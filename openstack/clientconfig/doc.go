@@ -0,0 +1,42 @@
+/*
+Package clientconfig builds AuthOptions and authenticated ProviderClients
+from a clouds.yaml file or from the OS_* environment variables, so that
+command line tools don't need to reimplement credential discovery.
+
+The clouds.yaml file is searched in the current directory, then in
+~/.config/openstack and /etc/openstack, unless OS_CLIENT_CONFIG_FILE is set.
+Secrets may be kept apart in a secure.yaml file found in the same
+locations; its entries are merged into the matching clouds.yaml entries.
+
+Example of a clouds.yaml file
+
+	clouds:
+	  mycloud:
+	    auth:
+	      auth_url: https://iam.example.com/v3
+	      username: jdoe
+	      project_name: my-project
+	      user_domain_name: my-domain
+	    region_name: region-1
+
+Example to Create an Authenticated Client
+
+	opts := &clientconfig.ClientOpts{
+		Cloud: "mycloud",
+	}
+
+	provider, err := clientconfig.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the AuthOptions of a Cloud
+
+	ao, err := clientconfig.AuthOptions(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	if err != nil {
+		panic(err)
+	}
+
+	provider, err := openstack.AuthenticatedClient(*ao)
+*/
+package clientconfig
@@ -0,0 +1,298 @@
+package clientconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack"
+	"gopkg.in/yaml.v2"
+)
+
+// ClientOpts represents options to customize the way a client is configured.
+type ClientOpts struct {
+	// Cloud is the cloud entry in clouds.yaml to use. It defaults to the
+	// value of the OS_CLOUD environment variable. When no cloud is selected,
+	// the OS_* environment variables are used instead.
+	Cloud string
+
+	// AuthInfo overrides the auth section of the selected cloud entry.
+	AuthInfo *AuthInfo
+
+	// RegionName overrides the region of the selected cloud entry.
+	RegionName string
+}
+
+// configFileNames returns the paths which are searched for a file named name,
+// in order: the current directory, the user config directory and
+// /etc/openstack.
+func configFileNames(name string) []string {
+	paths := []string{name}
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, filepath.Join(home, ".config", "openstack", name))
+	}
+	return append(paths, filepath.Join("/etc", "openstack", name))
+}
+
+// findAndReadYAML reads the first file found among paths. It returns a nil
+// slice if none of them exists.
+func findAndReadYAML(paths []string) ([]byte, error) {
+	for _, p := range paths {
+		content, err := ioutil.ReadFile(p)
+		if err == nil {
+			return content, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// LoadCloudsYAML reads the clouds.yaml file. The file given by the
+// OS_CLIENT_CONFIG_FILE environment variable takes precedence over the
+// standard locations.
+func LoadCloudsYAML() (map[string]Cloud, error) {
+	paths := configFileNames("clouds.yaml")
+	if v := os.Getenv("OS_CLIENT_CONFIG_FILE"); v != "" {
+		paths = []string{v}
+	}
+	return loadClouds(paths)
+}
+
+// LoadSecureCloudsYAML reads the secure.yaml file, which holds the secrets
+// of the entries of clouds.yaml. It returns an empty map if there is none.
+func LoadSecureCloudsYAML() (map[string]Cloud, error) {
+	return loadClouds(configFileNames("secure.yaml"))
+}
+
+func loadClouds(paths []string) (map[string]Cloud, error) {
+	content, err := findAndReadYAML(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var clouds Clouds
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml: %s", err)
+	}
+	return clouds.Clouds, nil
+}
+
+// GetCloudFromYAML returns the cloud entry selected by opts, with the
+// matching secure.yaml entry merged into it.
+func GetCloudFromYAML(opts *ClientOpts) (*Cloud, error) {
+	clouds, err := LoadCloudsYAML()
+	if err != nil {
+		return nil, err
+	}
+	secureClouds, err := LoadSecureCloudsYAML()
+	if err != nil {
+		return nil, err
+	}
+	return selectCloud(opts, clouds, secureClouds)
+}
+
+func cloudName(opts *ClientOpts) string {
+	if opts != nil && opts.Cloud != "" {
+		return opts.Cloud
+	}
+	return os.Getenv("OS_CLOUD")
+}
+
+func selectCloud(opts *ClientOpts, clouds, secureClouds map[string]Cloud) (*Cloud, error) {
+	name := cloudName(opts)
+	cloud, ok := clouds[name]
+	if !ok {
+		return nil, fmt.Errorf("cloud %s does not exist in clouds.yaml", name)
+	}
+
+	if secure, ok := secureClouds[name]; ok {
+		merged, err := mergeClouds(secure, cloud)
+		if err != nil {
+			return nil, fmt.Errorf("unable to merge information from clouds.yaml and secure.yaml: %s", err)
+		}
+		cloud = *merged
+	}
+
+	if opts != nil {
+		if opts.AuthInfo != nil {
+			cloud.AuthInfo = opts.AuthInfo
+		}
+		if opts.RegionName != "" {
+			cloud.RegionName = opts.RegionName
+		}
+	}
+	return &cloud, nil
+}
+
+// mergeClouds merges override into base. The values of override take
+// precedence, and nested sections are merged key by key.
+func mergeClouds(override, base Cloud) (*Cloud, error) {
+	var baseMap, overrideMap map[interface{}]interface{}
+	for _, c := range []struct {
+		cloud Cloud
+		m     *map[interface{}]interface{}
+	}{{base, &baseMap}, {override, &overrideMap}} {
+		b, err := yaml.Marshal(c.cloud)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(b, c.m); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := yaml.Marshal(mergeMaps(baseMap, overrideMap))
+	if err != nil {
+		return nil, err
+	}
+	var merged Cloud
+	if err := yaml.Unmarshal(b, &merged); err != nil {
+		return nil, err
+	}
+	return &merged, nil
+}
+
+func mergeMaps(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	if base == nil {
+		base = make(map[interface{}]interface{})
+	}
+	for k, v := range override {
+		bv, bok := base[k].(map[interface{}]interface{})
+		ov, ook := v.(map[interface{}]interface{})
+		if bok && ook {
+			base[k] = mergeMaps(bv, ov)
+		} else {
+			base[k] = v
+		}
+	}
+	return base
+}
+
+// AuthOptions returns the AuthOptions of the cloud entry selected by opts.
+// When no cloud is selected, they are built from the OS_* environment
+// variables by openstack.AuthOptionsFromEnv.
+func AuthOptions(opts *ClientOpts) (*golangsdk.AuthOptions, error) {
+	if cloudName(opts) == "" {
+		ao, err := openstack.AuthOptionsFromEnv()
+		return &ao, err
+	}
+
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+	return cloud.AuthOptions()
+}
+
+// AuthOptions converts the auth section of the cloud entry into
+// AuthOptions.
+func (c *Cloud) AuthOptions() (*golangsdk.AuthOptions, error) {
+	auth := c.AuthInfo
+	if auth == nil || auth.AuthURL == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "auth_url"}
+	}
+
+	ao := &golangsdk.AuthOptions{
+		IdentityEndpoint: auth.AuthURL,
+		TokenID:          auth.Token,
+		Username:         auth.Username,
+		UserID:           auth.UserID,
+		Password:         auth.Password,
+		TenantID:         auth.ProjectID,
+		TenantName:       auth.ProjectName,
+		DomainID:         auth.UserDomainID,
+		DomainName:       auth.UserDomainName,
+		AllowReauth:      true,
+	}
+	if ao.DomainID == "" {
+		ao.DomainID = auth.DomainID
+	}
+	if ao.DomainName == "" {
+		ao.DomainName = auth.DomainName
+	}
+	return ao, nil
+}
+
+// AKSKAuthOptions converts the auth section of the cloud entry into
+// AKSKAuthOptions.
+func (c *Cloud) AKSKAuthOptions() (*golangsdk.AKSKAuthOptions, error) {
+	auth := c.AuthInfo
+	if auth == nil || auth.AuthURL == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "auth_url"}
+	}
+	if auth.AccessKey == "" || auth.SecretKey == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "access_key"}
+	}
+
+	domainID, domainName := auth.UserDomainID, auth.UserDomainName
+	if domainID == "" {
+		domainID = auth.DomainID
+	}
+	if domainName == "" {
+		domainName = auth.DomainName
+	}
+
+	return &golangsdk.AKSKAuthOptions{
+		IdentityEndpoint: auth.AuthURL,
+		Region:           c.RegionName,
+		ProjectId:        auth.ProjectID,
+		ProjectName:      auth.ProjectName,
+		Domain:           domainName,
+		DomainID:         domainID,
+		AccessKey:        auth.AccessKey,
+		SecretKey:        auth.SecretKey,
+		SecurityToken:    auth.SecurityToken,
+	}, nil
+}
+
+// AuthenticatedClient returns a ProviderClient authenticated with the cloud
+// entry selected by opts, or with the OS_* environment variables when no
+// cloud is selected. The verify and cacert settings of the cloud entry are
+// applied to the HTTP client.
+func AuthenticatedClient(opts *ClientOpts) (*golangsdk.ProviderClient, error) {
+	if cloudName(opts) == "" {
+		ao, err := AuthOptions(opts)
+		if err != nil {
+			return nil, err
+		}
+		return openstack.AuthenticatedClient(*ao)
+	}
+
+	cloud, err := GetCloudFromYAML(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var provider golangsdk.AuthOptionsProvider
+	if cloud.AuthInfo != nil && cloud.AuthInfo.AccessKey != "" {
+		aksk, err := cloud.AKSKAuthOptions()
+		if err != nil {
+			return nil, err
+		}
+		provider = *aksk
+	} else {
+		ao, err := cloud.AuthOptions()
+		if err != nil {
+			return nil, err
+		}
+		provider = *ao
+	}
+
+	transportOpts := golangsdk.TransportOpts{
+		CACertFile: cloud.CACertFile,
+	}
+	if cloud.Verify != nil {
+		transportOpts.InsecureSkipVerify = !*cloud.Verify
+	}
+	transport, err := golangsdk.NewTransport(transportOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return openstack.AuthenticatedClientWithHTTPClient(provider, http.Client{Transport: transport})
+}
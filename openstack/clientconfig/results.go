@@ -0,0 +1,45 @@
+package clientconfig
+
+// Clouds represents a collection of Cloud entries in a clouds.yaml file.
+type Clouds struct {
+	Clouds map[string]Cloud `yaml:"clouds"`
+}
+
+// Cloud represents an entry in a clouds.yaml or secure.yaml file.
+type Cloud struct {
+	AuthInfo   *AuthInfo `yaml:"auth,omitempty"`
+	AuthType   string    `yaml:"auth_type,omitempty"`
+	RegionName string    `yaml:"region_name,omitempty"`
+
+	// Verify and CACertFile configure the TLS verification of the
+	// identity and service endpoints.
+	Verify     *bool  `yaml:"verify,omitempty"`
+	CACertFile string `yaml:"cacert,omitempty"`
+
+	// IdentityAPIVersion is the version of the identity API, "2" or "3".
+	IdentityAPIVersion string `yaml:"identity_api_version,omitempty"`
+}
+
+// AuthInfo represents the auth section of a cloud entry.
+type AuthInfo struct {
+	AuthURL string `yaml:"auth_url,omitempty"`
+	Token   string `yaml:"token,omitempty"`
+
+	Username string `yaml:"username,omitempty"`
+	UserID   string `yaml:"user_id,omitempty"`
+	Password string `yaml:"password,omitempty"`
+
+	// AccessKey and SecretKey are used instead of a username and password
+	// to authenticate with an AK/SK pair.
+	AccessKey     string `yaml:"access_key,omitempty"`
+	SecretKey     string `yaml:"secret_key,omitempty"`
+	SecurityToken string `yaml:"security_token,omitempty"`
+
+	ProjectName string `yaml:"project_name,omitempty"`
+	ProjectID   string `yaml:"project_id,omitempty"`
+
+	UserDomainName string `yaml:"user_domain_name,omitempty"`
+	UserDomainID   string `yaml:"user_domain_id,omitempty"`
+	DomainName     string `yaml:"domain_name,omitempty"`
+	DomainID       string `yaml:"domain_id,omitempty"`
+}
@@ -0,0 +1,95 @@
+package testing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/clientconfig"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestGetCloudFromYAML(t *testing.T) {
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "hawaii"})
+	th.AssertNoErr(t, err)
+
+	verify := false
+	expected := &clientconfig.Cloud{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:        "https://hi.example.com:5000/v3",
+			Username:       "jdoe",
+			Password:       "password",
+			ProjectName:    "Some Project",
+			UserDomainName: "default",
+		},
+		RegionName: "HNL",
+		Verify:     &verify,
+	}
+	th.CheckDeepEquals(t, expected, cloud)
+}
+
+func TestGetCloudFromYAMLOverrides(t *testing.T) {
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{
+		Cloud:      "hawaii",
+		RegionName: "OGG",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "OGG", cloud.RegionName)
+
+	_, err = clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "nowhere"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown cloud")
+	}
+}
+
+func TestAuthOptionsFromCloud(t *testing.T) {
+	os.Setenv("OS_CLOUD", "hawaii")
+	defer os.Unsetenv("OS_CLOUD")
+
+	ao, err := clientconfig.AuthOptions(nil)
+	th.AssertNoErr(t, err)
+
+	expected := &golangsdk.AuthOptions{
+		IdentityEndpoint: "https://hi.example.com:5000/v3",
+		Username:         "jdoe",
+		Password:         "password",
+		TenantName:       "Some Project",
+		DomainName:       "default",
+		AllowReauth:      true,
+	}
+	th.CheckDeepEquals(t, expected, ao)
+}
+
+func TestAKSKAuthOptionsFromCloud(t *testing.T) {
+	cloud, err := clientconfig.GetCloudFromYAML(&clientconfig.ClientOpts{Cloud: "akskcloud"})
+	th.AssertNoErr(t, err)
+
+	aksk, err := cloud.AKSKAuthOptions()
+	th.AssertNoErr(t, err)
+
+	expected := &golangsdk.AKSKAuthOptions{
+		IdentityEndpoint: "https://iam.example.com/v3",
+		Region:           "region-1",
+		ProjectId:        "0123456789",
+		AccessKey:        "AK",
+		SecretKey:        "SK",
+	}
+	th.CheckDeepEquals(t, expected, aksk)
+}
+
+func TestAuthOptionsFromEnv(t *testing.T) {
+	os.Setenv("OS_AUTH_URL", "https://env.example.com/v3")
+	os.Setenv("OS_USERNAME", "envuser")
+	os.Setenv("OS_PASSWORD", "envpass")
+	defer func() {
+		os.Unsetenv("OS_AUTH_URL")
+		os.Unsetenv("OS_USERNAME")
+		os.Unsetenv("OS_PASSWORD")
+	}()
+
+	ao, err := clientconfig.AuthOptions(nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://env.example.com/v3", ao.IdentityEndpoint)
+	th.CheckEquals(t, "envuser", ao.Username)
+	th.CheckEquals(t, "envpass", ao.Password)
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 type CreateOptsBuilder interface {
@@ -36,6 +37,22 @@ type ActionOpts struct {
 	NotificationList []string `json:"notificationList" required:"true"`
 }
 
+// LoadBalancerMetric returns the MetricOpts of an alarm rule which watches
+// the given metric (e.g. "m1_cps" or "m5_unhealthy_servers") of an enhanced
+// load balancer.
+func LoadBalancerMetric(loadbalancerID, metricName string) MetricOpts {
+	return MetricOpts{
+		Namespace:  "SYS.ELB",
+		MetricName: metricName,
+		Dimensions: []DimensionOpts{
+			{
+				Name:  "lbaas_instance_id",
+				Value: loadbalancerID,
+			},
+		},
+	}
+}
+
 type CreateOpts struct {
 	AlarmName               string        `json:"alarm_name" required:"true"`
 	AlarmDescription        string        `json:"alarm_description,omitempty"`
@@ -63,12 +80,46 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	}
 	log.Printf("[DEBUG] create AlarmRule url:%q, body=%#v, opt=%#v", rootURL(c), b, opts)
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{201}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
+type ListOptsBuilder interface {
+	ToAlarmRuleListQuery() (string, error)
+}
+
+type ListOpts struct {
+	// The value ranges from 1 to 100, and is 100 by default.
+	Limit int `q:"limit"`
+	// Specifies the sorting order of query results, asc or desc.
+	Order string `q:"order"`
+	// Specifies the ID of the alarm rule from which the query starts.
+	Start string `q:"start"`
+}
+
+func (opts ListOpts) ToAlarmRuleListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToAlarmRuleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AlarmRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -98,9 +149,10 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOpts) (r UpdateRes
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -122,14 +174,16 @@ func Enable(c *golangsdk.ServiceClient, id string, opts EnableOpts) (r EnableRes
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(actionURL(c, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := c.Put(actionURL(c, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204}}
-	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	resp, err := c.Delete(resourceURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
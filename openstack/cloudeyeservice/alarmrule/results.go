@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 type CreateResponse struct {
@@ -45,6 +46,7 @@ type ActionInfo struct {
 }
 
 type AlarmRule struct {
+	AlarmID                 string        `json:"alarm_id"`
 	AlarmName               string        `json:"alarm_name"`
 	AlarmDescription        string        `json:"alarm_description"`
 	AlarmType               string        `json:"alarm_type"`
@@ -79,6 +81,51 @@ func (g GetResult) Extract() (*AlarmRule, error) {
 	return &(r.MetricAlarms[0]), nil
 }
 
+type MetaData struct {
+	Count  int    `json:"count"`
+	Marker string `json:"marker"`
+	Total  int    `json:"total"`
+}
+
+type AlarmRulePage struct {
+	pagination.LinkedPageBase
+}
+
+func (r AlarmRulePage) IsEmpty() (bool, error) {
+	rules, err := ExtractAlarmRules(r)
+	return len(rules) == 0, err
+}
+
+// NextPageURL builds the URL of the next page from the marker returned in the
+// meta_data of the current page.
+func (r AlarmRulePage) NextPageURL() (string, error) {
+	var s struct {
+		MetricAlarms []AlarmRule `json:"metric_alarms"`
+		MetaData     MetaData    `json:"meta_data"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	if s.MetaData.Marker == "" || len(s.MetricAlarms) == 0 {
+		return "", nil
+	}
+
+	url := r.URL
+	q := url.Query()
+	q.Set("start", s.MetaData.Marker)
+	url.RawQuery = q.Encode()
+	return url.String(), nil
+}
+
+func ExtractAlarmRules(r pagination.Page) ([]AlarmRule, error) {
+	var s struct {
+		MetricAlarms []AlarmRule `json:"metric_alarms"`
+	}
+	err := r.(AlarmRulePage).ExtractInto(&s)
+	return s.MetricAlarms, err
+}
+
 type UpdateResult struct {
 	golangsdk.ErrResult
 }
@@ -7,7 +7,8 @@ import (
 
 // Get retrieves information for a specific extension using its alias.
 func Get(c *golangsdk.ServiceClient, alias string) (r GetResult) {
-	_, r.Err = c.Get(ExtensionURL(c, alias), &r.Body, nil)
+	resp, err := c.Get(ExtensionURL(c, alias), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
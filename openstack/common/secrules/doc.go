@@ -0,0 +1,29 @@
+/*
+Package secrules provides RuleOpts, a description of a security group rule
+shared by the security groups of the compute (os-security-groups) and
+networking (security-groups) services.
+
+The same rule can be converted into the create options of either service with
+secgroups.NewCreateRuleOpts or rules.NewCreateOpts, which check that the
+service supports it.
+
+Example to Open HTTPS to the Internet
+
+	rule := secrules.RuleOpts{
+		Protocol:     secrules.ProtocolTCP,
+		PortRangeMin: 443,
+		PortRangeMax: 443,
+		RemoteCIDR:   "0.0.0.0/0",
+	}
+
+	createOpts, err := rules.NewCreateOpts("a7734e61-b545-452d-a3cd-0189cbd9747a", rule)
+	if err != nil {
+		panic(err)
+	}
+
+	secRule, err := rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package secrules
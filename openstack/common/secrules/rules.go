@@ -0,0 +1,136 @@
+package secrules
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Direction is the direction of the traffic matched by a rule.
+type Direction string
+
+// EtherType is the IP version of the traffic matched by a rule.
+type EtherType string
+
+// Constants useful for RuleOpts
+const (
+	DirectionIngress Direction = "ingress"
+	DirectionEgress  Direction = "egress"
+
+	EtherTypeIPv4 EtherType = "IPv4"
+	EtherTypeIPv6 EtherType = "IPv6"
+
+	ProtocolTCP      = "tcp"
+	ProtocolUDP      = "udp"
+	ProtocolICMP     = "icmp"
+	ProtocolIPv6ICMP = "ipv6-icmp"
+)
+
+// RuleOpts describes a security group rule independently of the service
+// managing the group.
+type RuleOpts struct {
+	// Direction is the direction of the traffic matched by the rule. It
+	// defaults to DirectionIngress.
+	Direction Direction
+
+	// EtherType is the IP version of the traffic matched by the rule. It
+	// defaults to the version of RemoteCIDR, or EtherTypeIPv4.
+	EtherType EtherType
+
+	// Protocol is the IP protocol matched by the rule, e.g. ProtocolTCP. The
+	// rule matches every protocol if it is empty.
+	Protocol string
+
+	// PortRangeMin and PortRangeMax are the bounds of the port range matched by
+	// the rule, or the ICMP type and code for ICMP rules. The rule matches
+	// every port if both are 0.
+	PortRangeMin int
+	PortRangeMax int
+
+	// RemoteCIDR is the IP range the traffic comes from, or goes to for egress
+	// rules. Only one of RemoteCIDR and RemoteGroupID can be set; the rule
+	// matches any address if both are empty.
+	RemoteCIDR string
+
+	// RemoteGroupID is the ID of the security group of the ports the traffic
+	// comes from, or goes to for egress rules.
+	RemoteGroupID string
+
+	// Description is a description of the rule.
+	Description string
+}
+
+// Normalize checks the rule and returns it with the default direction and
+// ether type set.
+func (opts RuleOpts) Normalize() (RuleOpts, error) {
+	switch opts.Direction {
+	case "":
+		opts.Direction = DirectionIngress
+	case DirectionIngress, DirectionEgress:
+	default:
+		return opts, invalidInput("Direction", opts.Direction, "The direction must be ingress or egress")
+	}
+
+	if opts.RemoteCIDR != "" && opts.RemoteGroupID != "" {
+		return opts, invalidInput("RemoteCIDR", opts.RemoteCIDR, "Only one of RemoteCIDR and RemoteGroupID can be set")
+	}
+
+	cidrType := EtherType("")
+	if opts.RemoteCIDR != "" {
+		ip, _, err := net.ParseCIDR(opts.RemoteCIDR)
+		if err != nil {
+			return opts, invalidInput("RemoteCIDR", opts.RemoteCIDR, err.Error())
+		}
+		cidrType = EtherTypeIPv6
+		if ip.To4() != nil {
+			cidrType = EtherTypeIPv4
+		}
+	}
+
+	switch opts.EtherType {
+	case "":
+		opts.EtherType = cidrType
+		if opts.EtherType == "" {
+			opts.EtherType = EtherTypeIPv4
+		}
+	case EtherTypeIPv4, EtherTypeIPv6:
+		if cidrType != "" && cidrType != opts.EtherType {
+			return opts, invalidInput("RemoteCIDR", opts.RemoteCIDR, fmt.Sprintf("The CIDR is not an %s one", opts.EtherType))
+		}
+	default:
+		return opts, invalidInput("EtherType", opts.EtherType, "The ether type must be IPv4 or IPv6")
+	}
+
+	if opts.PortRangeMin == 0 && opts.PortRangeMax == 0 {
+		return opts, nil
+	}
+	if opts.Protocol == "" {
+		return opts, invalidInput("Protocol", opts.Protocol, "A port range requires a protocol")
+	}
+	if opts.IsICMP() {
+		if opts.PortRangeMin < 0 || opts.PortRangeMin > 255 || opts.PortRangeMax < 0 || opts.PortRangeMax > 255 {
+			return opts, invalidInput("PortRangeMin", opts.PortRangeMin, "ICMP types and codes must be between 0 and 255")
+		}
+		return opts, nil
+	}
+	if opts.PortRangeMin < 1 || opts.PortRangeMax > 65535 || opts.PortRangeMin > opts.PortRangeMax {
+		return opts, invalidInput("PortRangeMin", opts.PortRangeMin,
+			fmt.Sprintf("Invalid port range %d-%d", opts.PortRangeMin, opts.PortRangeMax))
+	}
+	return opts, nil
+}
+
+// IsICMP reports whether the rule matches ICMP traffic, in which case the
+// port range holds the ICMP type and code.
+func (opts RuleOpts) IsICMP() bool {
+	return opts.Protocol == ProtocolICMP || opts.Protocol == ProtocolIPv6ICMP
+}
+
+func invalidInput(argument string, value interface{}, info string) error {
+	err := golangsdk.ErrInvalidInput{}
+	err.Argument = "secrules.RuleOpts." + argument
+	err.Value = value
+	err.Info = fmt.Sprintf("Invalid input provided for argument [%s]: [%v]: %s", err.Argument, value, info)
+	return err
+}
@@ -0,0 +1,2 @@
+// secrules unit tests
+package testing
@@ -0,0 +1,43 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/common/secrules"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestNormalize(t *testing.T) {
+	rule, err := secrules.RuleOpts{
+		Protocol:     secrules.ProtocolTCP,
+		PortRangeMin: 80,
+		PortRangeMax: 80,
+		RemoteCIDR:   "2001:db8::/32",
+	}.Normalize()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, secrules.DirectionIngress, rule.Direction)
+	th.CheckEquals(t, secrules.EtherTypeIPv6, rule.EtherType)
+
+	rule, err = secrules.RuleOpts{Direction: secrules.DirectionEgress}.Normalize()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, secrules.EtherTypeIPv4, rule.EtherType)
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	invalid := []secrules.RuleOpts{
+		{Direction: "inbound"},
+		{EtherType: "IPv5"},
+		{EtherType: secrules.EtherTypeIPv4, RemoteCIDR: "::/0"},
+		{RemoteCIDR: "10.0.0.0/33"},
+		{RemoteCIDR: "10.0.0.0/8", RemoteGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5"},
+		{PortRangeMin: 80, PortRangeMax: 80},
+		{Protocol: secrules.ProtocolTCP, PortRangeMin: 443, PortRangeMax: 80},
+		{Protocol: secrules.ProtocolUDP, PortRangeMin: 1, PortRangeMax: 65536},
+		{Protocol: secrules.ProtocolICMP, PortRangeMin: 8, PortRangeMax: 256},
+	}
+	for _, rule := range invalid {
+		if _, err := rule.Normalize(); err == nil {
+			t.Errorf("Expected an error for %+v", rule)
+		}
+	}
+}
@@ -26,9 +26,10 @@ func doAction(client *golangsdk.ServiceClient, srvType, id string, opts ActionOp
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, srvType, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, srvType, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -52,15 +53,17 @@ func Delete(client *golangsdk.ServiceClient, srvType, id string, tags []Resource
 
 //Get is a method of getting the tags by id
 func Get(client *golangsdk.ServiceClient, srvType, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, srvType, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getURL(client, srvType, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202, 200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //List is a method of getting the tags of all service
 func List(client *golangsdk.ServiceClient, srvType string) (r ListResult) {
-	_, r.Err = client.Get(listURL(client, srvType), &r.Body, nil)
+	resp, err := client.Get(listURL(client, srvType), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
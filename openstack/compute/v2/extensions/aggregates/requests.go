@@ -36,27 +36,30 @@ func Create(client *golangsdk.ServiceClient, opts CreateOpts) (r CreateResult) {
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(aggregatesCreateURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(aggregatesCreateURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete makes a request against the API to delete an aggregate.
 func Delete(client *golangsdk.ServiceClient, aggregateID int) (r DeleteResult) {
 	v := strconv.Itoa(aggregateID)
-	_, r.Err = client.Delete(aggregatesDeleteURL(client, v), &golangsdk.RequestOpts{
+	resp, err := client.Delete(aggregatesDeleteURL(client, v), &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get makes a request against the API to get details for a specific aggregate.
 func Get(client *golangsdk.ServiceClient, aggregateID int) (r GetResult) {
 	v := strconv.Itoa(aggregateID)
-	_, r.Err = client.Get(aggregatesGetURL(client, v), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(aggregatesGetURL(client, v), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -84,9 +87,10 @@ func Update(client *golangsdk.ServiceClient, aggregateID int, opts UpdateOpts) (
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(aggregatesUpdateURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(aggregatesUpdateURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -108,9 +112,10 @@ func AddHost(client *golangsdk.ServiceClient, aggregateID int, opts AddHostOpts)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(aggregatesAddHostURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(aggregatesAddHostURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -132,9 +137,10 @@ func RemoveHost(client *golangsdk.ServiceClient, aggregateID int, opts RemoveHos
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(aggregatesRemoveHostURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(aggregatesRemoveHostURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -155,8 +161,9 @@ func SetMetadata(client *golangsdk.ServiceClient, aggregateID int, opts SetMetad
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(aggregatesSetMetadataURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(aggregatesSetMetadataURL(client, v), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -40,6 +40,12 @@ Example to Create a new Interface attachment on the Server
 		panic(err)
 	}
 
+	// Wait for the port to be up before using the new address.
+	err = attachinterfaces.WaitForStatus(computeClient, serverID, interface.PortID, "ACTIVE", 120)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete an Interface attachment from the Server
 
 	portID = "0dde1598-b374-474e-986f-5b8dd1df1d4e"
@@ -48,5 +54,11 @@ Example to Delete an Interface attachment from the Server
 	if err != nil {
 		panic(err)
 	}
+
+	// Detaching is asynchronous.
+	err = attachinterfaces.WaitForDetach(computeClient, serverID, portID, 120)
+	if err != nil {
+		panic(err)
+	}
 */
 package attachinterfaces
@@ -14,9 +14,10 @@ func List(client *golangsdk.ServiceClient, serverID string) pagination.Pager {
 
 // Get requests details on a single interface attachment by the server and port IDs.
 func Get(client *golangsdk.ServiceClient, serverID, portID string) (r GetResult) {
-	_, r.Err = client.Get(getInterfaceURL(client, serverID, portID), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getInterfaceURL(client, serverID, portID), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -48,6 +49,13 @@ type CreateOpts struct {
 
 // ToAttachInterfacesCreateMap constructs a request body from CreateOpts.
 func (opts CreateOpts) ToAttachInterfacesCreateMap() (map[string]interface{}, error) {
+	if opts.PortID != "" && opts.NetworkID != "" {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "attachinterfaces.CreateOpts.NetworkID"
+		err.Value = opts.NetworkID
+		err.Info = "NetworkID and PortID are mutually exclusive"
+		return nil, err
+	}
 	return golangsdk.BuildRequestBody(opts, "interfaceAttachment")
 }
 
@@ -58,15 +66,17 @@ func Create(client *golangsdk.ServiceClient, serverID string, opts CreateOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createInterfaceURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createInterfaceURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete makes a request against the nova API to detach a single interface from the server.
 // It needs server and port IDs to make a such request.
 func Delete(client *golangsdk.ServiceClient, serverID, portID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteInterfaceURL(client, serverID, portID), nil)
+	resp, err := client.Delete(deleteInterfaceURL(client, serverID, portID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
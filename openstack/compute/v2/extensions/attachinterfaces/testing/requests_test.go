@@ -76,6 +76,16 @@ func TestCreateInterface(t *testing.T) {
 	th.CheckDeepEquals(t, &expected, actual)
 }
 
+func TestCreateInterfaceWithPortAndNetwork(t *testing.T) {
+	res := attachinterfaces.Create(client.ServiceClient(), "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f", attachinterfaces.CreateOpts{
+		PortID:    "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestDeleteInterface(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,38 @@
+package attachinterfaces
+
+import "github.com/huaweicloud/golangsdk"
+
+// WaitForStatus will continually poll an interface attachment until its port
+// transitions to a specified state, e.g. "ACTIVE". It will do this for at most
+// the number of seconds specified.
+func WaitForStatus(c *golangsdk.ServiceClient, serverID, portID, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, serverID, portID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.PortState == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// WaitForDetach will continually poll an interface attachment until it is
+// removed from the server. It will do this for at most the number of seconds
+// specified.
+func WaitForDetach(c *golangsdk.ServiceClient, serverID, portID string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		_, err := Get(c, serverID, portID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return true, nil
+			}
+			return false, err
+		}
+
+		return false, nil
+	})
+}
@@ -1,6 +1,8 @@
 package bootfromvolume
 
 import (
+	"fmt"
+
 	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/servers"
 )
@@ -107,6 +109,10 @@ func (opts CreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
 	blockDevice := make([]map[string]interface{}, len(opts.BlockDevice))
 
 	for i, bd := range opts.BlockDevice {
+		if err := bd.validate(i); err != nil {
+			return nil, err
+		}
+
 		b, err := golangsdk.BuildRequestBody(bd, "")
 		if err != nil {
 			return nil, err
@@ -118,6 +124,28 @@ func (opts CreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
 	return base, nil
 }
 
+// validate checks that the block device can be created: every source but a
+// blank one needs the UUID of the volume, snapshot or image, and new volumes
+// and ephemeral disks need a size.
+func (bd BlockDevice) validate(i int) error {
+	argument := fmt.Sprintf("bootfromvolume.CreateOptsExt.BlockDevice[%d]", i)
+
+	if bd.SourceType != SourceBlank && bd.UUID == "" {
+		err := golangsdk.ErrMissingInput{}
+		err.Argument = argument + ".UUID"
+		return err
+	}
+
+	newDisk := bd.SourceType == SourceBlank ||
+		(bd.SourceType == SourceImage && bd.DestinationType == DestinationVolume)
+	if newDisk && bd.VolumeSize <= 0 {
+		err := golangsdk.ErrMissingInput{}
+		err.Argument = argument + ".VolumeSize"
+		return err
+	}
+	return nil
+}
+
 // Create requests the creation of a server from the given block device mapping.
 func Create(client *golangsdk.ServiceClient, opts servers.CreateOptsBuilder) (r servers.CreateResult) {
 	b, err := opts.ToServerCreateMap()
@@ -125,8 +153,9 @@ func Create(client *golangsdk.ServiceClient, opts servers.CreateOptsBuilder) (r
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/bootfromvolume"
 	th "github.com/huaweicloud/golangsdk/testhelper"
 )
 
@@ -42,3 +43,25 @@ func TestAttachExistingVolume(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckJSONEquals(t, ExpectedImageAndExistingVolumeRequest, actual)
 }
+
+func TestCreateOptsExtMissingInput(t *testing.T) {
+	opts := bootfromvolume.CreateOptsExt{
+		CreateOptsBuilder: BaseCreateOpts,
+		BlockDevice: []bootfromvolume.BlockDevice{
+			{
+				DestinationType: bootfromvolume.DestinationVolume,
+				SourceType:      bootfromvolume.SourceImage,
+				UUID:            "asdfasdfasdf",
+			},
+		},
+	}
+	_, err := opts.ToServerCreateMap()
+	th.AssertEquals(t, "Missing input for argument [bootfromvolume.CreateOptsExt.BlockDevice[0].VolumeSize]", err.Error())
+
+	opts.BlockDevice[0] = bootfromvolume.BlockDevice{
+		DestinationType: bootfromvolume.DestinationVolume,
+		SourceType:      bootfromvolume.SourceVolume,
+	}
+	_, err = opts.ToServerCreateMap()
+	th.AssertEquals(t, "Missing input for argument [bootfromvolume.CreateOptsExt.BlockDevice[0].UUID]", err.Error())
+}
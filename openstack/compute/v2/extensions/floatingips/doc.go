@@ -54,6 +54,15 @@ Example to Associate a Floating IP With a Server
 		panic(err)
 	}
 
+Example to Ensure a Server Has a Floating IP
+
+	fip, err := floatingips.EnsureFloatingIP(computeClient, "server-id", "nova")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(fip.IP)
+
 Example to Disassociate a Floating IP From a Server
 
 	disassociateOpts := floatingips.DisassociateOpts{
@@ -0,0 +1,46 @@
+package floatingips
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// EnsureFloatingIP returns the floating IP associated with a server. If the
+// server has none, it associates an unassociated floating IP of the pool,
+// allocating one from the pool if there is none.
+//
+// EnsureFloatingIP is not atomic: a concurrent caller may associate the same
+// unassociated floating IP, in which case one of the associations fails.
+func EnsureFloatingIP(client *golangsdk.ServiceClient, serverID, pool string) (*FloatingIP, error) {
+	allPages, err := List(client).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	fips, err := ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var fip *FloatingIP
+	for i := range fips {
+		if fips[i].InstanceID == serverID {
+			return &fips[i], nil
+		}
+		if fip == nil && fips[i].InstanceID == "" && fips[i].Pool == pool {
+			fip = &fips[i]
+		}
+	}
+
+	if fip == nil {
+		fip, err = Create(client, CreateOpts{Pool: pool}).Extract()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = AssociateInstance(client, serverID, AssociateOpts{FloatingIP: fip.IP}).ExtractErr()
+	if err != nil {
+		return nil, err
+	}
+	fip.InstanceID = serverID
+	return fip, nil
+}
@@ -36,21 +36,24 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get returns data about a previously created Floating IP.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete requests the deletion of a previous allocated Floating IP.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -81,7 +84,8 @@ func AssociateInstance(client *golangsdk.ServiceClient, serverID string, opts As
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(associateURL(client, serverID), b, nil, nil)
+	resp, err := client.Post(associateURL(client, serverID), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -109,6 +113,7 @@ func DisassociateInstance(client *golangsdk.ServiceClient, serverID string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(disassociateURL(client, serverID), b, nil, nil)
+	resp, err := client.Post(disassociateURL(client, serverID), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -221,3 +221,22 @@ func HandleDisassociateSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// HandleEnsureSuccessfully configures the test server to list the floating
+// IPs and to respond to the association of the unassociated one.
+func HandleEnsureSuccessfully(t *testing.T) {
+	HandleListSuccessfully(t)
+	th.Mux.HandleFunc("/servers/3fa16a7b-5d0d-4b82-a8c5-6f4c3b1f6d58/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+	"addFloatingIp": {
+		"address": "10.10.10.1"
+	}
+}
+`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -109,3 +109,19 @@ func TestDisassociateInstance(t *testing.T) {
 	err := floatingips.DisassociateInstance(client.ServiceClient(), "4d8c3732-a248-40ed-bebc-539a6ffd25c0", disassociateOpts).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestEnsureFloatingIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleEnsureSuccessfully(t)
+
+	actual, err := floatingips.EnsureFloatingIP(client.ServiceClient(), "4d8c3732-a248-40ed-bebc-539a6ffd25c0", "nova")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &SecondFloatingIP, actual)
+
+	expected := FirstFloatingIP
+	expected.InstanceID = "3fa16a7b-5d0d-4b82-a8c5-6f4c3b1f6d58"
+	actual, err = floatingips.EnsureFloatingIP(client.ServiceClient(), "3fa16a7b-5d0d-4b82-a8c5-6f4c3b1f6d58", "nova")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &expected, actual)
+}
@@ -12,6 +12,16 @@ Example of Show Hypervisor Details
 
 	fmt.Printf("%+v\n", hypervisor)
 
+Example of Show Hypervisor Details When IDs are UUIDs (microversion 2.53+)
+
+	hypervisorID := golangsdk.FromString("c48f6247-abe4-4a24-824e-ea39e108874f")
+	hypervisor, err := hypervisors.GetByResourceID(computeClient, hypervisorID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", hypervisor)
+
 Example of Retrieving Details of All Hypervisors
 
 	allPages, err := hypervisors.List(computeClient).AllPages()
@@ -47,5 +57,20 @@ Example of Show Hypervisor Uptime
 
 	fmt.Printf("%+v\n", hypervisorUptime)
 
+Example of Listing the Servers Running on Compute Hosts
+
+	allPages, err := hypervisors.ListServers(computeClient, "compute-rack1").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, hypervisor := range allHypervisors {
+		fmt.Printf("%s runs %d servers\n", hypervisor.HypervisorHostname, len(hypervisor.Servers))
+	}
 */
 package hypervisors
@@ -16,26 +16,55 @@ func List(client *golangsdk.ServiceClient) pagination.Pager {
 
 // Statistics makes a request against the API to get hypervisors statistics.
 func GetStatistics(client *golangsdk.ServiceClient) (r StatisticsResult) {
-	_, r.Err = client.Get(hypervisorsStatisticsURL(client), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(hypervisorsStatisticsURL(client), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get makes a request against the API to get details for specific hypervisor.
 func Get(client *golangsdk.ServiceClient, hypervisorID int) (r HypervisorResult) {
 	v := strconv.Itoa(hypervisorID)
-	_, r.Err = client.Get(hypervisorsGetURL(client, v), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(hypervisorsGetURL(client, v), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetByResourceID makes a request against the API to get details for specific
+// hypervisor, whose ID is either an integer or a UUID.
+func GetByResourceID(client *golangsdk.ServiceClient, hypervisorID golangsdk.ResourceID) (r HypervisorResult) {
+	resp, err := client.Get(hypervisorsGetURL(client, hypervisorID.String()), &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetUptime makes a request against the API to get uptime for specific hypervisor.
 func GetUptime(client *golangsdk.ServiceClient, hypervisorID int) (r UptimeResult) {
 	v := strconv.Itoa(hypervisorID)
-	_, r.Err = client.Get(hypervisorsUptimeURL(client, v), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(hypervisorsUptimeURL(client, v), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
+
+// Search makes a request against the API to find the hypervisors whose
+// hostname contains hostnamePattern.
+func Search(client *golangsdk.ServiceClient, hostnamePattern string) pagination.Pager {
+	return pagination.NewPager(client, hypervisorsSearchURL(client, hostnamePattern), func(r pagination.PageResult) pagination.Page {
+		return HypervisorSummaryPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// ListServers makes a request against the API to list the servers running on
+// the hypervisors whose hostname contains hostnamePattern.
+func ListServers(client *golangsdk.ServiceClient, hostnamePattern string) pagination.Pager {
+	return pagination.NewPager(client, hypervisorsServersURL(client, hostnamePattern), func(r pagination.PageResult) pagination.Page {
+		return HypervisorSummaryPage{pagination.SinglePageBase(r)}
+	})
+}
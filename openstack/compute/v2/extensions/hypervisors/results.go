@@ -71,8 +71,13 @@ type Hypervisor struct {
 	// HypervisorVersion is the version of the hypervisor.
 	HypervisorVersion int `json:"-"`
 
-	// ID is the unique ID of the hypervisor.
-	ID int `json:"id"`
+	// ID is the unique ID of the hypervisor. It is only set when the ID is an
+	// integer, see ResourceID.
+	ID int `json:"-"`
+
+	// ResourceID is the unique ID of the hypervisor, an integer or, since
+	// microversion 2.53, a UUID.
+	ResourceID golangsdk.ResourceID `json:"id"`
 
 	// LocalGB is the disk space in the hypervisor, measured in GB.
 	LocalGB int `json:"-"`
@@ -116,6 +121,10 @@ func (r *Hypervisor) UnmarshalJSON(b []byte) error {
 
 	*r = Hypervisor(s.tmp)
 
+	if r.ResourceID.IsInt() {
+		r.ID, _ = r.ResourceID.Int()
+	}
+
 	// Newer versions return the CPU info as the correct type.
 	// Older versions return the CPU info as a string and need to be
 	// unmarshalled by the json parser.
@@ -288,3 +297,55 @@ func (r UptimeResult) Extract() (*Uptime, error) {
 	err := r.ExtractInto(&s)
 	return &s.Uptime, err
 }
+
+// HypervisorServer represents a server running on a hypervisor.
+type HypervisorServer struct {
+	// The name of the server.
+	Name string `json:"name"`
+
+	// The UUID of the server.
+	UUID string `json:"uuid"`
+}
+
+// HypervisorSummary represents a hypervisor returned by Search and
+// ListServers.
+type HypervisorSummary struct {
+	// The hypervisor host name provided by the Nova virt driver.
+	// For the Ironic driver, it is the Ironic node uuid.
+	HypervisorHostname string `json:"hypervisor_hostname"`
+
+	// The id of the hypervisor, an integer or, since microversion 2.53, a
+	// UUID.
+	ID golangsdk.ResourceID `json:"id"`
+
+	// The state of the hypervisor. One of up or down.
+	State string `json:"state"`
+
+	// The status of the hypervisor. One of enabled or disabled.
+	Status string `json:"status"`
+
+	// The servers running on the hypervisor, only returned by ListServers.
+	Servers []HypervisorServer `json:"servers"`
+}
+
+// HypervisorSummaryPage represents a single page of HypervisorSummaries from
+// a Search or ListServers request.
+type HypervisorSummaryPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a HypervisorSummaryPage is empty.
+func (page HypervisorSummaryPage) IsEmpty() (bool, error) {
+	va, err := ExtractHypervisorSummaries(page)
+	return len(va) == 0, err
+}
+
+// ExtractHypervisorSummaries interprets a page of results as a slice of
+// HypervisorSummaries.
+func ExtractHypervisorSummaries(p pagination.Page) ([]HypervisorSummary, error) {
+	var h struct {
+		Hypervisors []HypervisorSummary `json:"hypervisors"`
+	}
+	err := (p.(HypervisorSummaryPage)).ExtractInto(&h)
+	return h.Hypervisors, err
+}
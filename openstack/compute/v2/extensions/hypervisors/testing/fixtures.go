@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/hypervisors"
 	"github.com/huaweicloud/golangsdk/testhelper"
 	"github.com/huaweicloud/golangsdk/testhelper/client"
@@ -186,6 +188,7 @@ var (
 		HypervisorType:     "fake",
 		HypervisorVersion:  2002000,
 		ID:                 1,
+		ResourceID:         golangsdk.FromInt(1),
 		LocalGB:            1028,
 		LocalGBUsed:        0,
 		MemoryMB:           8192,
@@ -263,3 +266,65 @@ func HandleHypervisorUptimeSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, HypervisorUptimeBody)
 	})
 }
+
+// HypervisorServersBody represents a raw response to a ListServers request.
+const HypervisorServersBody = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "fake-mini",
+            "id": 1,
+            "state": "up",
+            "status": "enabled",
+            "servers": [
+                {
+                    "name": "test_server1",
+                    "uuid": "041b7b4e-ea45-4dbc-99c4-1b5fa0fda4a0"
+                },
+                {
+                    "name": "test_server2",
+                    "uuid": "f7d93c52-dc6c-4b7b-85fa-ef8d8c1d1d9b"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// HypervisorServersExpected is the expected result of ListServers.
+var HypervisorServersExpected = []hypervisors.HypervisorSummary{
+	{
+		HypervisorHostname: "fake-mini",
+		ID:                 golangsdk.FromInt(1),
+		State:              "up",
+		Status:             "enabled",
+		Servers: []hypervisors.HypervisorServer{
+			{Name: "test_server1", UUID: "041b7b4e-ea45-4dbc-99c4-1b5fa0fda4a0"},
+			{Name: "test_server2", UUID: "f7d93c52-dc6c-4b7b-85fa-ef8d8c1d1d9b"},
+		},
+	},
+}
+
+func HandleHypervisorServersSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/os-hypervisors/fake/servers", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, HypervisorServersBody)
+	})
+}
+
+// HypervisorUUID is the ID of the hypervisor of HypervisorGetBody in
+// microversion 2.53 and later.
+const HypervisorUUID = "c48f6247-abe4-4a24-824e-ea39e108874f"
+
+func HandleHypervisorGetByUUIDSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/os-hypervisors/"+HypervisorUUID, func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, strings.Replace(HypervisorGetBody, `"id":1`, `"id":"`+HypervisorUUID+`"`, 1))
+	})
+}
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/hypervisors"
 	"github.com/huaweicloud/golangsdk/pagination"
 	"github.com/huaweicloud/golangsdk/testhelper"
@@ -76,6 +77,20 @@ func TestGetHypervisor(t *testing.T) {
 	testhelper.CheckDeepEquals(t, &expected, actual)
 }
 
+func TestGetHypervisorByResourceID(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleHypervisorGetByUUIDSuccessfully(t)
+
+	expected := HypervisorFake
+	expected.ID = 0
+	expected.ResourceID = golangsdk.FromString(HypervisorUUID)
+
+	actual, err := hypervisors.GetByResourceID(client.ServiceClient(), expected.ResourceID).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, &expected, actual)
+}
+
 func TestHypervisorsUptime(t *testing.T) {
 	testhelper.SetupHTTP()
 	defer testhelper.TeardownHTTP()
@@ -87,3 +102,15 @@ func TestHypervisorsUptime(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, &expected, actual)
 }
+
+func TestListHypervisorServers(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleHypervisorServersSuccessfully(t)
+
+	allPages, err := hypervisors.ListServers(client.ServiceClient(), "fake").AllPages()
+	testhelper.AssertNoErr(t, err)
+	actual, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, HypervisorServersExpected, actual)
+}
@@ -17,3 +17,11 @@ func hypervisorsGetURL(c *golangsdk.ServiceClient, hypervisorID string) string {
 func hypervisorsUptimeURL(c *golangsdk.ServiceClient, hypervisorID string) string {
 	return c.ServiceURL("os-hypervisors", hypervisorID, "uptime")
 }
+
+func hypervisorsSearchURL(c *golangsdk.ServiceClient, hostnamePattern string) string {
+	return c.ServiceURL("os-hypervisors", hostnamePattern, "search")
+}
+
+func hypervisorsServersURL(c *golangsdk.ServiceClient, hostnamePattern string) string {
+	return c.ServiceURL("os-hypervisors", hostnamePattern, "servers")
+}
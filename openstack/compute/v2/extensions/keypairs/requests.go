@@ -52,6 +52,10 @@ type CreateOpts struct {
 	// PublicKey [optional] is a pregenerated OpenSSH-formatted public key.
 	// If provided, this key will be imported and no new key will be created.
 	PublicKey string `json:"public_key,omitempty"`
+
+	// Type [optional] is the type of the KeyPair, either "ssh" or "x509".
+	// Requires microversion 2.2 or later.
+	Type string `json:"type,omitempty"`
 }
 
 // ToKeyPairCreateMap constructs a request body from CreateOpts.
@@ -67,20 +71,23 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get returns public data about a previously uploaded KeyPair.
 func Get(client *golangsdk.ServiceClient, name string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, name), &r.Body, nil)
+	resp, err := client.Get(getURL(client, name), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete requests the deletion of a previous stored KeyPair from the server.
 func Delete(client *golangsdk.ServiceClient, name string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, name), nil)
+	resp, err := client.Delete(deleteURL(client, name), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -27,6 +27,10 @@ type KeyPair struct {
 
 	// UserID is the user who owns this KeyPair.
 	UserID string `json:"user_id"`
+
+	// Type is the type of the KeyPair, "ssh" or "x509". It is only returned
+	// with microversion 2.2 or later.
+	Type string `json:"type"`
 }
 
 // KeyPairPage stores a single page of all KeyPair results from a List call.
@@ -13,5 +13,12 @@ Example to Retrieve Limits for a Tenant
 	}
 
 	fmt.Printf("%+v\n", limits)
+
+Example to Wait for an Over Limit Error to Clear
+
+	_, err := servers.Create(computeClient, createOpts).Extract()
+	if overLimit, ok := err.(golangsdk.ErrOverLimit); ok && !overLimit.RetryAfter.IsZero() {
+		time.Sleep(time.Until(overLimit.RetryAfter))
+	}
 */
 package limits
@@ -34,6 +34,7 @@ func Get(client *golangsdk.ServiceClient, opts GetOptsBuilder) (r GetResult) {
 		url += query
 	}
 
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
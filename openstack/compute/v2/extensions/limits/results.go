@@ -1,6 +1,8 @@
 package limits
 
 import (
+	"time"
+
 	"github.com/huaweicloud/golangsdk"
 )
 
@@ -8,6 +10,41 @@ import (
 type Limits struct {
 	// Absolute contains the limits and usage information.
 	Absolute Absolute `json:"absolute"`
+
+	// Rate contains the rate limits of the API, grouped by URI.
+	Rate []Rate `json:"rate"`
+}
+
+// Rate is a set of rate limits applied to the requests matching a URI.
+type Rate struct {
+	// URI is a human readable form of the URIs the limits apply to.
+	URI string `json:"uri"`
+
+	// Regex is the regular expression matching the URIs the limits apply to.
+	Regex string `json:"regex"`
+
+	// Limit is the list of limits, one for each HTTP verb.
+	Limit []RateLimit `json:"limit"`
+}
+
+// RateLimit is the number of requests of an HTTP verb allowed per unit of
+// time.
+type RateLimit struct {
+	// Verb is the HTTP verb the limit applies to.
+	Verb string `json:"verb"`
+
+	// Value is the number of requests allowed per Unit.
+	Value int `json:"value"`
+
+	// Remaining is the number of requests left in the current period.
+	Remaining int `json:"remaining"`
+
+	// Unit is the period of the limit: SECOND, MINUTE, HOUR or DAY.
+	Unit string `json:"unit"`
+
+	// NextAvailable is the time at which a request can be sent again once
+	// the limit has been reached.
+	NextAvailable time.Time `json:"next-available"`
 }
 
 // Usage is a struct that contains the current resource usage and limits
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/limits"
 	th "github.com/huaweicloud/golangsdk/testhelper"
@@ -63,6 +64,7 @@ var LimitsResult = limits.Limits{
 		MaxTotalInstances:       10,
 		MaxTotalRAMSize:         51200,
 	},
+	Rate: []limits.Rate{},
 }
 
 const TenantID = "555544443333222211110000ffffeeee"
@@ -78,3 +80,59 @@ func HandleGetSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, GetOutput)
 	})
 }
+
+// GetRateOutput is a sample response to a Get call with the rate limits of
+// the API.
+const GetRateOutput = `
+{
+    "limits": {
+        "rate": [
+            {
+                "uri": "*",
+                "regex": ".*",
+                "limit": [
+                    {
+                        "verb": "POST",
+                        "value": 120,
+                        "remaining": 118,
+                        "unit": "MINUTE",
+                        "next-available": "2020-05-12T09:30:00Z"
+                    }
+                ]
+            }
+        ],
+        "absolute": {}
+    }
+}
+`
+
+// RateLimitsResult is the result of the limits in GetRateOutput.
+var RateLimitsResult = limits.Limits{
+	Rate: []limits.Rate{
+		{
+			URI:   "*",
+			Regex: ".*",
+			Limit: []limits.RateLimit{
+				{
+					Verb:          "POST",
+					Value:         120,
+					Remaining:     118,
+					Unit:          "MINUTE",
+					NextAvailable: time.Date(2020, 5, 12, 9, 30, 0, 0, time.UTC),
+				},
+			},
+		},
+	},
+}
+
+// HandleGetRateSuccessfully configures the test server to respond to a Get
+// request for a limit with the rate limits of the API.
+func HandleGetRateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetRateOutput)
+	})
+}
@@ -21,3 +21,13 @@ func TestGet(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, &LimitsResult, actual)
 }
+
+func TestGetRate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetRateSuccessfully(t)
+
+	actual, err := limits.Get(client.ServiceClient(), limits.GetOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &RateLimitsResult, actual)
+}
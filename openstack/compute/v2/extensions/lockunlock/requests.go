@@ -8,12 +8,14 @@ func actionURL(client *golangsdk.ServiceClient, id string) string {
 
 // Lock is the operation responsible for locking a Compute server.
 func Lock(client *golangsdk.ServiceClient, id string) (r LockResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"lock": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"lock": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Unlock is the operation responsible for unlocking a Compute server.
 func Unlock(client *golangsdk.ServiceClient, id string) (r UnlockResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"unlock": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -6,7 +6,8 @@ import (
 
 // Migrate will initiate a migration of the instance to another host.
 func Migrate(client *golangsdk.ServiceClient, id string) (r MigrateResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -45,6 +46,7 @@ func LiveMigrate(client *golangsdk.ServiceClient, id string, opts LiveMigrateOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	resp, err := client.Post(actionURL(client, id), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
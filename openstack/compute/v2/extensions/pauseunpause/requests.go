@@ -8,12 +8,14 @@ func actionURL(client *golangsdk.ServiceClient, id string) string {
 
 // Pause is the operation responsible for pausing a Compute server.
 func Pause(client *golangsdk.ServiceClient, id string) (r PauseResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"pause": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"pause": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Unpause is the operation responsible for unpausing a Compute server.
 func Unpause(client *golangsdk.ServiceClient, id string) (r UnpauseResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unpause": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"unpause": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -19,6 +19,26 @@ Example to Get a Detailed Quota Set
 
 	fmt.Printf("%+v\n", quotaset)
 
+Example to Get the Default Quota Set
+
+	quotaset, err := quotasets.GetDefaults(computeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Check the Remaining Capacity of a Tenant
+
+	quotaset, err := quotasets.GetDetail(computeClient, "tenant-id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if quotaset.Cores.Remaining() == 0 {
+		fmt.Println("No cores left")
+	}
+
 Example to Update a Quota Set
 
 	updateOpts := quotasets.UpdateOpts{
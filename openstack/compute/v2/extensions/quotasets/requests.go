@@ -6,13 +6,23 @@ import (
 
 // Get returns public data about a previously created QuotaSet.
 func Get(client *golangsdk.ServiceClient, tenantID string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, tenantID), &r.Body, nil)
+	resp, err := client.Get(getURL(client, tenantID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetDetail returns detailed public data about a previously created QuotaSet.
 func GetDetail(client *golangsdk.ServiceClient, tenantID string) (r GetDetailResult) {
-	_, r.Err = client.Get(getDetailURL(client, tenantID), &r.Body, nil)
+	resp, err := client.Get(getDetailURL(client, tenantID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetDefaults returns the default quotas, applied to the given tenant until
+// they are updated.
+func GetDefaults(client *golangsdk.ServiceClient, tenantID string) (r GetResult) {
+	resp, err := client.Get(getDefaultsURL(client, tenantID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -24,13 +34,15 @@ func Update(client *golangsdk.ServiceClient, tenantID string, opts UpdateOptsBui
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, tenantID), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(updateURL(client, tenantID), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Resets the quotas for the given tenant to their default values.
 func Delete(client *golangsdk.ServiceClient, tenantID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, tenantID), nil)
+	resp, err := client.Delete(deleteURL(client, tenantID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -121,6 +121,18 @@ type QuotaDetail struct {
 	Limit int `json:"limit"`
 }
 
+// Remaining returns the number of resources which can still be allocated,
+// or -1 if the quota is unlimited.
+func (d QuotaDetail) Remaining() int {
+	if d.Limit < 0 {
+		return -1
+	}
+	if remaining := d.Limit - d.InUse - d.Reserved; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
 // QuotaSetPage stores a single page of all QuotaSet results from a List call.
 type QuotaSetPage struct {
 	pagination.SinglePageBase
@@ -171,6 +171,16 @@ func HandleGetSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleGetDefaultsSuccessfully configures the test server to respond to a Get Defaults request for sample tenant
+func HandleGetDefaultsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID+"/defaults", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
 // HandleGetDetailSuccessfully configures the test server to respond to a Get Details request for sample tenant
 func HandleGetDetailSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID+"/detail", func(w http.ResponseWriter, r *http.Request) {
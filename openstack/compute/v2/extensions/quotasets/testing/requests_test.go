@@ -19,6 +19,21 @@ func TestGet(t *testing.T) {
 	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
 }
 
+func TestGetDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDefaultsSuccessfully(t)
+	actual, err := quotasets.GetDefaults(client.ServiceClient(), FirstTenantID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+}
+
+func TestRemaining(t *testing.T) {
+	th.AssertEquals(t, 15, quotasets.QuotaDetail{InUse: 8, Reserved: 2, Limit: 25}.Remaining())
+	th.AssertEquals(t, 0, quotasets.QuotaDetail{InUse: 30, Limit: 25}.Remaining())
+	th.AssertEquals(t, -1, quotasets.QuotaDetail{InUse: 30, Limit: -1}.Remaining())
+}
+
 func TestGetDetail(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -16,6 +16,10 @@ func getDetailURL(c *golangsdk.ServiceClient, tenantID string) string {
 	return c.ServiceURL(resourcePath, tenantID, "detail")
 }
 
+func getDefaultsURL(c *golangsdk.ServiceClient, tenantID string) string {
+	return c.ServiceURL(resourcePath, tenantID, "defaults")
+}
+
 func updateURL(c *golangsdk.ServiceClient, tenantID string) string {
 	return getURL(c, tenantID)
 }
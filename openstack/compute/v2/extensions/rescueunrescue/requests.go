@@ -35,14 +35,16 @@ func Rescue(client *golangsdk.ServiceClient, id string, opts RescueOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Unrescue instructs the provider to return the server from RESCUE mode.
 func Unrescue(client *golangsdk.ServiceClient, id string) (r UnrescueResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"unrescue": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"unrescue": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
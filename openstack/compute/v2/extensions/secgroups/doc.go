@@ -72,6 +72,25 @@ Example to Create a Security Group Rule
 		panic(err)
 	}
 
+Example to Create a Security Group Rule from a secrules.RuleOpts
+
+	ruleOpts := secrules.RuleOpts{
+		Protocol:     secrules.ProtocolTCP,
+		PortRangeMin: 22,
+		PortRangeMax: 22,
+		RemoteCIDR:   "192.168.0.0/16",
+	}
+
+	createOpts, err := secgroups.NewCreateRuleOpts("37d94f8a-d136-465c-ae46-144f0d8ef141", ruleOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	rule, err := secgroups.CreateRule(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Add a Security Group to a Server
 
 	serverID := "aab3ad01-9956-4623-a29b-24afc89a7d36"
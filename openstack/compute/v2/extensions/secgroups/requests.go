@@ -2,6 +2,7 @@ package secgroups
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/common/secrules"
 	"github.com/huaweicloud/golangsdk/pagination"
 )
 
@@ -54,9 +55,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -82,21 +84,24 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get will return details for a particular security group.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a security group from the project.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -131,6 +136,60 @@ type CreateRuleOpts struct {
 	FromGroupID string `json:"group_id,omitempty" or:"CIDR"`
 }
 
+// NewCreateRuleOpts returns the CreateRuleOpts adding a rule described by a
+// secrules.RuleOpts to the security group parentGroupID. The compute service
+// only supports ingress rules for the TCP, UDP and ICMP protocols: the rule
+// matches every port or ICMP type if the port range is empty, and any address
+// if no remote is set.
+func NewCreateRuleOpts(parentGroupID string, rule secrules.RuleOpts) (CreateRuleOpts, error) {
+	rule, err := rule.Normalize()
+	if err != nil {
+		return CreateRuleOpts{}, err
+	}
+
+	if rule.Direction != secrules.DirectionIngress {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "secrules.RuleOpts.Direction"
+		err.Value = rule.Direction
+		err.Info = "Compute security groups only support ingress rules"
+		return CreateRuleOpts{}, err
+	}
+
+	opts := CreateRuleOpts{
+		ParentGroupID: parentGroupID,
+		FromPort:      rule.PortRangeMin,
+		ToPort:        rule.PortRangeMax,
+		IPProtocol:    rule.Protocol,
+		CIDR:          rule.RemoteCIDR,
+		FromGroupID:   rule.RemoteGroupID,
+	}
+
+	switch rule.Protocol {
+	case secrules.ProtocolTCP, secrules.ProtocolUDP:
+		if opts.FromPort == 0 && opts.ToPort == 0 {
+			opts.FromPort, opts.ToPort = 1, 65535
+		}
+	case secrules.ProtocolICMP:
+		if opts.FromPort == 0 && opts.ToPort == 0 {
+			opts.FromPort, opts.ToPort = -1, -1
+		}
+	default:
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "secrules.RuleOpts.Protocol"
+		err.Value = rule.Protocol
+		err.Info = "Compute security groups only support tcp, udp and icmp rules"
+		return CreateRuleOpts{}, err
+	}
+
+	if opts.CIDR == "" && opts.FromGroupID == "" {
+		opts.CIDR = "0.0.0.0/0"
+		if rule.EtherType == secrules.EtherTypeIPv6 {
+			opts.CIDR = "::/0"
+		}
+	}
+	return opts, nil
+}
+
 // CreateRuleOptsBuilder allows extensions to add additional parameters to the
 // CreateRule request.
 type CreateRuleOptsBuilder interface {
@@ -151,15 +210,17 @@ func CreateRule(client *golangsdk.ServiceClient, opts CreateRuleOptsBuilder) (r
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootRuleURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootRuleURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DeleteRule will permanently delete a rule from a security group.
 func DeleteRule(client *golangsdk.ServiceClient, id string) (r DeleteRuleResult) {
-	_, r.Err = client.Delete(resourceRuleURL(client, id), nil)
+	resp, err := client.Delete(resourceRuleURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -172,12 +233,14 @@ func actionMap(prefix, groupName string) map[string]map[string]string {
 // AddServer will associate a server and a security group, enforcing the
 // rules of the group on the server.
 func AddServer(client *golangsdk.ServiceClient, serverID, groupName string) (r AddServerResult) {
-	_, r.Err = client.Post(serverActionURL(client, serverID), actionMap("add", groupName), nil, nil)
+	resp, err := client.Post(serverActionURL(client, serverID), actionMap("add", groupName), nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // RemoveServer will disassociate a server from a security group.
 func RemoveServer(client *golangsdk.ServiceClient, serverID, groupName string) (r RemoveServerResult) {
-	_, r.Err = client.Post(serverActionURL(client, serverID), actionMap("remove", groupName), nil, nil)
+	resp, err := client.Post(serverActionURL(client, serverID), actionMap("remove", groupName), nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -3,6 +3,7 @@ package testing
 import (
 	"testing"
 
+	"github.com/huaweicloud/golangsdk/openstack/common/secrules"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/secgroups"
 	"github.com/huaweicloud/golangsdk/pagination"
 	th "github.com/huaweicloud/golangsdk/testhelper"
@@ -300,3 +301,38 @@ func TestRemoveServer(t *testing.T) {
 	err := secgroups.RemoveServer(client.ServiceClient(), serverID, "test").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestNewCreateRuleOpts(t *testing.T) {
+	opts, err := secgroups.NewCreateRuleOpts(groupID, secrules.RuleOpts{
+		Protocol:   secrules.ProtocolICMP,
+		RemoteCIDR: "2001:db8::/32",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, secgroups.CreateRuleOpts{
+		ParentGroupID: groupID,
+		FromPort:      -1,
+		ToPort:        -1,
+		IPProtocol:    "icmp",
+		CIDR:          "2001:db8::/32",
+	}, opts)
+
+	opts, err = secgroups.NewCreateRuleOpts(groupID, secrules.RuleOpts{
+		Protocol: secrules.ProtocolTCP,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, secgroups.CreateRuleOpts{
+		ParentGroupID: groupID,
+		FromPort:      1,
+		ToPort:        65535,
+		IPProtocol:    "tcp",
+		CIDR:          "0.0.0.0/0",
+	}, opts)
+
+	_, err = secgroups.NewCreateRuleOpts(groupID, secrules.RuleOpts{
+		Direction: secrules.DirectionEgress,
+		Protocol:  secrules.ProtocolTCP,
+	})
+	if err == nil {
+		t.Errorf("Expected an error for an egress rule")
+	}
+}
@@ -21,7 +21,7 @@ Example to Create a Server Group
 
 	createOpts := servergroups.CreateOpts{
 		Name:     "my_sg",
-		Policies: []string{"anti-affinity"},
+		Policies: []string{servergroups.PolicyAntiAffinity},
 	}
 
 	sg, err := servergroups.Create(computeClient, createOpts).Extract()
@@ -29,6 +29,18 @@ Example to Create a Server Group
 		panic(err)
 	}
 
+Example to Add a Server to a Server Group
+
+	sgID := "7a6f29ad-e34d-4368-951a-58a08f11cfb7"
+	serverID := "d194d539-07b0-446e-b52c-e639e618e49d"
+	err := servergroups.AddMember(computeClient, sgID, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Servers can also be placed in a group when they are created, see the
+schedulerhints package.
+
 Example to Delete a Server Group
 
 	sgID := "7a6f29ad-e34d-4368-951a-58a08f11cfb7"
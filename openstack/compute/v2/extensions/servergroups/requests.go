@@ -19,12 +19,29 @@ type CreateOptsBuilder interface {
 	ToServerGroupCreateMap() (map[string]interface{}, error)
 }
 
+// Server group policies.
+const (
+	// PolicyAffinity places all servers of the group on the same host.
+	PolicyAffinity = "affinity"
+
+	// PolicyAntiAffinity places each server of the group on a different host.
+	PolicyAntiAffinity = "anti-affinity"
+
+	// PolicySoftAffinity places the servers of the group on the same host
+	// when possible.
+	PolicySoftAffinity = "soft-affinity"
+
+	// PolicySoftAntiAffinity places the servers of the group on different
+	// hosts when possible.
+	PolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
 // CreateOpts specifies Server Group creation parameters.
 type CreateOpts struct {
 	// Name is the name of the server group
 	Name string `json:"name" required:"true"`
 
-	// Policies are the server group policies
+	// Policies are the server group policies, e.g. PolicyAntiAffinity
 	Policies []string `json:"policies" required:"true"`
 }
 
@@ -40,21 +57,24 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get returns data about a previously created ServerGroup.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete requests the deletion of a previously allocated ServerGroup.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -79,8 +99,19 @@ func UpdateMember(client *golangsdk.ServiceClient, opts MemberOptsBuilder, optsT
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
+
+// AddMember adds a server to a Server Group.
+func AddMember(client *golangsdk.ServiceClient, id, serverID string) (r MemberResult) {
+	return UpdateMember(client, MemberOpts{InstanceUUid: serverID}, "add_member", id)
+}
+
+// RemoveMember removes a server from a Server Group.
+func RemoveMember(client *golangsdk.ServiceClient, id, serverID string) (r MemberResult) {
+	return UpdateMember(client, MemberOpts{InstanceUUid: serverID}, "remove_member", id)
+}
@@ -70,6 +70,15 @@ func TestAddMember(t *testing.T) {
 	th.AssertNoErr(t, err)
 }
 
+func TestAddMemberByID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAddMemberSuccessfully(t)
+
+	err := servergroups.AddMember(client.ServiceClient(), "616fb98f-46ca-475e-917e-2563e5a8cd19", "d194d539-07b0-446e-b52c-e639e618e49d").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
 func TestRemoveMember(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
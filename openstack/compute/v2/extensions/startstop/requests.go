@@ -8,12 +8,14 @@ func actionURL(client *golangsdk.ServiceClient, id string) string {
 
 // Start is the operation responsible for starting a Compute server.
 func Start(client *golangsdk.ServiceClient, id string) (r StartResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"os-start": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"os-start": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Stop is the operation responsible for stopping a Compute server.
 func Stop(client *golangsdk.ServiceClient, id string) (r StopResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"os-stop": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"os-stop": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
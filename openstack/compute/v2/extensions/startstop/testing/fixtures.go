@@ -25,3 +25,13 @@ func mockStopServerResponse(t *testing.T, id string) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+func mockStartServerRequestIDResponse(t *testing.T, id string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"os-start": null}`)
+		w.Header().Add("X-Openstack-Request-Id", "req-start")
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -29,3 +29,14 @@ func TestStop(t *testing.T) {
 	err := startstop.Stop(client.ServiceClient(), serverID).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestStartRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockStartServerRequestIDResponse(t, serverID)
+
+	res := startstop.Start(client.ServiceClient(), serverID)
+	th.AssertNoErr(t, res.Err)
+	th.CheckEquals(t, "req-start", res.Header.Get("X-Openstack-Request-Id"))
+}
@@ -8,12 +8,14 @@ func actionURL(client *golangsdk.ServiceClient, id string) string {
 
 // Suspend is the operation responsible for suspending a Compute server.
 func Suspend(client *golangsdk.ServiceClient, id string) (r SuspendResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"suspend": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"suspend": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Resume is the operation responsible for resuming a Compute server.
 func Resume(client *golangsdk.ServiceClient, id string) (r UnsuspendResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"resume": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"resume": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -35,18 +35,21 @@ func Create(client *golangsdk.ServiceClient, server_id string, opts CreateOptsBu
 		r.Err = err
 		return r
 	}
-	_, r.Err = client.Put(createURL(client, server_id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(createURL(client, server_id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get implements tags get request
 func Get(client *golangsdk.ServiceClient, server_id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, server_id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, server_id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete implements image delete request
 func Delete(client *golangsdk.ServiceClient, server_id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, server_id), nil)
+	resp, err := client.Delete(deleteURL(client, server_id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
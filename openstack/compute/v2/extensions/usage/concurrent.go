@@ -0,0 +1,151 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Default settings of AllTenantsConcurrently.
+const (
+	DefaultUsageWindow      = 24 * time.Hour
+	DefaultUsageConcurrency = 4
+)
+
+// ConcurrentOpts are the options of AllTenantsConcurrently.
+type ConcurrentOpts struct {
+	// Start and End delimit the period to report on. They are required.
+	Start time.Time
+	End   time.Time
+
+	// Detailed will return detailed results.
+	Detailed bool
+
+	// Window is the length of the time ranges the period is split into, each
+	// of them being fetched by a separate AllTenants request. It defaults to
+	// DefaultUsageWindow.
+	Window time.Duration
+
+	// Concurrency is the number of time ranges fetched at once. Since the
+	// results are handled in order, it is also the maximum number of time
+	// ranges held in memory. It defaults to DefaultUsageConcurrency.
+	Concurrency int
+}
+
+// usageWindow is the result of the AllTenants request of a time range.
+type usageWindow struct {
+	usages []TenantUsage
+	err    error
+}
+
+// AllTenantsConcurrently returns the usage of all tenants between opts.Start
+// and opts.End. The period is split into windows which are fetched in
+// parallel, and handler is called with each TenantUsage ordered by the start
+// of its window, so that a large history can be exported quickly with a
+// bounded memory.
+//
+// AllTenantsConcurrently stops at the first error, returned either by a
+// request or by handler, or when ctx is done.
+func AllTenantsConcurrently(ctx context.Context, client *golangsdk.ServiceClient, opts ConcurrentOpts, handler func(TenantUsage) error) error {
+	if opts.Start.IsZero() || opts.End.IsZero() {
+		err := golangsdk.ErrMissingInput{}
+		err.Argument = "usage.ConcurrentOpts.Start/End"
+		return err
+	}
+	if !opts.Start.Before(opts.End) {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "usage.ConcurrentOpts.End"
+		err.Value = opts.End
+		err.Info = fmt.Sprintf("End must be after Start (%s)", opts.Start)
+		return err
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = DefaultUsageWindow
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultUsageConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// Each window is fetched by its own goroutine, started once a slot is
+	// free. A slot is released once its window is handled, which bounds the
+	// number of windows in memory.
+	slots := make(chan struct{}, concurrency)
+	results := make(chan chan usageWindow, concurrency)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(results)
+		for start := opts.Start; start.Before(opts.End); start = start.Add(window) {
+			end := start.Add(window)
+			if end.After(opts.End) {
+				end = opts.End
+			}
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			result := make(chan usageWindow, 1)
+			results <- result
+			wg.Add(1)
+			go func(start, end time.Time) {
+				defer wg.Done()
+				usages, err := fetchUsageWindow(ctx, client, AllTenantsOpts{
+					Detailed: opts.Detailed,
+					Start:    &start,
+					End:      &end,
+				})
+				result <- usageWindow{usages: usages, err: err}
+			}(start, end)
+		}
+	}()
+
+	for result := range results {
+		w := <-result
+		if w.err != nil {
+			return w.err
+		}
+		for _, u := range w.usages {
+			if err := handler(u); err != nil {
+				return err
+			}
+		}
+		<-slots
+	}
+	return ctx.Err()
+}
+
+// fetchUsageWindow returns the usages of all the pages of an AllTenants
+// request, ordered by their start.
+func fetchUsageWindow(ctx context.Context, client *golangsdk.ServiceClient, opts AllTenantsOpts) ([]TenantUsage, error) {
+	var usages []TenantUsage
+	err := AllTenants(client, opts).EachPage(func(page pagination.Page) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		u, err := ExtractAllTenants(page)
+		if err != nil {
+			return false, err
+		}
+		usages = append(usages, u...)
+		return true, nil
+	})
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Start.Before(usages[j].Start)
+	})
+	return usages, err
+}
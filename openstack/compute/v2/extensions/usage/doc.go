@@ -55,5 +55,23 @@ Example to Retrieve Usage for All Tenants:
 		panic(err)
 	}
 
+Example to Export the Usage of All Tenants Over a Long Period:
+
+	concurrentOpts := usage.ConcurrentOpts{
+		Start:       time.Date(2017, 01, 01, 0, 0, 0, 0, time.UTC),
+		End:         time.Date(2018, 01, 01, 0, 0, 0, 0, time.UTC),
+		Window:      24 * time.Hour,
+		Concurrency: 8,
+	}
+
+	err := usage.AllTenantsConcurrently(context.Background(), computeClient, concurrentOpts, func(u usage.TenantUsage) error {
+		fmt.Printf("%s,%s,%f\n", u.Start, u.TenantID, u.TotalHours)
+		return nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+
 */
 package usage
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/usage"
 	th "github.com/huaweicloud/golangsdk/testhelper"
 	"github.com/huaweicloud/golangsdk/testhelper/client"
@@ -312,3 +313,30 @@ var AllTenantsUsageResult = []usage.TenantUsage{
 		TotalVCPUsUsage:    1.25834212,
 	},
 }
+
+// HandleGetAllTenantsByWindowSuccessfully configures the test server to
+// respond to the AllTenants requests of each day of January 2017 with the
+// usage of FirstTenantID. The earlier days are answered later, to check that
+// the results are handled in order.
+func HandleGetAllTenantsByWindowSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-simple-tenant-usage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		start, err := time.Parse(golangsdk.RFC3339MilliNoZ, r.URL.Query().Get("start"))
+		th.AssertNoErr(t, err)
+		time.Sleep(time.Duration(31-start.Day()) * time.Millisecond)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"tenant_usages": [
+				{
+					"tenant_id": "%s",
+					"start": "%s",
+					"stop": "%s",
+					"total_hours": 24
+				}
+			]
+		}`, FirstTenantID, r.URL.Query().Get("start"), r.URL.Query().Get("end"))
+	})
+}
@@ -1,7 +1,9 @@
 package testing
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/usage"
 	"github.com/huaweicloud/golangsdk/pagination"
@@ -50,3 +52,29 @@ func TestAllTenants(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, count, 1)
 }
+
+func TestAllTenantsConcurrently(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAllTenantsByWindowSuccessfully(t)
+
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	opts := usage.ConcurrentOpts{
+		Start:       start,
+		End:         start.AddDate(0, 0, 30),
+		Window:      24 * time.Hour,
+		Concurrency: 8,
+	}
+
+	var actual []time.Time
+	err := usage.AllTenantsConcurrently(context.Background(), client.ServiceClient(), opts, func(u usage.TenantUsage) error {
+		th.AssertEquals(t, FirstTenantID, u.TenantID)
+		actual = append(actual, u.Start)
+		return nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 30, len(actual))
+	for i, s := range actual {
+		th.AssertEquals(t, start.AddDate(0, 0, i), s)
+	}
+}
@@ -17,6 +17,22 @@ Example to Attach a Volume
 		panic(err)
 	}
 
+Example to Attach a Volume and Get its Device Path
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	createOpts := volumeattach.CreateOpts{
+		VolumeID: "87463836-f0e2-4029-abf6-20c8892a3103",
+	}
+
+	result, err := volumeattach.AttachAndWait(ctx, computeClient, blockStorageClient, serverID, createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Volume attached as %s\n", result.Device)
+
 Example to Detach a Volume
 
 	serverID := "7ac8686c-de71-4acb-9600-ec18b1a1ed6d"
@@ -0,0 +1,19 @@
+package volumeattach
+
+import (
+	"fmt"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ErrAttachFailed is returned by AttachAndWait when the block storage service
+// reports that the volume could not be attached.
+type ErrAttachFailed struct {
+	golangsdk.BaseError
+	VolumeID string
+	Status   string
+}
+
+func (e ErrAttachFailed) Error() string {
+	return fmt.Sprintf("Volume %s could not be attached, it is in status %s", e.VolumeID, e.Status)
+}
@@ -40,21 +40,24 @@ func Create(client *golangsdk.ServiceClient, serverID string, opts CreateOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, serverID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get returns public data about a previously created VolumeAttachment.
 func Get(client *golangsdk.ServiceClient, serverID, attachmentID string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, serverID, attachmentID), &r.Body, nil)
+	resp, err := client.Get(getURL(client, serverID, attachmentID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete requests the deletion of a previous stored VolumeAttachment from
 // the server.
 func Delete(client *golangsdk.ServiceClient, serverID, attachmentID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, serverID, attachmentID), nil)
+	resp, err := client.Delete(deleteURL(client, serverID, attachmentID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -106,3 +106,30 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// HandleVolumeInUseSuccessfully configures the test server to respond to a
+// block storage Get request for the attached volume.
+func HandleVolumeInUseSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/volumes/a26887c6-c47b-4654-abb5-dfadf7d3f804", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+{
+  "volume": {
+    "id": "a26887c6-c47b-4654-abb5-dfadf7d3f804",
+    "status": "in-use",
+    "attachments": [
+      {
+        "attachment_id": "a26887c6-c47b-4654-abb5-dfadf7d3f804",
+        "device": "/dev/vdb",
+        "server_id": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+        "volume_id": "a26887c6-c47b-4654-abb5-dfadf7d3f804"
+      }
+    ]
+  }
+}
+`)
+	})
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"testing"
 
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/volumeattach"
@@ -74,6 +75,26 @@ func TestCreate(t *testing.T) {
 	th.CheckDeepEquals(t, &CreatedVolumeAttachment, actual)
 }
 
+func TestAttachAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateSuccessfully(t)
+	HandleVolumeInUseSuccessfully(t)
+
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+
+	actual, err := volumeattach.AttachAndWait(context.TODO(), client.ServiceClient(), client.ServiceClient(), serverID, volumeattach.CreateOpts{
+		Device:   "/dev/vdc",
+		VolumeID: "a26887c6-c47b-4654-abb5-dfadf7d3f804",
+	})
+	th.AssertNoErr(t, err)
+
+	expected := CreatedVolumeAttachment
+	expected.Device = "/dev/vdb"
+	th.CheckDeepEquals(t, &expected, actual)
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -0,0 +1,62 @@
+package volumeattach
+
+import (
+	"context"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/blockstorage/v3/volumes"
+)
+
+// attachPollInterval is the time waited between two polls of the volume.
+const attachPollInterval = 2 * time.Second
+
+// AttachAndWait attaches a volume to a server, then polls the volume with a
+// block storage client until it is in-use by the server.
+//
+// Compute answers the attach request before the volume is actually attached,
+// and block storage may still report the volume as available for a moment
+// afterwards, so a volume is only considered failed when it returns to
+// available after attaching started, or ends up in an error status. The
+// returned VolumeAttachment holds the device path reported by block storage,
+// which can differ from the requested Device.
+func AttachAndWait(ctx context.Context, computeClient, blockStorageClient *golangsdk.ServiceClient, serverID string, opts CreateOpts) (*VolumeAttachment, error) {
+	attachment, err := Create(computeClient, serverID, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	attaching := false
+	for {
+		volume, err := volumes.Get(blockStorageClient, opts.VolumeID).Extract()
+		if err != nil {
+			return attachment, err
+		}
+
+		switch volume.Status {
+		case "in-use":
+			for _, a := range volume.Attachments {
+				if a.ServerID == serverID {
+					if a.Device != "" {
+						attachment.Device = a.Device
+					}
+					return attachment, nil
+				}
+			}
+		case "reserved", "attaching":
+			attaching = true
+		case "available":
+			if attaching {
+				return attachment, ErrAttachFailed{VolumeID: opts.VolumeID, Status: volume.Status}
+			}
+		case "error", "error_attaching":
+			return attachment, ErrAttachFailed{VolumeID: opts.VolumeID, Status: volume.Status}
+		}
+
+		select {
+		case <-ctx.Done():
+			return attachment, ctx.Err()
+		case <-time.After(attachPollInterval):
+		}
+	}
+}
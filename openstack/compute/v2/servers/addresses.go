@@ -0,0 +1,146 @@
+package servers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Address types of the addresses of a Server.
+const (
+	AddressTypeFixed    = "fixed"
+	AddressTypeFloating = "floating"
+)
+
+// AddressFilter selects the addresses of a server. Fields left at their zero
+// value match any address.
+type AddressFilter struct {
+	// Network is the label of the network of the addresses.
+	Network string
+
+	// Version is the IP version, 4 or 6.
+	Version int
+
+	// Type is AddressTypeFixed or AddressTypeFloating.
+	Type string
+}
+
+// ExtractAddresses returns the addresses of the server keyed by the label of
+// their network.
+func (r Server) ExtractAddresses() (map[string][]Address, error) {
+	b, err := json.Marshal(r.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	var addresses map[string][]Address
+	err = json.Unmarshal(b, &addresses)
+	return addresses, err
+}
+
+// FilterAddresses returns the addresses of the server matching the filter,
+// sorted by network label and in the order of each network.
+func (r Server) FilterAddresses(filter AddressFilter) ([]Address, error) {
+	addresses, err := r.ExtractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make([]string, 0, len(addresses))
+	for network := range addresses {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+
+	var matching []Address
+	for _, network := range networks {
+		if filter.Network != "" && network != filter.Network {
+			continue
+		}
+		for _, address := range addresses[network] {
+			if filter.Version != 0 && address.Version != filter.Version {
+				continue
+			}
+			if filter.Type != "" && address.Type != filter.Type {
+				continue
+			}
+			matching = append(matching, address)
+		}
+	}
+	return matching, nil
+}
+
+// FirstPublicIPv4 returns the first public IPv4 address of a server, e.g. to
+// register it as a load balancer member reached from the internet. It is, in
+// order of preference, the access IPv4 address of the server, its first
+// floating IPv4 address, or its first fixed IPv4 address outside of the
+// private and shared address ranges.
+func FirstPublicIPv4(server Server) (string, error) {
+	if server.AccessIPv4 != "" {
+		return server.AccessIPv4, nil
+	}
+
+	floating, err := server.FilterAddresses(AddressFilter{Version: 4, Type: AddressTypeFloating})
+	if err != nil {
+		return "", err
+	}
+	if len(floating) > 0 {
+		return floating[0].Address, nil
+	}
+
+	fixed, err := server.FilterAddresses(AddressFilter{Version: 4})
+	if err != nil {
+		return "", err
+	}
+	for _, address := range fixed {
+		if ip := net.ParseIP(address.Address); ip != nil && !isPrivateIP(ip) {
+			return address.Address, nil
+		}
+	}
+	return "", noAddressError(server, "public IPv4")
+}
+
+// FirstPrivateIPv4 returns the first fixed IPv4 address of a server on the
+// given network, or on any network if network is empty, e.g. to register it
+// as a load balancer member on that network.
+func FirstPrivateIPv4(server Server, network string) (string, error) {
+	fixed, err := server.FilterAddresses(AddressFilter{Network: network, Version: 4})
+	if err != nil {
+		return "", err
+	}
+	for _, address := range fixed {
+		if address.Type != AddressTypeFloating {
+			return address.Address, nil
+		}
+	}
+	return "", noAddressError(server, "private IPv4")
+}
+
+var privateNetworks = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"100.64.0.0/10",
+	"169.254.0.0/16",
+	"127.0.0.0/8",
+}
+
+func isPrivateIP(ip net.IP) bool {
+	for _, cidr := range privateNetworks {
+		_, network, _ := net.ParseCIDR(cidr)
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func noAddressError(server Server, kind string) error {
+	err := golangsdk.ErrResourceNotFound{}
+	err.ResourceType = "server address"
+	err.Name = server.ID
+	err.Info = fmt.Sprintf("Server %s has no %s address", server.ID, kind)
+	return err
+}
@@ -111,5 +111,49 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Get the Addresses of a Server
+
+	server, err := servers.Get(computeClient, "d9072956-1560-487c-97f2-18bdf65ec749").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	ipv6, err := server.FilterAddresses(servers.AddressFilter{
+		Network: "private",
+		Version: 6,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	publicIP, err := servers.FirstPublicIPv4(*server)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Console of a Server
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	consoleOpts := servers.GetConsoleOpts{
+		Type: servers.ConsoleTypeNoVNC,
+	}
+
+	console, err := servers.GetConsole(computeClient, serverID, consoleOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(console.URL)
+
+	outputOpts := servers.ShowConsoleOutputOpts{
+		Length: 50,
+	}
+
+	output, err := servers.ShowConsoleOutput(computeClient, serverID, outputOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package servers
@@ -43,6 +43,21 @@ type ListOpts struct {
 	// Host is the name of the host as a string.
 	Host string `q:"host"`
 
+	// IP is a regular expression to match the IPv4 address of the server.
+	IP string `q:"ip"`
+
+	// IP6 is a regular expression to match the IPv6 address of the server.
+	IP6 string `q:"ip6"`
+
+	// UserID lists servers created by a particular user.
+	UserID string `q:"user_id"`
+
+	// SortKey sorts the servers by an attribute, e.g. "created_at".
+	SortKey string `q:"sort_key"`
+
+	// SortDir sets the sorting direction, either "asc" or "desc".
+	SortDir string `q:"sort_dir"`
+
 	// Marker is a UUID of the server at which you want to set a marker.
 	Marker string `q:"marker"`
 
@@ -282,28 +297,32 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(listURL(client), reqBody, &r.Body, nil)
+	resp, err := client.Post(listURL(client), reqBody, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete requests that a server previously provisioned be removed from your
 // account.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // ForceDelete forces the deletion of a server.
 func ForceDelete(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"forceDelete": ""}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"forceDelete": ""}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get requests details on a single server, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 203},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -340,9 +359,10 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -354,7 +374,8 @@ func ChangeAdminPassword(client *golangsdk.ServiceClient, id, newPassword string
 			"adminPass": newPassword,
 		},
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	resp, err := client.Post(actionURL(client, id), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -408,7 +429,8 @@ func Reboot(client *golangsdk.ServiceClient, id string, opts RebootOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	resp, err := client.Post(actionURL(client, id), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -487,7 +509,8 @@ func Rebuild(client *golangsdk.ServiceClient, id string, opts RebuildOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, nil)
+	resp, err := client.Post(actionURL(client, id), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -525,23 +548,26 @@ func Resize(client *golangsdk.ServiceClient, id string, opts ResizeOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	resp, err := client.Post(actionURL(client, id), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // ConfirmResize confirms a previous resize operation on a server.
 // See Resize() for more details.
 func ConfirmResize(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"confirmResize": nil}, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"confirmResize": nil}, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{201, 202, 204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // RevertResize cancels a previous resize operation on a server.
 // See Resize() for more details.
 func RevertResize(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"revertResize": nil}, nil, nil)
+	resp, err := client.Post(actionURL(client, id), map[string]interface{}{"revertResize": nil}, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -577,15 +603,17 @@ func ResetMetadata(client *golangsdk.ServiceClient, id string, opts ResetMetadat
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Metadata requests all the metadata for the given server ID.
 func Metadata(client *golangsdk.ServiceClient, id string) (r GetMetadataResult) {
-	_, r.Err = client.Get(metadataURL(client, id), &r.Body, nil)
+	resp, err := client.Get(metadataURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -604,9 +632,10 @@ func UpdateMetadata(client *golangsdk.ServiceClient, id string, opts UpdateMetad
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(metadataURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -644,23 +673,26 @@ func CreateMetadatum(client *golangsdk.ServiceClient, id string, opts MetadatumO
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(metadatumURL(client, id, key), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(metadatumURL(client, id, key), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Metadatum requests the key-value pair with the given key for the given
 // server ID.
 func Metadatum(client *golangsdk.ServiceClient, id, key string) (r GetMetadatumResult) {
-	_, r.Err = client.Get(metadatumURL(client, id, key), &r.Body, nil)
+	resp, err := client.Get(metadatumURL(client, id, key), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DeleteMetadatum will delete the key-value pair with the given key for the
 // given server ID.
 func DeleteMetadatum(client *golangsdk.ServiceClient, id, key string) (r DeleteMetadatumResult) {
-	_, r.Err = client.Delete(metadatumURL(client, id, key), nil)
+	resp, err := client.Delete(metadatumURL(client, id, key), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -758,7 +790,8 @@ func IDFromName(client *golangsdk.ServiceClient, name string) (string, error) {
 // GetPassword makes a request against the nova API to get the encrypted
 // administrative password.
 func GetPassword(client *golangsdk.ServiceClient, serverId string) (r GetPasswordResult) {
-	_, r.Err = client.Get(passwordURL(client, serverId), &r.Body, nil)
+	resp, err := client.Get(passwordURL(client, serverId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -787,8 +820,69 @@ func ShowConsoleOutput(client *golangsdk.ServiceClient, id string, opts ShowCons
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// ConsoleType is the type of a remote console of a server.
+type ConsoleType string
+
+const (
+	ConsoleTypeNoVNC      ConsoleType = "novnc"
+	ConsoleTypeXVPVNC     ConsoleType = "xvpvnc"
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+	ConsoleTypeSerial     ConsoleType = "serial"
+	ConsoleTypeRDPHTML5   ConsoleType = "rdp-html5"
+)
+
+// consoleActions maps the console types to the server action returning them.
+var consoleActions = map[ConsoleType]string{
+	ConsoleTypeNoVNC:      "os-getVNCConsole",
+	ConsoleTypeXVPVNC:     "os-getVNCConsole",
+	ConsoleTypeSPICEHTML5: "os-getSPICEConsole",
+	ConsoleTypeSerial:     "os-getSerialConsole",
+	ConsoleTypeRDPHTML5:   "os-getRDPConsole",
+}
+
+// GetConsoleOptsBuilder is the interface types must satisfy in order to be
+// used as GetConsole options
+type GetConsoleOptsBuilder interface {
+	ToServerGetConsoleMap() (map[string]interface{}, error)
+}
+
+// GetConsoleOpts satisfies the GetConsoleOptsBuilder
+type GetConsoleOpts struct {
+	// Type is the type of the remote console.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToServerGetConsoleMap formats a GetConsoleOpts structure into a request body.
+func (opts GetConsoleOpts) ToServerGetConsoleMap() (map[string]interface{}, error) {
+	action, ok := consoleActions[opts.Type]
+	if !ok {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "servers.GetConsoleOpts.Type"
+		err.Value = opts.Type
+		err.Info = "Unsupported console type"
+		return nil, err
+	}
+	return golangsdk.BuildRequestBody(opts, action)
+}
+
+// GetConsole makes a request against the nova API to get the URL of a remote
+// console of the server.
+func GetConsole(client *golangsdk.ServiceClient, id string, opts GetConsoleOptsBuilder) (r GetConsoleResult) {
+	b, err := opts.ToServerGetConsoleMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(actionURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -89,6 +89,30 @@ func (r ShowConsoleOutputResult) Extract() (string, error) {
 	return s.Output, err
 }
 
+// GetConsoleResult represents the result of a GetConsole operation. Call its
+// Extract method to retrieve the console.
+type GetConsoleResult struct {
+	golangsdk.Result
+}
+
+// Console is a remote console of a server.
+type Console struct {
+	// Type is the type of the console, e.g. "novnc".
+	Type string `json:"type"`
+
+	// URL is the URL to connect to the console with.
+	URL string `json:"url"`
+}
+
+// Extract will return the console from a GetConsole request.
+func (r GetConsoleResult) Extract() (*Console, error) {
+	var s struct {
+		Console *Console `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Console, err
+}
+
 // GetPasswordResult represent the result of a get os-server-password operation.
 // Call its ExtractPassword method to retrieve the password.
 type GetPasswordResult struct {
@@ -358,6 +382,14 @@ func (r MetadatumResult) Extract() (map[string]string, error) {
 type Address struct {
 	Version int    `json:"version"`
 	Address string `json:"addr"`
+
+	// Type is AddressTypeFixed or AddressTypeFloating. It is only set in the
+	// addresses of a Server.
+	Type string `json:"OS-EXT-IPS:type"`
+
+	// MACAddr is the MAC address of the port of the address. It is only set
+	// in the addresses of a Server.
+	MACAddr string `json:"OS-EXT-IPS-MAC:mac_addr"`
 }
 
 // AddressPage abstracts the raw results of making a ListAddresses() request
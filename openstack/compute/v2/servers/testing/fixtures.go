@@ -836,6 +836,7 @@ func HandleServerGetSuccessfully(t *testing.T) {
 		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
 		th.TestHeader(t, r, "Accept", "application/json")
 
+		w.Header().Set("X-Compute-Request-Id", "req-3c6cb2d5-7a0f-4b8f-9d4e-6f1e0b2a9c17")
 		fmt.Fprintf(w, SingleServerBody)
 	})
 }
@@ -901,6 +902,26 @@ func HandleShowConsoleOutputSuccessfully(t *testing.T, response string) {
 	})
 }
 
+// HandleGetConsoleSuccessfully sets up the test server to respond to a os-getVNCConsole request with success.
+func HandleGetConsoleSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{ "os-getVNCConsole": { "type": "novnc" } }`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+			{
+				"console": {
+					"type": "novnc",
+					"url": "http://127.0.0.1:6080/vnc_auto.html?token=191996c3-7b0f-42f3-95a7-f1839f2da6ed"
+				}
+			}
+		`)
+	})
+}
+
 // HandleRebuildSuccessfully sets up the test server to respond to a rebuild request with success.
 func HandleRebuildSuccessfully(t *testing.T, response string) {
 	th.Mux.HandleFunc("/servers/1234asdf/action", func(w http.ResponseWriter, r *http.Request) {
@@ -1115,3 +1136,42 @@ func HandlePasswordGetSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, ServerPasswordBody)
 	})
 }
+
+// HandleServerWatchSuccessfully sets up the test server to respond to the
+// listings of Watch. The first listing returns a Date header, and the second
+// one doesn't, so that the third one starts from the newest update.
+func HandleServerWatchSuccessfully(t *testing.T) {
+	calls := 0
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		calls++
+		switch calls {
+		case 1:
+			th.TestFormValues(t, r, map[string]string{})
+			w.Header().Set("Date", "Tue, 02 Jun 2020 10:00:00 GMT")
+			fmt.Fprintf(w, `{"servers": [
+				{"id": "a", "status": "ACTIVE", "updated": "2020-06-02T09:59:00Z"},
+				{"id": "b", "status": "ACTIVE", "updated": "2020-06-02T09:59:58Z"}
+			]}`)
+		case 2:
+			th.TestFormValues(t, r, map[string]string{"changes-since": "2020-06-02T09:59:55Z"})
+			w.Header()["Date"] = nil
+			fmt.Fprintf(w, `{"servers": [
+				{"id": "b", "status": "ACTIVE", "updated": "2020-06-02T09:59:58Z"},
+				{"id": "a", "status": "SHUTOFF", "updated": "2020-06-02T10:00:30Z"},
+				{"id": "c", "status": "BUILD", "updated": "2020-06-02T10:00:10Z"},
+				{"id": "d", "status": "DELETED", "updated": "2020-06-02T10:00:20Z"}
+			]}`)
+		case 3:
+			th.TestFormValues(t, r, map[string]string{"changes-since": "2020-06-02T10:00:25Z"})
+			fmt.Fprintf(w, `{"servers": [
+				{"id": "c", "status": "DELETED", "updated": "2020-06-02T10:00:40Z"}
+			]}`)
+		default:
+			fmt.Fprintf(w, `{"servers": []}`)
+		}
+	})
+}
@@ -1,11 +1,15 @@
 package testing
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/availabilityzones"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/diskconfig"
 	"github.com/huaweicloud/golangsdk/openstack/compute/v2/extensions/extendedstatus"
@@ -218,6 +222,24 @@ func TestGetServer(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, *actual)
 }
 
+func TestGetServerRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetSuccessfully(t)
+
+	res := servers.Get(client.ServiceClient(), "1234asdf")
+	th.AssertNoErr(t, res.Err)
+	th.AssertEquals(t, "req-3c6cb2d5-7a0f-4b8f-9d4e-6f1e0b2a9c17", res.RequestID())
+
+	res = servers.Get(client.ServiceClient(), "unknown")
+	err, ok := res.Err.(golangsdk.ErrDefault404)
+	if !ok {
+		t.Fatalf("Expected ErrDefault404, got %v", res.Err)
+	}
+	th.AssertEquals(t, "text/plain; charset=utf-8", err.ResponseHeader.Get("Content-Type"))
+	th.AssertEquals(t, "text/plain; charset=utf-8", res.Header.Get("Content-Type"))
+}
+
 func TestGetFaultyServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -297,6 +319,22 @@ func TestShowConsoleOutput(t *testing.T) {
 	th.AssertByteArrayEquals(t, []byte(ConsoleOutput), []byte(actual))
 }
 
+func TestGetConsole(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetConsoleSuccessfully(t)
+
+	opts := servers.GetConsoleOpts{
+		Type: servers.ConsoleTypeNoVNC,
+	}
+	actual, err := servers.GetConsole(client.ServiceClient(), "1234asdf", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &servers.Console{
+		Type: "novnc",
+		URL:  "http://127.0.0.1:6080/vnc_auto.html?token=191996c3-7b0f-42f3-95a7-f1839f2da6ed",
+	}, actual)
+}
+
 func TestGetPassword(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -550,3 +588,28 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestWatchServers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerWatchSuccessfully(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var events []string
+	for event := range servers.Watch(ctx, client.ServiceClient(), servers.ListOpts{}, time.Millisecond) {
+		th.AssertNoErr(t, event.Err)
+		events = append(events, fmt.Sprintf("%s %s %s", event.Type, event.Server.ID, event.Server.Status))
+		if len(events) == 5 {
+			cancel()
+		}
+	}
+	th.CheckDeepEquals(t, []string{
+		"ADDED a ACTIVE",
+		"ADDED b ACTIVE",
+		"MODIFIED a SHUTOFF",
+		"ADDED c BUILD",
+		"DELETED c DELETED",
+	}, events)
+}
@@ -108,3 +108,53 @@ func TestListAddressesAllPages(t *testing.T) {
 	_, err = servers.ExtractAddresses(allPages)
 	th.AssertNoErr(t, err)
 }
+
+func TestServerAddresses(t *testing.T) {
+	server := ServerHerp
+	server.Addresses = map[string]interface{}{
+		"private": []interface{}{
+			map[string]interface{}{
+				"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b",
+				"version":                 float64(4),
+				"addr":                    "10.0.0.32",
+				"OS-EXT-IPS:type":         "fixed",
+			},
+			map[string]interface{}{
+				"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b",
+				"version":                 float64(4),
+				"addr":                    "50.56.176.35",
+				"OS-EXT-IPS:type":         "floating",
+			},
+			map[string]interface{}{
+				"OS-EXT-IPS-MAC:mac_addr": "fa:16:3e:7c:1b:2b",
+				"version":                 float64(6),
+				"addr":                    "2001:4800:790e:510:be76:4eff:fe04:84a8",
+				"OS-EXT-IPS:type":         "fixed",
+			},
+		},
+	}
+
+	ipv6, err := server.FilterAddresses(servers.AddressFilter{Version: 6})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []servers.Address{
+		{
+			Version: 6,
+			Address: "2001:4800:790e:510:be76:4eff:fe04:84a8",
+			Type:    servers.AddressTypeFixed,
+			MACAddr: "fa:16:3e:7c:1b:2b",
+		},
+	}, ipv6)
+
+	publicIP, err := servers.FirstPublicIPv4(server)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "50.56.176.35", publicIP)
+
+	privateIP, err := servers.FirstPrivateIPv4(server, "private")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "10.0.0.32", privateIP)
+
+	_, err = servers.FirstPublicIPv4(ServerHerp)
+	if _, ok := err.(golangsdk.ErrResourceNotFound); !ok {
+		t.Errorf("Expected ErrResourceNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package servers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// WatchEventType is the kind of change reported by Watch.
+type WatchEventType string
+
+const (
+	WatchAdded    WatchEventType = "ADDED"
+	WatchModified WatchEventType = "MODIFIED"
+	WatchDeleted  WatchEventType = "DELETED"
+)
+
+// watchOverlap is subtracted from the changes-since filter of Watch, so that
+// the changes made while a listing is served are not missed.
+const watchOverlap = 5 * time.Second
+
+// WatchEvent is a change of a server, or a listing error when Err is set.
+type WatchEvent struct {
+	Type   WatchEventType
+	Server Server
+	Err    error
+}
+
+// Watch lists the servers matching opts, then polls every interval for the
+// servers changed since the previous listing with the changes-since filter,
+// which also returns the deleted servers. It sends an event on the returned
+// channel for each change; the servers of the first listing are reported as
+// added. The channel is closed once ctx is done.
+//
+// The changes-since filter is taken from the clock of the server, with the
+// Date header of the previous listing or else the newest update it returned,
+// less a small overlap. The servers returned again without a newer update are
+// not reported twice.
+//
+// Listing errors are sent as events, and the next poll starts from the last
+// successful listing.
+func Watch(ctx context.Context, c *golangsdk.ServiceClient, opts ListOpts, interval time.Duration) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	go func() {
+		defer close(events)
+
+		send := func(event WatchEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		known := make(map[string]time.Time)
+		for {
+			servers, date, err := listAll(c, opts)
+			if err != nil {
+				if !send(WatchEvent{Err: err}) {
+					return
+				}
+			} else {
+				for _, server := range servers {
+					updated, ok := known[server.ID]
+					event := WatchEvent{Type: WatchModified, Server: server}
+					switch {
+					case server.Status == "DELETED":
+						if !ok {
+							continue
+						}
+						delete(known, server.ID)
+						event.Type = WatchDeleted
+					case !ok:
+						known[server.ID] = server.Updated
+						event.Type = WatchAdded
+					case !server.Updated.After(updated):
+						continue
+					default:
+						known[server.ID] = server.Updated
+					}
+					if !send(event) {
+						return
+					}
+				}
+				if date.IsZero() {
+					for _, server := range servers {
+						if server.Updated.After(date) {
+							date = server.Updated
+						}
+					}
+				}
+				if !date.IsZero() {
+					opts.ChangesSince = date.Add(-watchOverlap).UTC().Format(time.RFC3339)
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return events
+}
+
+// listAll returns the servers of all the pages of a listing, and the time of
+// the server when the first page was returned.
+func listAll(c *golangsdk.ServiceClient, opts ListOpts) ([]Server, time.Time, error) {
+	var servers []Server
+	var date time.Time
+	err := List(c, opts).EachPage(func(page pagination.Page) (bool, error) {
+		if date.IsZero() {
+			date, _ = http.ParseTime(page.(ServerPage).Header.Get("Date"))
+		}
+		s, err := ExtractServers(page)
+		if err != nil {
+			return false, err
+		}
+		servers = append(servers, s...)
+		return true, nil
+	})
+	return servers, date, err
+}
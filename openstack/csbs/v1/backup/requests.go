@@ -103,9 +103,10 @@ func Create(client *golangsdk.ServiceClient, resourceId string, opts CreateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, resourceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, resourceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -142,16 +143,18 @@ func QueryResourceBackupCapability(client *golangsdk.ServiceClient, opts Resourc
 
 		return
 	}
-	_, r.Err = client.Post(resourceURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(resourceURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get will get a single backup with specific ID. To extract the Backup object from the response,
 // call the ExtractBackup method on the GetResult.
 func Get(client *golangsdk.ServiceClient, backupId string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, backupId), &r.Body, nil)
+	resp, err := client.Get(getURL(client, backupId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 
@@ -159,9 +162,10 @@ func Get(client *golangsdk.ServiceClient, backupId string) (r GetResult) {
 
 // Delete will delete an existing backup.
 func Delete(client *golangsdk.ServiceClient, checkpoint_id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, checkpoint_id), &golangsdk.RequestOpts{
+	resp, err := client.Delete(deleteURL(client, checkpoint_id), &golangsdk.RequestOpts{
 		OkCodes:      []int{200},
 		JSONResponse: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -158,19 +158,21 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get will get a single backup policy with specific ID.
 // call the Extract method on the GetResult.
 func Get(client *golangsdk.ServiceClient, policy_id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, policy_id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(resourceURL(client, policy_id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:  []int{200},
 		JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -213,17 +215,19 @@ func Update(c *golangsdk.ServiceClient, policy_id string, opts UpdateOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, policy_id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, policy_id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will delete an existing backup policy.
 func Delete(client *golangsdk.ServiceClient, policy_id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, policy_id), &golangsdk.RequestOpts{
+	resp, err := client.Delete(resourceURL(client, policy_id), &golangsdk.RequestOpts{
 		OkCodes:      []int{200},
 		JSONResponse: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -33,32 +33,36 @@ func PolicyCreate(client *golangsdk.ServiceClient, opts CreateOptsBuilder, clust
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(policyURL(client, clusterId), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(policyURL(client, clusterId), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // PolicyGet retrieves the snapshot policy with the provided cluster ID.
 // To extract the snapshot policy object from the response, call the Extract method on the GetResult.
 func PolicyGet(client *golangsdk.ServiceClient, clusterId string) (r PolicyResult) {
-	_, r.Err = client.Get(policyURL(client, clusterId), &r.Body, nil)
+	resp, err := client.Get(policyURL(client, clusterId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Enable will enable the Snapshot function with the provided ID.
 func Enable(client *golangsdk.ServiceClient, clusterId string) (r ErrorResult) {
 	body := make(map[string]interface{})
-	_, r.Err = client.Post(enableURL(client, clusterId), body, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(enableURL(client, clusterId), body, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Disable will disable the Snapshot function with the provided ID.
 func Disable(client *golangsdk.ServiceClient, clusterId string) (r ErrorResult) {
-	_, r.Err = client.Delete(disableURL(client, clusterId), nil)
+	resp, err := client.Delete(disableURL(client, clusterId), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -84,24 +88,27 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder, clusterId s
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client, clusterId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, clusterId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // List retrieves the Snapshots with the provided ID. To extract the Snapshot
 // objects from the response, call the Extract method on the GetResult.
 func List(client *golangsdk.ServiceClient, clusterId string) (r ListResult) {
-	_, r.Err = client.Get(listURL(client, clusterId), &r.Body, nil)
+	resp, err := client.Get(listURL(client, clusterId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will delete the existing Snapshot ID with the provided ID.
 func Delete(client *golangsdk.ServiceClient, clusterId, id string) (r ErrorResult) {
-	_, r.Err = client.Delete(deleteURL(client, clusterId, id), &golangsdk.RequestOpts{
+	resp, err := client.Delete(deleteURL(client, clusterId, id), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,29 @@
+/*
+Package traces retrieves the audit records of the operations performed on
+cloud resources, such as the changes made to load balancers, recorded by the
+Cloud Trace Service.
+
+Example to List the Changes Made to a Load Balancer in the Last Day
+
+	listOpts := traces.ListOpts{
+		ServiceType:  traces.ServiceTypeELB,
+		ResourceType: traces.ResourceTypeLoadBalancer,
+		ResourceID:   "165b6a38-5278-4569-b747-b2ee65ea84a4",
+		From:         time.Now().Add(-24 * time.Hour),
+	}
+
+	allPages, err := traces.List(ctsClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTraces, err := traces.ExtractTraces(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trace := range allTraces {
+		fmt.Printf("%s: %s by %s\n", trace.Time, trace.Name, trace.User.Name)
+	}
+*/
+package traces
@@ -0,0 +1,123 @@
+package traces
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// DefaultTrackerName is the name of the tracker recording the operations
+// of a project.
+const DefaultTrackerName = "system"
+
+// Trace ratings.
+const (
+	RatingNormal   = "normal"
+	RatingWarning  = "warning"
+	RatingIncident = "incident"
+)
+
+// Service and resource types of the load balancer operations.
+const (
+	ServiceTypeELB = "ELB"
+
+	ResourceTypeLoadBalancer  = "loadbalancer"
+	ResourceTypeListener      = "listener"
+	ResourceTypePool          = "pool"
+	ResourceTypeMember        = "member"
+	ResourceTypeHealthMonitor = "healthmonitor"
+	ResourceTypeWhitelist     = "whitelist"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTraceListQuery() (string, error)
+}
+
+// ListOpts allows to filter the traces by resource, user and time range.
+type ListOpts struct {
+	// TrackerName is the tracker recording the traces, DefaultTrackerName if
+	// not set.
+	TrackerName string
+
+	// ServiceType is the cloud service of the traces, e.g. ServiceTypeELB.
+	ServiceType string `q:"service_type"`
+
+	// ResourceType is the type of the resources of the traces, e.g.
+	// ResourceTypeLoadBalancer.
+	ResourceType string `q:"resource_type"`
+
+	ResourceID   string `q:"resource_id"`
+	ResourceName string `q:"resource_name"`
+
+	// TraceName is the name of the operation, e.g. createLoadBalancer.
+	TraceName string `q:"trace_name"`
+
+	// TraceRating is one of RatingNormal, RatingWarning or RatingIncident.
+	TraceRating string `q:"trace_rating"`
+
+	// User is the name of the user who performed the operations.
+	User string `q:"user"`
+
+	// From and To restrict the traces to the ones recorded within this time
+	// range. The API only keeps the traces of the last 7 days.
+	From time.Time
+	To   time.Time
+
+	// Limit is the number of traces per page, 10 by default and at most 200.
+	Limit int `q:"limit"`
+}
+
+// ToTraceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTraceListQuery() (string, error) {
+	if !opts.From.IsZero() && !opts.To.IsZero() && opts.To.Before(opts.From) {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "traces.ListOpts.To"
+		err.Value = opts.To
+		err.Info = "To must not be before From"
+		return "", err
+	}
+
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	params := q.Query()
+	params.Set("trace_type", "system")
+	if !opts.From.IsZero() {
+		params.Set("from", strconv.FormatInt(toMilliseconds(opts.From), 10))
+	}
+	if !opts.To.IsZero() {
+		params.Set("to", strconv.FormatInt(toMilliseconds(opts.To), 10))
+	}
+	q.RawQuery = params.Encode()
+	return q.String(), nil
+}
+
+// List returns a Pager which allows you to iterate over the traces of the
+// operations performed on the cloud resources, most recent first.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	trackerName := DefaultTrackerName
+	if o, ok := opts.(ListOpts); ok && o.TrackerName != "" {
+		trackerName = o.TrackerName
+	}
+
+	url := rootURL(c, trackerName)
+	if opts != nil {
+		query, err := opts.ToTraceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return TracePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+func toMilliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
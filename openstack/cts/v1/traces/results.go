@@ -0,0 +1,124 @@
+package traces
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Trace is the record of an operation performed on a cloud resource.
+type Trace struct {
+	ID     string `json:"trace_id"`
+	Name   string `json:"trace_name"`
+	Rating string `json:"trace_rating"`
+	Type   string `json:"trace_type"`
+
+	ServiceType  string `json:"service_type"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+
+	// User is the user who performed the operation.
+	User User `json:"user"`
+
+	SourceIP   string `json:"source_ip"`
+	APIVersion string `json:"api_version"`
+
+	// Request and Response are the bodies of the API call of the operation.
+	Request  string `json:"request"`
+	Response string `json:"response"`
+
+	// Code is the HTTP status code of the API call of the operation.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	// Time is when the operation was performed, and RecordTime when it was
+	// recorded.
+	Time       time.Time `json:"-"`
+	RecordTime time.Time `json:"-"`
+}
+
+// User identifies the user who performed an operation.
+type User struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Domain Domain `json:"domain"`
+}
+
+// Domain is the domain of a User.
+type Domain struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UnmarshalJSON converts the timestamps of a trace, in milliseconds, to
+// times.
+func (r *Trace) UnmarshalJSON(b []byte) error {
+	type tmp Trace
+	var s struct {
+		tmp
+		Time       int64 `json:"time"`
+		RecordTime int64 `json:"record_time"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	*r = Trace(s.tmp)
+	r.Time = fromMilliseconds(s.Time)
+	r.RecordTime = fromMilliseconds(s.RecordTime)
+	return nil
+}
+
+func fromMilliseconds(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
+// TracePage is the page returned by a pager when traversing over a
+// collection of traces.
+type TracePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of traces has reached
+// the end of a page and the pager seeks to traverse over a new one. The next
+// page starts after the marker of the page metadata.
+func (r TracePage) NextPageURL() (string, error) {
+	var s struct {
+		MetaData struct {
+			Marker string `json:"marker"`
+		} `json:"meta_data"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+
+	q := r.URL.Query()
+	if s.MetaData.Marker == "" || s.MetaData.Marker == q.Get("next") {
+		return "", nil
+	}
+	q.Set("next", s.MetaData.Marker)
+	u := r.URL
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// IsEmpty checks whether a TracePage struct is empty.
+func (r TracePage) IsEmpty() (bool, error) {
+	is, err := ExtractTraces(r)
+	return len(is) == 0, err
+}
+
+// ExtractTraces accepts a Page struct, specifically a TracePage struct, and
+// extracts the elements into a slice of Trace structs.
+func ExtractTraces(r pagination.Page) ([]Trace, error) {
+	var s struct {
+		Traces []Trace `json:"traces"`
+	}
+	err := (r.(TracePage)).ExtractInto(&s)
+	return s.Traces, err
+}
@@ -0,0 +1,116 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/traces"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+// TracesListBody contains the first page of a trace list response.
+const TracesListBody = `
+{
+    "traces": [
+        {
+            "trace_id": "2a4f0eb9-3a2e-11eb-9f6c-0255ac100030",
+            "trace_name": "updateLoadBalancer",
+            "trace_rating": "normal",
+            "trace_type": "ApiCall",
+            "service_type": "ELB",
+            "resource_type": "loadbalancer",
+            "resource_id": "165b6a38-5278-4569-b747-b2ee65ea84a4",
+            "resource_name": "web_lb",
+            "user": {
+                "id": "ad8c2f9a4b0d4b1da1d1e6c1a6e4ccf0",
+                "name": "ops",
+                "domain": {
+                    "id": "2ae5d1a9f1e14cb09bc9c7a6d1e6b0d4",
+                    "name": "company"
+                }
+            },
+            "source_ip": "10.0.0.12",
+            "api_version": "v2.0",
+            "request": "{\"loadbalancer\":{\"name\":\"web_lb\"}}",
+            "response": "",
+            "code": "200",
+            "message": "",
+            "time": 1607429400000,
+            "record_time": 1607429401500
+        }
+    ],
+    "meta_data": {
+        "count": 1,
+        "marker": "2a4f0eb9-3a2e-11eb-9f6c-0255ac100030"
+    }
+}
+`
+
+// TracesListLastBody contains the last page of a trace list response.
+const TracesListLastBody = `
+{
+    "traces": [],
+    "meta_data": {
+        "count": 0
+    }
+}
+`
+
+// UpdateLoadBalancerTrace is the trace of TracesListBody.
+var UpdateLoadBalancerTrace = traces.Trace{
+	ID:           "2a4f0eb9-3a2e-11eb-9f6c-0255ac100030",
+	Name:         "updateLoadBalancer",
+	Rating:       traces.RatingNormal,
+	Type:         "ApiCall",
+	ServiceType:  traces.ServiceTypeELB,
+	ResourceType: traces.ResourceTypeLoadBalancer,
+	ResourceID:   "165b6a38-5278-4569-b747-b2ee65ea84a4",
+	ResourceName: "web_lb",
+	User: traces.User{
+		ID:   "ad8c2f9a4b0d4b1da1d1e6c1a6e4ccf0",
+		Name: "ops",
+		Domain: traces.Domain{
+			ID:   "2ae5d1a9f1e14cb09bc9c7a6d1e6b0d4",
+			Name: "company",
+		},
+	},
+	SourceIP:   "10.0.0.12",
+	APIVersion: "v2.0",
+	Request:    `{"loadbalancer":{"name":"web_lb"}}`,
+	Code:       "200",
+	Time:       time.Date(2020, 12, 8, 12, 10, 0, 0, time.UTC),
+	RecordTime: time.Date(2020, 12, 8, 12, 10, 1, 500000000, time.UTC),
+}
+
+// HandleTraceListSuccessfully sets up the test server to respond to a trace
+// List request filtered on a load balancer and a time range.
+func HandleTraceListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/system/trace", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		r.ParseForm()
+		for k, v := range map[string]string{
+			"trace_type":    "system",
+			"service_type":  "ELB",
+			"resource_type": "loadbalancer",
+			"resource_id":   "165b6a38-5278-4569-b747-b2ee65ea84a4",
+			"from":          "1607385600000",
+			"to":            "1607472000000",
+		} {
+			th.CheckEquals(t, v, r.Form.Get(k))
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Form.Get("next") {
+		case "":
+			fmt.Fprintf(w, TracesListBody)
+		case "2a4f0eb9-3a2e-11eb-9f6c-0255ac100030":
+			fmt.Fprintf(w, TracesListLastBody)
+		default:
+			t.Fatalf("/system/trace invoked with unexpected marker=[%s]", r.Form.Get("next"))
+		}
+	})
+}
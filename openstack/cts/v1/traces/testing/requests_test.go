@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/cts/v1/traces"
+	"github.com/huaweicloud/golangsdk/pagination"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTraceListSuccessfully(t)
+
+	listOpts := traces.ListOpts{
+		ServiceType:  traces.ServiceTypeELB,
+		ResourceType: traces.ResourceTypeLoadBalancer,
+		ResourceID:   "165b6a38-5278-4569-b747-b2ee65ea84a4",
+		From:         time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2020, 12, 9, 0, 0, 0, 0, time.UTC),
+	}
+
+	pages := 0
+	err := traces.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := traces.ExtractTraces(page)
+		if err != nil {
+			return false, err
+		}
+		th.CheckDeepEquals(t, []traces.Trace{UpdateLoadBalancerTrace}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestListInvalidTimeRange(t *testing.T) {
+	listOpts := traces.ListOpts{
+		From: time.Date(2020, 12, 9, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2020, 12, 8, 0, 0, 0, 0, time.UTC),
+	}
+
+	err := traces.List(client.ServiceClient(), listOpts).EachPage(func(pagination.Page) (bool, error) {
+		return true, nil
+	})
+	if _, ok := err.(golangsdk.ErrInvalidInput); !ok {
+		t.Fatalf("expected ErrInvalidInput, got %v", err)
+	}
+}
@@ -0,0 +1,9 @@
+package traces
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "trace"
+
+func rootURL(c *golangsdk.ServiceClient, trackerName string) string {
+	return c.ServiceURL(trackerName, resourcePath)
+}
@@ -20,9 +20,10 @@ type ListOpts struct {
 // the returned collection for greater efficiency.
 func List(client *golangsdk.ServiceClient, opts ListOpts) ([]Tracker, error) {
 	var r ListResult
-	_, r.Err = client.Get(rootURL(client), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(rootURL(client), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	allTracker, err := r.ExtractTracker()
 	if err != nil {
@@ -125,9 +126,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -167,17 +169,19 @@ func Update(client *golangsdk.ServiceClient, opts UpdateOptsBuilder) (r UpdateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular tracker.
 func Delete(client *golangsdk.ServiceClient) (r DeleteResult) {
-	_, r.Err = client.Delete(rootURL(client), &golangsdk.RequestOpts{
+	resp, err := client.Delete(rootURL(client), &golangsdk.RequestOpts{
 		OkCodes:  []int{204},
 		JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
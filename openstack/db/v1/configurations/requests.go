@@ -53,13 +53,15 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(baseURL(client), &b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get will retrieve the details for a specified configuration group.
 func Get(client *golangsdk.ServiceClient, configID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, configID), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, configID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -97,7 +99,8 @@ func Update(client *golangsdk.ServiceClient, configID string, opts UpdateOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(resourceURL(client, configID), &b, nil, nil)
+	resp, err := client.Patch(resourceURL(client, configID), &b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -110,7 +113,8 @@ func Replace(client *golangsdk.ServiceClient, configID string, opts UpdateOptsBu
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, configID), &b, nil, nil)
+	resp, err := client.Put(resourceURL(client, configID), &b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -118,7 +122,8 @@ func Replace(client *golangsdk.ServiceClient, configID string, opts UpdateOptsBu
 // config groups cannot be deleted whilst still attached to running instances -
 // you must detach and then delete them.
 func Delete(client *golangsdk.ServiceClient, configID string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, configID), nil)
+	resp, err := client.Delete(resourceURL(client, configID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -147,7 +152,8 @@ func ListDatastoreParams(client *golangsdk.ServiceClient, datastoreID, versionID
 // need the param's ID first, which can be attained by using the ListDatastoreParams
 // operation.
 func GetDatastoreParam(client *golangsdk.ServiceClient, datastoreID, versionID, paramID string) (r ParamResult) {
-	_, r.Err = client.Get(getDSParamURL(client, datastoreID, versionID, paramID), &r.Body, nil)
+	resp, err := client.Get(getDSParamURL(client, datastoreID, versionID, paramID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -162,6 +168,7 @@ func ListGlobalParams(client *golangsdk.ServiceClient, versionID string) paginat
 // GetGlobalParam is similar to GetDatastoreParam but does not require a
 // DatastoreID.
 func GetGlobalParam(client *golangsdk.ServiceClient, versionID, paramID string) (r ParamResult) {
-	_, r.Err = client.Get(getGlobalParamURL(client, versionID, paramID), &r.Body, nil)
+	resp, err := client.Get(getGlobalParamURL(client, versionID, paramID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
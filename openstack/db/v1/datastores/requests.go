@@ -14,7 +14,8 @@ func List(client *golangsdk.ServiceClient) pagination.Pager {
 
 // Get will retrieve the details of a specified datastore type.
 func Get(client *golangsdk.ServiceClient, datastoreID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, datastoreID), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, datastoreID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -28,6 +29,7 @@ func ListVersions(client *golangsdk.ServiceClient, datastoreID string) paginatio
 
 // GetVersion will retrieve the details of a specified datastore version.
 func GetVersion(client *golangsdk.ServiceClient, datastoreID, versionID string) (r GetVersionResult) {
-	_, r.Err = client.Get(versionURL(client, datastoreID, versionID), &r.Body, nil)
+	resp, err := client.Get(versionURL(client, datastoreID, versionID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -16,6 +16,7 @@ func List(client *golangsdk.ServiceClient) pagination.Pager {
 
 // Get will retrieve information for a specified hardware flavor.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
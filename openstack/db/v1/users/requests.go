@@ -71,7 +71,8 @@ func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(baseURL(client, instanceID), &b, nil, nil)
+	resp, err := client.Post(baseURL(client, instanceID), &b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -86,6 +87,7 @@ func List(client *golangsdk.ServiceClient, instanceID string) pagination.Pager {
 
 // Delete will permanently delete a user from a specified database instance.
 func Delete(client *golangsdk.ServiceClient, instanceID, userName string) (r DeleteResult) {
-	_, r.Err = client.Delete(userURL(client, instanceID, userName), nil)
+	resp, err := client.Delete(userURL(client, instanceID, userName), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
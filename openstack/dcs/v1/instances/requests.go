@@ -161,15 +161,17 @@ func Create(client *golangsdk.ServiceClient, ops CreateOpsBuilder) (r CreateResu
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete an instance by id
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -220,15 +222,17 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, id), body, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id), body, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get a instance with detailed information by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -268,9 +272,10 @@ func UpdatePassword(client *golangsdk.ServiceClient, id string, opts UpdatePassw
 		return
 	}
 
-	_, r.Err = client.Put(passwordURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(passwordURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -305,9 +310,10 @@ func Extend(client *golangsdk.ServiceClient, id string, opts ExtendOptsBuilder)
 		return
 	}
 
-	_, r.Err = client.Post(extendURL(client, id), body, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(extendURL(client, id), body, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -6,6 +6,7 @@ import (
 
 // Get maintain windows
 func Get(client *golangsdk.ServiceClient) (r GetResult) {
-	_, r.Err = client.Get(getURL(client), &r.Body, nil)
+	resp, err := client.Get(getURL(client), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -38,6 +38,7 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult)
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
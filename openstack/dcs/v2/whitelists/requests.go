@@ -39,14 +39,16 @@ func Put(client *golangsdk.ServiceClient, id string, ops WhitelistOptsBuilder) (
 		return
 	}
 
-	_, r.Err = client.Put(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get the instance whitelist groups by instance id
 func Get(client *golangsdk.ServiceClient, id string) (r WhitelistResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -63,9 +63,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateInstanceBuilder) (r Crea
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -89,7 +90,8 @@ func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteInstanc
 
 	url := deleteURL(client, instanceId)
 
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{JSONResponse: &r.Body, MoreHeaders: map[string]string{"Content-Type": "application/json"}})
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{JSONResponse: &r.Body, MoreHeaders: map[string]string{"Content-Type": "application/json"}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -182,9 +184,10 @@ func Update(client *golangsdk.ServiceClient, instanceId string, opts []UpdateOpt
 			httpMethod = client.Put
 		}
 
-		_, r.Err = httpMethod(url, body, &r.Body, &golangsdk.RequestOpts{
+		resp, err := httpMethod(url, body, &r.Body, &golangsdk.RequestOpts{
 			OkCodes: []int{200, 202},
 		})
+		r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 		if r.Err != nil {
 			break
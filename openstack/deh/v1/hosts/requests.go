@@ -35,7 +35,8 @@ func Allocate(c *golangsdk.ServiceClient, opts AllocateOptsBuilder) (r AllocateR
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200, 201}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -64,13 +65,15 @@ func Update(c *golangsdk.ServiceClient, hostID string, opts UpdateOptsBuilder) (
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204}}
-	_, r.Err = c.Put(resourceURL(c, hostID), b, nil, reqOpt)
+	resp, err := c.Put(resourceURL(c, hostID), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Deletes the DeH using the specified hostID.
 func Delete(c *golangsdk.ServiceClient, hostid string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, hostid), nil)
+	resp, err := c.Delete(resourceURL(c, hostid), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -133,7 +136,8 @@ func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 
 // Get retrieves a particular host based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
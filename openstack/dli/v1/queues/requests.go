@@ -111,7 +111,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(createURL(c), requstbody, &r.Body, reqOpt)
+	resp, err := c.Post(createURL(c), requstbody, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -127,7 +128,8 @@ This API is used to delete a queue.
 */
 func Delete(c *golangsdk.ServiceClient, queueName string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Delete(resourceURL(c, queueName), reqOpt)
+	resp, err := c.Delete(resourceURL(c, queueName), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -155,7 +157,8 @@ func List(c *golangsdk.ServiceClient, listOpts ListOptsBuilder) (r GetResult) {
 	}
 
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Get(queryAllURL(c), &listResult, reqOpt)
+	resp, err := c.Get(queryAllURL(c), &listResult, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	r.Body = listResult
 	return r
 }
@@ -174,7 +177,8 @@ func Get(c *golangsdk.ServiceClient, queueName string) (r GetResult) {
 	result := new(Queue4Get)
 
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Get(resourceURL(c, queueName), &result, reqOpt)
+	resp, err := c.Get(resourceURL(c, queueName), &result, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	if result != nil {
 		r.Body = result
@@ -190,6 +194,7 @@ func ScaleOrRestart(c *golangsdk.ServiceClient, opts ActionOpts) (r PutResult) {
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(ActionURL(c, opts.QueueName), requstbody, &r.Body, reqOpt)
+	resp, err := c.Put(ActionURL(c, opts.QueueName), requstbody, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -38,16 +38,18 @@ func Create(client *golangsdk.ServiceClient, queueID string, ops CreateOpsBuilde
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client, queueID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, queueID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Delete a group by id
 func Delete(client *golangsdk.ServiceClient, queueID string, groupID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, queueID, groupID), nil)
+	resp, err := client.Delete(deleteURL(client, queueID, groupID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -37,9 +37,10 @@ func Create(client *golangsdk.ServiceClient, instanceID string, ops CreateOpsBui
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -77,21 +78,24 @@ func Update(client *golangsdk.ServiceClient, instanceID string, opts UpdateOptsB
 		return
 	}
 
-	_, r.Err = client.Put(rootURL(client, instanceID), body, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(rootURL(client, instanceID), body, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get an topic with detailed information by instance id and topic name
 func Get(client *golangsdk.ServiceClient, instanceID, topicName string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, instanceID, topicName), &r.Body, nil)
+	resp, err := client.Get(getURL(client, instanceID, topicName), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // List all topics belong to the instance id
 func List(client *golangsdk.ServiceClient, instanceID string) (r ListResult) {
-	_, r.Err = client.Get(rootURL(client, instanceID), &r.Body, nil)
+	resp, err := client.Get(rootURL(client, instanceID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -107,9 +111,10 @@ func Delete(client *golangsdk.ServiceClient, instanceID string, topics []string)
 		return
 	}
 
-	_, r.Err = client.Post(deleteURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(deleteURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -0,0 +1,56 @@
+/*
+Package ptrrecords enables management of the ptr records, the reverse DNS
+records, of the floating IPs.
+
+Example to Set the PTR Record of a Floating IP
+
+	createOpts := ptrrecords.CreateOpts{
+		PtrName: "www.example.com.",
+		TTL:     3600,
+	}
+
+	ptr, err := ptrrecords.Create(dnsClient, "region_id", "floatingip_id", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List PTR Records
+
+	allPages, err := ptrrecords.List(dnsClient, ptrrecords.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPtrs, err := ptrrecords.ExtractPtrs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, ptr := range allPtrs {
+		fmt.Printf("%s: %s\n", ptr.Address, ptr.PtrName)
+	}
+
+Example to Set the PTR Records of the VIP of a Load Balancer
+
+	lb, err := loadbalancers.Get(lbClient, "loadbalancer_id").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := ptrrecords.CreateOpts{
+		PtrName: "mail.example.com.",
+	}
+
+	ptrs, err := ptrrecords.SetForPort(dnsClient, networkClient, "region_id", lb.VipPortID, createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete the PTR Record of a Floating IP
+
+	err := ptrrecords.Delete(dnsClient, "region_id:floatingip_id").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ptrrecords
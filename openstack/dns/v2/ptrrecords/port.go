@@ -0,0 +1,51 @@
+package ptrrecords
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/layer3/floatingips"
+)
+
+// SetForPort sets the ptr record of each floating IP associated with a port,
+// e.g. the VIP port of a load balancer (loadbalancers.LoadBalancer.VipPortID)
+// or a port of a server. networkClient is the networking v2 client used to
+// find the floating IPs, and region the region they belong to.
+func SetForPort(client, networkClient *golangsdk.ServiceClient, region, portID string, opts CreateOptsBuilder) ([]Ptr, error) {
+	fips, err := portFloatingIPs(networkClient, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	ptrs := make([]Ptr, 0, len(fips))
+	for _, fip := range fips {
+		ptr, err := Create(client, region, fip.ID, opts).Extract()
+		if err != nil {
+			return ptrs, err
+		}
+		ptrs = append(ptrs, *ptr)
+	}
+	return ptrs, nil
+}
+
+// DeleteForPort removes the ptr records of the floating IPs associated with a
+// port.
+func DeleteForPort(client, networkClient *golangsdk.ServiceClient, region, portID string) error {
+	fips, err := portFloatingIPs(networkClient, portID)
+	if err != nil {
+		return err
+	}
+
+	for _, fip := range fips {
+		if err := Delete(client, region+":"+fip.ID).ExtractErr(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func portFloatingIPs(networkClient *golangsdk.ServiceClient, portID string) ([]floatingips.FloatingIP, error) {
+	allPages, err := floatingips.List(networkClient, floatingips.ListOpts{PortID: portID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return floatingips.ExtractFloatingIPs(allPages)
+}
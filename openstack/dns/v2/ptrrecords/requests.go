@@ -2,11 +2,55 @@ package ptrrecords
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
+// ListOptsBuilder allows extensions to add parameters to the List request.
+type ListOptsBuilder interface {
+	ToPtrListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the ptr records set on the floating IPs.
+// Marker and Limit are used for pagination.
+type ListOpts struct {
+	// Integer value for the limit of values to return.
+	Limit int `q:"limit"`
+
+	// ID of the ptr at which you want to set a marker.
+	Marker string `q:"marker"`
+
+	// Status of the ptr records to return.
+	Status string `q:"status"`
+
+	// Enterprise project id
+	EnterpriseProjectID string `q:"enterprise_project_id"`
+}
+
+// ToPtrListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPtrListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List implements a ptr List request.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToPtrListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return PtrPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 // Get returns information about a ptr, given its ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -63,9 +107,10 @@ func Create(client *golangsdk.ServiceClient, region string, fip_id string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(baseURL(client, region, fip_id), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Patch(baseURL(client, region, fip_id), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -74,8 +119,9 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	b := map[string]string{
 		"ptrname": "null",
 	}
-	_, r.Err = client.Patch(resourceURL(client, id), &b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Patch(resourceURL(client, id), &b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -2,6 +2,7 @@ package ptrrecords
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 type commonResult struct {
@@ -34,6 +35,26 @@ type DeleteResult struct {
 	golangsdk.ErrResult
 }
 
+// PtrPage is a single page of Ptr results.
+type PtrPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if the page contains no results.
+func (r PtrPage) IsEmpty() (bool, error) {
+	s, err := ExtractPtrs(r)
+	return len(s) == 0, err
+}
+
+// ExtractPtrs extracts a slice of Ptrs from a List result.
+func ExtractPtrs(r pagination.Page) ([]Ptr, error) {
+	var s struct {
+		Ptrs []Ptr `json:"floatingips"`
+	}
+	err := (r.(PtrPage)).ExtractInto(&s)
+	return s.Ptrs, err
+}
+
 // Ptr represents a ptr record.
 type Ptr struct {
 	// ID uniquely identifies this ptr amongst all other ptr records.
@@ -9,3 +9,7 @@ func baseURL(c *golangsdk.ServiceClient, region string, floatingip_id string) st
 func resourceURL(c *golangsdk.ServiceClient, id string) string {
 	return c.ServiceURL("reverse/floatingips", id)
 }
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("reverse/floatingips")
+}
@@ -57,7 +57,8 @@ func ListByZone(client *golangsdk.ServiceClient, zoneID string, opts ListOptsBui
 
 // Get implements the recordset Get request.
 func Get(client *golangsdk.ServiceClient, zoneID string, rrsetID string) (r GetResult) {
-	_, r.Err = client.Get(rrsetURL(client, zoneID, rrsetID), &r.Body, nil)
+	resp, err := client.Get(rrsetURL(client, zoneID, rrsetID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -103,9 +104,10 @@ func Create(client *golangsdk.ServiceClient, zoneID string, opts CreateOptsBuild
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(baseURL(client, zoneID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(baseURL(client, zoneID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -149,16 +151,18 @@ func Update(client *golangsdk.ServiceClient, zoneID string, rrsetID string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(rrsetURL(client, zoneID, rrsetID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(rrsetURL(client, zoneID, rrsetID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete removes an existing RecordSet.
 func Delete(client *golangsdk.ServiceClient, zoneID string, rrsetID string) (r DeleteResult) {
-	_, r.Err = client.Delete(rrsetURL(client, zoneID, rrsetID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(rrsetURL(client, zoneID, rrsetID), &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
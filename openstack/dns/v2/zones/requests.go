@@ -55,7 +55,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 // Get returns information about a zone, given its ID.
 func Get(client *golangsdk.ServiceClient, zoneID string) (r GetResult) {
-	_, r.Err = client.Get(zoneURL(client, zoneID), &r.Body, nil)
+	resp, err := client.Get(zoneURL(client, zoneID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -113,9 +114,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(baseURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -161,18 +163,20 @@ func Update(client *golangsdk.ServiceClient, zoneID string, opts UpdateOptsBuild
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(zoneURL(client, zoneID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Patch(zoneURL(client, zoneID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete implements a zone delete request.
 func Delete(client *golangsdk.ServiceClient, zoneID string) (r DeleteResult) {
-	_, r.Err = client.Delete(zoneURL(client, zoneID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(zoneURL(client, zoneID), &golangsdk.RequestOpts{
 		OkCodes:      []int{202},
 		JSONResponse: &r.Body,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -202,7 +206,8 @@ func AssociateZone(client *golangsdk.ServiceClient, zoneID string, opts RouterOp
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(associateURL(client, zoneID), b, nil, nil)
+	resp, err := client.Post(associateURL(client, zoneID), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -213,6 +218,7 @@ func DisassociateZone(client *golangsdk.ServiceClient, zoneID string, opts Route
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(disassociateURL(client, zoneID), b, nil, nil)
+	resp, err := client.Post(disassociateURL(client, zoneID), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
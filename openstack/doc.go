@@ -10,5 +10,18 @@ Example of Creating a Service Client
 	client, err := openstack.NewNetworkV2(client, golangsdk.EndpointOpts{
 		Region: os.Getenv("OS_REGION_NAME"),
 	})
+
+Example of Listing Load Balancers Across Regions
+
+	regions := []string{"eu-de", "eu-nl"}
+	multi, err := openstack.NewMultiRegionClient(provider, regions, golangsdk.EndpointOpts{}, openstack.NewNetworkV2)
+
+	items, err := multi.List(func(client *golangsdk.ServiceClient) pagination.Pager {
+		return loadbalancers.List(client, nil)
+	}, loadbalancers.ExtractLoadBalancers)
+
+	for _, item := range items {
+		fmt.Printf("%s: %+v\n", item.Region, item.Item.(loadbalancers.LoadBalancer))
+	}
 */
 package openstack
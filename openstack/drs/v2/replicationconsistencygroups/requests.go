@@ -47,22 +47,25 @@ func Create(client *golangsdk.ServiceClient, ops CreateOpsBuilder) (r CreateResu
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Delete a replication consistency group by id
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get a replication consistency group with detailed information by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -148,9 +151,10 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id), body, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -176,65 +180,70 @@ const (
 
 // FailOver is performing a failover for a replication consistency group.
 func FailOver(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(
+	resp, err := client.Post(
 		actionURL(client, id),
 		map[string]interface{}{OsFailoverReplicationConsistencyGroup: nil},
 		nil,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Sync is synchronizing a replication consistency group.
 func Sync(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(
+	resp, err := client.Post(
 		actionURL(client, id),
 		map[string]interface{}{OsSyncReplicationConsistencyGroup: nil},
 		nil,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Reverse is performing a primary/secondary switchover for a replication consistency group.
 func Reverse(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(
+	resp, err := client.Post(
 		actionURL(client, id),
 		map[string]interface{}{OsReverseReplicationConsistencyGroup: nil},
 		nil,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Stop is pausing a replication consistency group.
 func Stop(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(
+	resp, err := client.Post(
 		actionURL(client, id),
 		map[string]interface{}{OsStopReplicationConsistencyGroup: nil},
 		nil,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Reprotect is reprotecting a replication consistency group.
 func Reprotect(client *golangsdk.ServiceClient, id string) (r ActionResult) {
-	_, r.Err = client.Post(
+	resp, err := client.Post(
 		actionURL(client, id),
 		map[string]interface{}{OsReprotectReplicationConsistencyGroup: nil},
 		nil,
 		&golangsdk.RequestOpts{
 			OkCodes: []int{200},
 		})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -276,9 +285,10 @@ func Extend(client *golangsdk.ServiceClient, id string, ops ExtendReplicationVol
 		return
 	}
 
-	_, r.Err = client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -41,12 +41,14 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	}
 	log.Printf("[DEBUG] create url:%q, body=%#v", createURL(c), b)
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(createURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -57,6 +59,7 @@ func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 			"keep_last_manual_snapshot": 0,
 		},
 	}
-	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	resp, err := c.Delete(resourceURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -31,6 +31,7 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) error
 }
 
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
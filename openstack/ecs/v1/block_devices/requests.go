@@ -5,6 +5,7 @@ import (
 )
 
 func Get(c *golangsdk.ServiceClient, server_id string, volume_id string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, server_id, volume_id), &r.Body, nil)
+	resp, err := c.Get(getURL(c, server_id, volume_id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
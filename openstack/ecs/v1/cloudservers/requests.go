@@ -187,7 +187,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r JobResul
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -199,15 +200,17 @@ func CreatePrePaid(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r O
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(createURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular Server based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(getURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 203},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -234,7 +237,8 @@ func Delete(client *golangsdk.ServiceClient, opts DeleteOpts) (r JobResult) {
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(deleteURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(deleteURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -256,7 +260,8 @@ func DeleteOrder(client *golangsdk.ServiceClient, opts DeleteOrderOpts) (r Delet
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(deleteOrderURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(deleteOrderURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -351,6 +356,7 @@ func Resize(client *golangsdk.ServiceClient, opts ResizeOptsBuilder, serverId st
 		return
 	}
 
-	_, r.Err = client.Post(resizeURL(client, serverId), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(resizeURL(client, serverId), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
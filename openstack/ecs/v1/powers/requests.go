@@ -34,6 +34,7 @@ func PowerAction(client *golangsdk.ServiceClient, opts PowerOptsBuilder, option
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Post(actionURL(client), reqBody, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -48,14 +48,16 @@ func BatchAction(client *golangsdk.ServiceClient, serverID string, opts BatchOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, serverID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, serverID), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the tags of a specific instance.
 func Get(client *golangsdk.ServiceClient, serverID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, serverID), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, serverID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -70,6 +70,7 @@ func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (ListResult, error)
 		}
 		url += query
 	}
-	_, r.Err = c.Get(url, &r.Body, nil)
+	resp, err := c.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return r, err
 }
@@ -44,6 +44,20 @@ type FlavorsPage struct {
 	pagination.LinkedPageBase
 }
 
+// NextPageURL is invoked when a paginated collection of flavors has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r FlavorsPage) NextPageURL() (string, error) {
+	var s struct {
+		PageInfo pagination.PageInfo `json:"page_info"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
+}
+
 // IsEmpty checks whether a FlavorsPage struct is empty.
 func (r FlavorsPage) IsEmpty() (bool, error) {
 	is, err := ExtractFlavors(r)
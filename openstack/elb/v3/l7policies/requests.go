@@ -79,7 +79,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -136,13 +137,15 @@ func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 
 // Get retrieves a particular l7policy based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular l7policy based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -207,9 +210,10 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -253,7 +257,8 @@ func CreateRule(c *golangsdk.ServiceClient, policyID string, opts CreateRuleOpts
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(ruleRootURL(c, policyID), b, &r.Body, nil)
+	resp, err := c.Post(ruleRootURL(c, policyID), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -308,13 +313,15 @@ func ListRules(c *golangsdk.ServiceClient, policyID string, opts ListRulesOptsBu
 
 // GetRule retrieves a particular L7Policy Rule based on its unique ID.
 func GetRule(c *golangsdk.ServiceClient, policyID string, ruleID string) (r GetRuleResult) {
-	_, r.Err = c.Get(ruleResourceURL(c, policyID, ruleID), &r.Body, nil)
+	resp, err := c.Get(ruleResourceURL(c, policyID, ruleID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DeleteRule will remove a Rule from a particular L7Policy.
 func DeleteRule(c *golangsdk.ServiceClient, policyID string, ruleID string) (r DeleteRuleResult) {
-	_, r.Err = c.Delete(ruleResourceURL(c, policyID, ruleID), nil)
+	resp, err := c.Delete(ruleResourceURL(c, policyID, ruleID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -368,8 +375,9 @@ func UpdateRule(c *golangsdk.ServiceClient, policyID string, ruleID string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(ruleResourceURL(c, policyID, ruleID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(ruleResourceURL(c, policyID, ruleID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -126,13 +126,13 @@ type L7PolicyPage struct {
 // to do this, it needs to construct the next page's URL.
 func (r L7PolicyPage) NextPageURL() (string, error) {
 	var s struct {
-		Links []golangsdk.Link `json:"l7policies_links"`
+		PageInfo pagination.PageInfo `json:"page_info"`
 	}
 	err := r.ExtractInto(&s)
 	if err != nil {
 		return "", err
 	}
-	return golangsdk.ExtractNextURL(s.Links)
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
 }
 
 // IsEmpty checks whether a L7PolicyPage struct is empty.
@@ -200,13 +200,13 @@ type RulePage struct {
 // to do this, it needs to construct the next page's URL.
 func (r RulePage) NextPageURL() (string, error) {
 	var s struct {
-		Links []golangsdk.Link `json:"rules_links"`
+		PageInfo pagination.PageInfo `json:"page_info"`
 	}
 	err := r.ExtractInto(&s)
 	if err != nil {
 		return "", err
 	}
-	return golangsdk.ExtractNextURL(s.Links)
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
 }
 
 // IsEmpty checks whether a RulePage struct is empty.
@@ -93,15 +93,13 @@ type MonitorPage struct {
 // to do this, it needs to construct the next page's URL.
 func (r MonitorPage) NextPageURL() (string, error) {
 	var s struct {
-		Links []golangsdk.Link `json:"healthmonitors_links"`
+		PageInfo pagination.PageInfo `json:"page_info"`
 	}
-
 	err := r.ExtractInto(&s)
 	if err != nil {
 		return "", err
 	}
-
-	return golangsdk.ExtractNextURL(s.Links)
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
 }
 
 // IsEmpty checks whether a MonitorPage struct is empty.
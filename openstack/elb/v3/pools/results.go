@@ -99,13 +99,13 @@ type PoolPage struct {
 // to do this, it needs to construct the next page's URL.
 func (r PoolPage) NextPageURL() (string, error) {
 	var s struct {
-		Links []golangsdk.Link `json:"pools_links"`
+		PageInfo pagination.PageInfo `json:"page_info"`
 	}
 	err := r.ExtractInto(&s)
 	if err != nil {
 		return "", err
 	}
-	return golangsdk.ExtractNextURL(s.Links)
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
 }
 
 // IsEmpty checks whether a PoolPage struct is empty.
@@ -207,13 +207,13 @@ type MemberPage struct {
 // to do this, it needs to construct the next page's URL.
 func (r MemberPage) NextPageURL() (string, error) {
 	var s struct {
-		Links []golangsdk.Link `json:"members_links"`
+		PageInfo pagination.PageInfo `json:"page_info"`
 	}
 	err := r.ExtractInto(&s)
 	if err != nil {
 		return "", err
 	}
-	return golangsdk.ExtractNextURL(s.Links)
+	return pagination.NextMarkerURL(r.URL, s.PageInfo.NextMarker), nil
 }
 
 // IsEmpty checks whether a MemberPage struct is empty.
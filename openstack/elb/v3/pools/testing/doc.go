@@ -0,0 +1,2 @@
+// pools unit tests
+package testing
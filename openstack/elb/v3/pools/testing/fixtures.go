@@ -0,0 +1,65 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+// PoolsListFirstPageBody is the canned body of the first page of a pool list
+// response, whose page_info holds the marker of the next page.
+const PoolsListFirstPageBody = `
+{
+	"pools": [
+		{"id": "36ce7086-a496-4666-9064-5ba0e6840c75", "name": "web", "lb_algorithm": "ROUND_ROBIN", "protocol": "HTTP"},
+		{"id": "5a9a3e9e-d1aa-448e-af37-a70171f2a332", "name": "api", "lb_algorithm": "LEAST_CONNECTIONS", "protocol": "HTTP"}
+	],
+	"page_info": {
+		"next_marker": "5a9a3e9e-d1aa-448e-af37-a70171f2a332",
+		"current_count": 2
+	}
+}
+`
+
+// PoolsListLastPageBody is the canned body of the last page of a pool list
+// response, whose page_info holds no next marker.
+const PoolsListLastPageBody = `
+{
+	"pools": [
+		{"id": "bb8a2c46-2a5b-4bfb-bf6b-bdaa2a5d9f2b", "name": "db", "lb_algorithm": "SOURCE_IP", "protocol": "TCP"}
+	],
+	"page_info": {
+		"previous_marker": "bb8a2c46-2a5b-4bfb-bf6b-bdaa2a5d9f2b",
+		"current_count": 1
+	}
+}
+`
+
+// HandlePoolListSuccessfully sets up the test server to respond to a pool List
+// request returning two pages. It returns the markers of the requests
+// received, in order.
+func HandlePoolListSuccessfully(t *testing.T) *[]string {
+	var markers []string
+	th.Mux.HandleFunc("/elb/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		th.AssertEquals(t, "2", r.Form.Get("limit"))
+		marker := r.Form.Get("marker")
+		markers = append(markers, marker)
+		switch marker {
+		case "":
+			fmt.Fprintf(w, PoolsListFirstPageBody)
+		case "5a9a3e9e-d1aa-448e-af37-a70171f2a332":
+			fmt.Fprintf(w, PoolsListLastPageBody)
+		default:
+			t.Fatalf("/elb/pools invoked with unexpected marker=[%s]", marker)
+		}
+	})
+	return &markers
+}
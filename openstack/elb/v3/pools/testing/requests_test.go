@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/elb/v3/pools"
+	"github.com/huaweicloud/golangsdk/pagination"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestListPools(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	markers := HandlePoolListSuccessfully(t)
+
+	var names []string
+	pages := 0
+	err := pools.List(client.ServiceClient(), pools.ListOpts{Limit: 2}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+		actual, err := pools.ExtractPools(page)
+		if err != nil {
+			return false, err
+		}
+		for _, pool := range actual {
+			names = append(names, pool.Name)
+		}
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, pages)
+	th.CheckDeepEquals(t, []string{"web", "api", "db"}, names)
+	th.CheckDeepEquals(t, []string{"", "5a9a3e9e-d1aa-448e-af37-a70171f2a332"}, *markers)
+}
@@ -7,6 +7,7 @@ import (
 // GetJobDetails retrieves the Job with the provided jobID. To extract the Job object
 // from the response, call the ExtractJob method on the GetResult.
 func GetJobDetails(client *golangsdk.ServiceClient, jobID string) (r GetResult) {
-	_, r.Err = client.Get(jobURL(client, jobID), &r.Body, nil)
+	resp, err := client.Get(jobURL(client, jobID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
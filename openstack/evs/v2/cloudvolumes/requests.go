@@ -77,7 +77,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r JobResul
 	baseURL := newClient.ResourceBaseURL()
 	newClient.ResourceBase = strings.Replace(baseURL, "/v2/", "/v2.1/", 1)
 
-	_, r.Err = newClient.Post(createURL(&newClient), b, &r.Body, nil)
+	resp, err := newClient.Post(createURL(&newClient), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -123,9 +124,10 @@ func ExtendSize(client *golangsdk.ServiceClient, id string, opts ExtendOptsBuild
 	baseURL := newClient.ResourceBaseURL()
 	newClient.ResourceBase = strings.Replace(baseURL, "/v2/", "/v2.1/", 1)
 
-	_, r.Err = newClient.Post(actionURL(&newClient, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := newClient.Post(actionURL(&newClient, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -156,9 +158,10 @@ func Update(client *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -193,16 +196,18 @@ func Delete(client *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder)
 		}
 		url += q
 	}
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the Volume with the provided ID. To extract the Volume object
 // from the response, call the Extract method on the GetResult.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -35,13 +35,15 @@ func Create(client *golangsdk.ServiceClient, resource_type, resource_id string,
 		r.Err = err
 		return r
 	}
-	_, r.Err = client.Put(createURL(client, resource_type, resource_id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.Put(createURL(client, resource_type, resource_id), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get implements tags get request
 func Get(client *golangsdk.ServiceClient, resource_type, resource_id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, resource_type, resource_id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, resource_type, resource_id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -49,7 +49,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createURL(c), f, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Post(createURL(c), f, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -85,19 +86,22 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 //Querying the Metadata Information of a Function
 func GetMetadata(c *golangsdk.ServiceClient, functionUrn string) (r GetResult) {
-	_, r.Err = c.Get(getMetadataURL(c, functionUrn), &r.Body, nil)
+	resp, err := c.Get(getMetadataURL(c, functionUrn), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Querying the Code of a Function
 func GetCode(c *golangsdk.ServiceClient, functionUrn string) (r GetResult) {
-	_, r.Err = c.Get(getCodeURL(c, functionUrn), &r.Body, nil)
+	resp, err := c.Get(getCodeURL(c, functionUrn), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Deleting a Function or Function Version
 func Delete(c *golangsdk.ServiceClient, functionUrn string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, functionUrn), nil)
+	resp, err := c.Delete(deleteURL(c, functionUrn), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -125,7 +129,8 @@ func UpdateCode(c *golangsdk.ServiceClient, functionUrn string, opts UpdateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateCodeURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Put(updateCodeURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -158,7 +163,8 @@ func UpdateMetadata(c *golangsdk.ServiceClient, functionUrn string, opts UpdateO
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateMetadataURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Put(updateMetadataURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -180,7 +186,8 @@ func CreateVersion(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionU
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createVersionURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200, 201}})
+	resp, err := c.Post(createVersionURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200, 201}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -216,7 +223,8 @@ func CreateAlias(c *golangsdk.ServiceClient, opts CreateOptsBuilder, functionUrn
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createAliasURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Post(createAliasURL(c, functionUrn), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -237,19 +245,22 @@ func UpdateAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string, opts
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateAliasURL(c, functionUrn, aliasName), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Put(updateAliasURL(c, functionUrn, aliasName), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Deleting an Alias of a Function Version
 func DeleteAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteAliasURL(c, functionUrn, aliasName), &golangsdk.RequestOpts{OkCodes: []int{204}})
+	resp, err := c.Delete(deleteAliasURL(c, functionUrn, aliasName), &golangsdk.RequestOpts{OkCodes: []int{204}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Querying the Alias Information of a Function Version
 func GetAlias(c *golangsdk.ServiceClient, functionUrn, aliasName string) (r GetResult) {
-	_, r.Err = c.Get(getAliasURL(c, functionUrn, aliasName), &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Get(getAliasURL(c, functionUrn, aliasName), &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -277,6 +288,7 @@ func Invoke(c *golangsdk.ServiceClient, m map[string]interface{}, functionUrn st
 
 //Executing a Function Asynchronously
 func AsyncInvoke(c *golangsdk.ServiceClient, m map[string]interface{}, functionUrn string) (r CreateResult) {
-	_, r.Err = c.Post(asyncInvokeURL(c, functionUrn), m, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{202}})
+	resp, err := c.Post(asyncInvokeURL(c, functionUrn), m, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{202}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -46,7 +46,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder, urn string) (r C
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createURL(c, urn), b, &r.Body, nil)
+	resp, err := c.Post(createURL(c, urn), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -60,9 +61,10 @@ func List(c *golangsdk.ServiceClient, urn string) pagination.Pager {
 
 // Get is a method to obtain a trigger through function urn, function code and trigger ID.
 func Get(c *golangsdk.ServiceClient, urn, triggerType, triggerId string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, urn, triggerType, triggerId), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, urn, triggerType, triggerId), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -88,22 +90,25 @@ func Update(c *golangsdk.ServiceClient, opts UpdateOptsBuilder, urn, triggerType
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, urn, triggerType, triggerId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, urn, triggerType, triggerId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete is a method to delete existing trigger.
 func Delete(c *golangsdk.ServiceClient, urn, triggerType, triggerId string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, urn, triggerType, triggerId), &golangsdk.RequestOpts{
+	resp, err := c.Delete(deleteURL(c, urn, triggerType, triggerId), &golangsdk.RequestOpts{
 		OkCodes: []int{204, 200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // DeleteAll is a method to delete all triggers from a function
 func DeleteAll(c *golangsdk.ServiceClient, urn string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteAllURL(c, urn), nil)
+	resp, err := c.Delete(deleteAllURL(c, urn), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
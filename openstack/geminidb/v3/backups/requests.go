@@ -38,6 +38,7 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Put(resourceURL(c, id), b, nil, reqOpt)
+	resp, err := c.Put(resourceURL(c, id), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
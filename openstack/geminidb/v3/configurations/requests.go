@@ -27,25 +27,28 @@ func Apply(client *golangsdk.ServiceClient, id string, opts ApplyBuilder) (r App
 		return
 	}
 
-	_, r.Err = client.Put(applyURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(applyURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func GetInstanceConfig(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(instanceConfigURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(instanceConfigURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -63,10 +63,11 @@ func Create(client *golangsdk.ServiceClient, opts CreateGeminiDBBuilder) (r Crea
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -94,10 +95,11 @@ func ExtendVolume(client *golangsdk.ServiceClient, instanceId string, opts Exten
 		return
 	}
 
-	_, r.Err = client.Post(extendURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(extendURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -125,10 +127,11 @@ func EnlargeNode(client *golangsdk.ServiceClient, instanceId string, opts Enlarg
 		return
 	}
 
-	_, r.Err = client.Post(enlargeNodeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(enlargeNodeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -155,20 +158,22 @@ func ReduceNode(client *golangsdk.ServiceClient, instanceId string, opts ReduceN
 		return
 	}
 
-	_, r.Err = client.Post(reduceNodeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(reduceNodeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteResult) {
 	url := deleteURL(client, instanceId)
 
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -287,10 +292,11 @@ func UpdateName(client *golangsdk.ServiceClient, instanceId string, opts UpdateN
 		return
 	}
 
-	_, r.Err = client.Put(updateNameURL(client, instanceId), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateNameURL(client, instanceId), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{204},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -317,10 +323,11 @@ func UpdatePass(client *golangsdk.ServiceClient, instanceId string, opts UpdateP
 		return
 	}
 
-	_, r.Err = client.Put(updatePassURL(client, instanceId), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(updatePassURL(client, instanceId), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{204},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -353,10 +360,11 @@ func Resize(client *golangsdk.ServiceClient, instanceId string, opts ResizeBuild
 		return
 	}
 
-	_, r.Err = client.Put(resizeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resizeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -383,10 +391,11 @@ func UpdateSg(client *golangsdk.ServiceClient, instanceId string, opts UpdateSgB
 		return
 	}
 
-	_, r.Err = client.Put(updateSgURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateSgURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -9,6 +9,13 @@ type PasswordCredentialsV2 struct {
 	Password string `json:"password" required:"true"`
 }
 
+// APIKeyCredentialsV2 represents the required options to authenticate
+// with a username and an API key.
+type APIKeyCredentialsV2 struct {
+	Username string `json:"username" required:"true"`
+	APIKey   string `json:"apiKey" required:"true"`
+}
+
 // TokenCredentialsV2 represents the required options to authenticate
 // with a token.
 type TokenCredentialsV2 struct {
@@ -46,6 +53,7 @@ type AuthOptions struct {
 	IdentityEndpoint string `json:"-"`
 	Username         string `json:"username,omitempty"`
 	Password         string `json:"password,omitempty"`
+	APIKey           string `json:"-"`
 	TenantID         string `json:"tenantId,omitempty"`
 	TenantName       string `json:"tenantName,omitempty"`
 	AllowReauth      bool   `json:"-"`
@@ -54,6 +62,25 @@ type AuthOptions struct {
 
 // ToTokenV2CreateMap builds a token request body from the given AuthOptions.
 func (opts AuthOptions) ToTokenV2CreateMap() (map[string]interface{}, error) {
+	if opts.APIKey != "" {
+		if opts.Password != "" {
+			return nil, golangsdk.ErrAPIKeyWithPassword{}
+		}
+		apiKeyOpts := struct {
+			APIKeyCredentials *APIKeyCredentialsV2 `json:"RAX-KSKEY:apiKeyCredentials" required:"true"`
+			TenantID          string               `json:"tenantId,omitempty"`
+			TenantName        string               `json:"tenantName,omitempty"`
+		}{
+			APIKeyCredentials: &APIKeyCredentialsV2{
+				Username: opts.Username,
+				APIKey:   opts.APIKey,
+			},
+			TenantID:   opts.TenantID,
+			TenantName: opts.TenantName,
+		}
+		return golangsdk.BuildRequestBody(apiKeyOpts, "auth")
+	}
+
 	v2Opts := AuthOptionsV2{
 		TenantID:   opts.TenantID,
 		TenantName: opts.TenantName,
@@ -87,17 +114,19 @@ func Create(client *golangsdk.ServiceClient, auth AuthOptionsBuilder) (r CreateR
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(CreateURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(CreateURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 203},
 		MoreHeaders: map[string]string{"X-Auth-Token": ""},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get validates and retrieves information for user's token.
 func Get(client *golangsdk.ServiceClient, token string) (r GetResult) {
-	_, r.Err = client.Get(GetURL(client, token), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(GetURL(client, token), &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 203},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
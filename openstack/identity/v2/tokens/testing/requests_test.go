@@ -84,6 +84,34 @@ func TestCreateTokenWithTenantName(t *testing.T) {
   `))
 }
 
+func TestCreateWithAPIKey(t *testing.T) {
+	options := golangsdk.AuthOptions{
+		Username: "me",
+		APIKey:   "1234567890abcdef",
+	}
+
+	IsSuccessful(t, tokenPost(t, options, `
+    {
+      "auth": {
+        "RAX-KSKEY:apiKeyCredentials": {
+          "username": "me",
+          "apiKey": "1234567890abcdef"
+        }
+      }
+    }
+  `))
+}
+
+func TestAPIKeyWithPassword(t *testing.T) {
+	options := golangsdk.AuthOptions{
+		Username: "me",
+		Password: "swordfish",
+		APIKey:   "1234567890abcdef",
+	}
+
+	tokenPostErr(t, options, golangsdk.ErrAPIKeyWithPassword{})
+}
+
 func TestRequireUsername(t *testing.T) {
 	options := golangsdk.AuthOptions{
 		Password: "thing",
@@ -59,9 +59,10 @@ func ConsoleACLPolicyUpdate(client *golangsdk.ServiceClient, opts ConsoleACLPoli
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(consoleACLPolicyURL(client, domainID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(consoleACLPolicyURL(client, domainID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -72,20 +73,23 @@ func APIACLPolicyUpdate(client *golangsdk.ServiceClient, opts APIACLPolicyBuilde
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(apiACLPolicyURL(client, domainID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(apiACLPolicyURL(client, domainID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // ConsoleACLPolicyGet retrieves details on iam identity acl for console access, by domain ID.
 func ConsoleACLPolicyGet(client *golangsdk.ServiceClient, domainID string) (r ACLResult) {
-	_, r.Err = client.Get(consoleACLPolicyURL(client, domainID), &r.Body, nil)
+	resp, err := client.Get(consoleACLPolicyURL(client, domainID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // APIACLPolicyGet retrieves details on iam identity acl for api access, by domain ID.
 func APIACLPolicyGet(client *golangsdk.ServiceClient, domainID string) (r ACLResult) {
-	_, r.Err = client.Get(apiACLPolicyURL(client, domainID), &r.Body, nil)
+	resp, err := client.Get(apiACLPolicyURL(client, domainID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -56,7 +56,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), &b, &r.Body, nil)
+	resp, err := client.Post(rootURL(client), &b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -79,18 +80,21 @@ func Update(client *golangsdk.ServiceClient, credentialID string, opts UpdateOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, credentialID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, credentialID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, credentialID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, credentialID), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, credentialID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, credentialID string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, credentialID), nil)
+	resp, err := client.Delete(resourceURL(client, credentialID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
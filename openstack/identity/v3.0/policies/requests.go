@@ -7,10 +7,11 @@ import (
 
 // Get retrieves details on a single policy, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -69,10 +70,11 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -83,18 +85,20 @@ func Update(client *golangsdk.ServiceClient, roleID string, opts CreateOptsBuild
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(resourceURL(client, roleID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Patch(resourceURL(client, roleID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete deletes a policy.
 func Delete(client *golangsdk.ServiceClient, roleID string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, roleID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(resourceURL(client, roleID), &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -61,7 +61,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), &b, &r.Body, nil)
+	resp, err := client.Post(createURL(client), &b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -118,14 +119,16 @@ func Update(client *golangsdk.ServiceClient, userID string, opts UpdateOptsBuild
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(updateURL(client, userID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, userID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves details on a single user, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -42,7 +42,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(listURL(client), &b, &r.Body, nil)
+	resp, err := client.Post(listURL(client), &b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -128,12 +129,14 @@ func Update(client *golangsdk.ServiceClient, endpointID string, opts UpdateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(endpointURL(client, endpointID), &b, &r.Body, nil)
+	resp, err := client.Patch(endpointURL(client, endpointID), &b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete removes an endpoint from the service catalog.
 func Delete(client *golangsdk.ServiceClient, endpointID string) (r DeleteResult) {
-	_, r.Err = client.Delete(endpointURL(client, endpointID), nil)
+	resp, err := client.Delete(endpointURL(client, endpointID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -22,5 +22,44 @@ Example to Create a Token with Username, Password, and Trust ID
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Trust
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	createOpts := trusts.CreateOpts{
+		ExpiresAt:     &expiresAt,
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles:         []trusts.Role{{Name: "member"}},
+		TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}
+
+	trust, err := trusts.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Trusts
+
+	listOpts := trusts.ListOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}
+
+	allPages, err := trusts.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTrusts, err := trusts.ExtractTrusts(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Trust
+
+	err := trusts.Delete(identityClient, "3422b7c113894f5d90665e1a79655e23").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package trusts
@@ -1,6 +1,12 @@
 package trusts
 
-import "github.com/huaweicloud/golangsdk/openstack/identity/v3/tokens"
+import (
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/identity/v3/tokens"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
 
 // AuthOptsExt extends the base Identity v3 tokens AuthOpts with a TrustID.
 type AuthOptsExt struct {
@@ -37,3 +43,128 @@ func (opts AuthOptsExt) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 func (opts AuthOptsExt) CanReauth() bool {
 	return opts.AuthOptionsBuilder.CanReauth()
 }
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToTrustCreateMap() (map[string]interface{}, error)
+}
+
+// Role identifies a role delegated by a trust, either by ID or by name.
+type Role struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// CreateOpts provides options used to create a new trust.
+type CreateOpts struct {
+	// TrusteeUserID is the ID of the user who is capable of consuming the trust.
+	TrusteeUserID string `json:"trustee_user_id" required:"true"`
+
+	// TrustorUserID is the ID of the user who created the trust.
+	TrustorUserID string `json:"trustor_user_id" required:"true"`
+
+	// Impersonation allows the trustee to impersonate the trustor.
+	Impersonation bool `json:"impersonation"`
+
+	// ProjectID is the ID of the project the trust is scoped to.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// Roles are the roles delegated to the trustee. Required when ProjectID
+	// is set.
+	Roles []Role `json:"roles,omitempty"`
+
+	// AllowRedelegation allows the trustee to redelegate the trust.
+	AllowRedelegation bool `json:"allow_redelegation,omitempty"`
+
+	// RedelegationCount is the maximum depth of the redelegation chain.
+	RedelegationCount int `json:"redelegation_count,omitempty"`
+
+	// RemainingUses is the number of times the trust can be used to obtain a
+	// token. The trust can be used an unlimited number of times when unset.
+	RemainingUses int `json:"remaining_uses,omitempty"`
+
+	// ExpiresAt is the expiration time of the trust. The trust never expires
+	// when unset.
+	ExpiresAt *time.Time `json:"-"`
+}
+
+// ToTrustCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToTrustCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		trust := b["trust"].(map[string]interface{})
+		trust["expires_at"] = opts.ExpiresAt.UTC().Format(golangsdk.RFC3339Milli)
+	}
+
+	return b, nil
+}
+
+// Create creates a new trust.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrustCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves details on a single trust.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a trust.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request.
+type ListOptsBuilder interface {
+	ToTrustListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// TrustorUserID filters the trusts created by a user.
+	TrustorUserID string `q:"trustor_user_id"`
+
+	// TrusteeUserID filters the trusts delegated to a user.
+	TrusteeUserID string `q:"trustee_user_id"`
+}
+
+// ToTrustListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTrustListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the trusts visible to the current user.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToTrustListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TrustPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
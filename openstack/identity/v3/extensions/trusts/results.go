@@ -1,5 +1,13 @@
 package trusts
 
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
 // TrusteeUser represents the trusted user ID of a trust.
 type TrusteeUser struct {
 	ID string `json:"id"`
@@ -19,9 +27,101 @@ type Trust struct {
 	TrustorUser        TrustorUser `json:"trustor_user"`
 	RedelegatedTrustID string      `json:"redelegated_trust_id"`
 	RedelegationCount  int         `json:"redelegation_count"`
+	TrusteeUserID      string      `json:"trustee_user_id"`
+	TrustorUserID      string      `json:"trustor_user_id"`
+	ProjectID          string      `json:"project_id"`
+	Roles              []Role      `json:"roles"`
+	RemainingUses      int         `json:"remaining_uses"`
+	ExpiresAt          time.Time   `json:"-"`
+}
+
+// UnmarshalJSON converts the expiration time, which may be null, into a
+// time.Time.
+func (r *Trust) UnmarshalJSON(b []byte) error {
+	type tmp Trust
+	var s struct {
+		tmp
+		ExpiresAt *golangsdk.JSONRFC3339Milli `json:"expires_at"`
+	}
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = Trust(s.tmp)
+
+	if s.ExpiresAt != nil {
+		r.ExpiresAt = time.Time(*s.ExpiresAt)
+	}
+	return nil
 }
 
 // TokenExt represents an extension of the base token result.
 type TokenExt struct {
 	Trust Trust `json:"OS-TRUST:trust"`
 }
+
+type trustResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any trust result as a Trust.
+func (r trustResult) Extract() (*Trust, error) {
+	var s struct {
+		Trust *Trust `json:"trust"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trust, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a Trust.
+type CreateResult struct {
+	trustResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Trust.
+type GetResult struct {
+	trustResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// TrustPage is a single page of Trust results.
+type TrustPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Trusts contains any results.
+func (r TrustPage) IsEmpty() (bool, error) {
+	trusts, err := ExtractTrusts(r)
+	return len(trusts) == 0, err
+}
+
+// NextPageURL extracts the "next" link from the links section of the result.
+func (r TrustPage) NextPageURL() (string, error) {
+	var s struct {
+		Links struct {
+			Next     string `json:"next"`
+			Previous string `json:"previous"`
+		} `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return s.Links.Next, err
+}
+
+// ExtractTrusts returns a slice of Trusts contained in a single page of
+// results.
+func ExtractTrusts(r pagination.Page) ([]Trust, error) {
+	var s struct {
+		Trusts []Trust `json:"trusts"`
+	}
+	err := (r.(TrustPage)).ExtractInto(&s)
+	return s.Trusts, err
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/huaweicloud/golangsdk/openstack/identity/v3/tokens"
 	"github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
 )
 
 // HandleCreateTokenWithTrustID verifies that providing certain AuthOptions and Scope results in an expected JSON structure.
@@ -65,3 +66,102 @@ func HandleCreateTokenWithTrustID(t *testing.T, options tokens.AuthOptionsBuilde
 }`)
 	})
 }
+
+// CreateTrustRequest is the expected request body of a trust Create request.
+const CreateTrustRequest = `
+{
+    "trust": {
+        "expires_at": "2019-12-01T14:00:00.999999Z",
+        "impersonation": false,
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "roles": [
+            {
+                "name": "member"
+            }
+        ],
+        "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+        "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+    }
+}
+`
+
+// TrustResponse is the canned body of a trust Create or Get request.
+const TrustResponse = `
+{
+    "trust": {
+        "expires_at": "2019-12-01T14:00:00.999999Z",
+        "id": "3422b7c113894f5d90665e1a79655e23",
+        "impersonation": false,
+        "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+        "remaining_uses": null,
+        "roles": [
+            {
+                "id": "b627fca5-a8f0-4d40-85b2-3c2fab8d45ff",
+                "name": "member"
+            }
+        ],
+        "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+        "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+    }
+}
+`
+
+// ListTrustsResponse is the canned body of a trust List request.
+const ListTrustsResponse = `
+{
+    "links": {
+        "next": null,
+        "previous": null
+    },
+    "trusts": [
+        {
+            "expires_at": null,
+            "id": "3422b7c113894f5d90665e1a79655e23",
+            "impersonation": true,
+            "project_id": "9b71012f5a4a4aef9193f1995fe159b2",
+            "trustee_user_id": "ecb37e88cc86431c99d0332208cb6fbf",
+            "trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3"
+        }
+    ]
+}
+`
+
+// HandleCreateTrust creates an HTTP handler at `/OS-TRUST/trusts` on the
+// test handler mux that tests trust creation.
+func HandleCreateTrust(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "POST")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestJSONRequest(t, r, CreateTrustRequest)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, TrustResponse)
+	})
+}
+
+// HandleListTrusts creates an HTTP handler at `/OS-TRUST/trusts` on the
+// test handler mux that tests listing trusts.
+func HandleListTrusts(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestFormValues(t, r, map[string]string{
+			"trustor_user_id": "959ed913a32c4ec88c041c98e61cbbc3",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, ListTrustsResponse)
+	})
+}
+
+// HandleDeleteTrust creates an HTTP handler at `/OS-TRUST/trusts/{id}` on
+// the test handler mux that tests trust deletion.
+func HandleDeleteTrust(t *testing.T) {
+	testhelper.Mux.HandleFunc("/OS-TRUST/trusts/3422b7c113894f5d90665e1a79655e23", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "DELETE")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
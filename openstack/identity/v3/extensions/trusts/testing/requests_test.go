@@ -72,3 +72,58 @@ func TestCreateUserIDPasswordTrustID(t *testing.T) {
 
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestCreateTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateTrust(t)
+
+	expiresAt := time.Date(2019, 12, 1, 14, 0, 0, 999999999, time.UTC)
+	actual, err := trusts.Create(client.ServiceClient(), trusts.CreateOpts{
+		ExpiresAt:     &expiresAt,
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		Roles:         []trusts.Role{{Name: "member"}},
+		TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &trusts.Trust{
+		ID:            "3422b7c113894f5d90665e1a79655e23",
+		ProjectID:     "9b71012f5a4a4aef9193f1995fe159b2",
+		TrusteeUserID: "ecb37e88cc86431c99d0332208cb6fbf",
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+		Roles: []trusts.Role{
+			{ID: "b627fca5-a8f0-4d40-85b2-3c2fab8d45ff", Name: "member"},
+		},
+		ExpiresAt: time.Date(2019, 12, 1, 14, 0, 0, 999999000, time.UTC),
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestListTrusts(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListTrusts(t)
+
+	allPages, err := trusts.List(client.ServiceClient(), trusts.ListOpts{
+		TrustorUserID: "959ed913a32c4ec88c041c98e61cbbc3",
+	}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := trusts.ExtractTrusts(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "3422b7c113894f5d90665e1a79655e23", actual[0].ID)
+	th.AssertEquals(t, true, actual[0].Impersonation)
+	th.AssertEquals(t, true, actual[0].ExpiresAt.IsZero())
+}
+
+func TestDeleteTrust(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteTrust(t)
+
+	err := trusts.Delete(client.ServiceClient(), "3422b7c113894f5d90665e1a79655e23").ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package trusts
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "OS-TRUST/trusts"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
@@ -43,7 +43,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 // Get retrieves details on a single group, by ID.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -92,7 +93,8 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{})
+	resp, err := client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -142,14 +144,16 @@ func Update(client *golangsdk.ServiceClient, groupID string, opts UpdateOptsBuil
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(updateURL(client, groupID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Patch(updateURL(client, groupID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete deletes a group.
 func Delete(client *golangsdk.ServiceClient, groupID string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, groupID), nil)
+	resp, err := client.Delete(deleteURL(client, groupID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
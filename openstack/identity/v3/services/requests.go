@@ -48,9 +48,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -92,7 +93,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Page
 
 // Get returns additional information about a service, given its ID.
 func Get(client *golangsdk.ServiceClient, serviceID string) (r GetResult) {
-	_, r.Err = client.Get(serviceURL(client, serviceID), &r.Body, nil)
+	resp, err := client.Get(serviceURL(client, serviceID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -139,9 +141,10 @@ func Update(client *golangsdk.ServiceClient, serviceID string, opts UpdateOptsBu
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Patch(updateURL(client, serviceID), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Patch(updateURL(client, serviceID), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -149,6 +152,7 @@ func Update(client *golangsdk.ServiceClient, serviceID string, opts UpdateOptsBu
 // It either deletes all associated endpoints, or fails until all endpoints
 // are deleted.
 func Delete(client *golangsdk.ServiceClient, serviceID string) (r DeleteResult) {
-	_, r.Err = client.Delete(serviceURL(client, serviceID), nil)
+	resp, err := client.Delete(serviceURL(client, serviceID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
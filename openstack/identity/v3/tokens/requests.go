@@ -216,8 +216,9 @@ func Validate(c *golangsdk.ServiceClient, token string) (bool, error) {
 
 // Revoke immediately makes specified token invalid.
 func Revoke(c *golangsdk.ServiceClient, token string) (r RevokeResult) {
-	_, r.Err = c.Delete(tokenURL(c), &golangsdk.RequestOpts{
+	resp, err := c.Delete(tokenURL(c), &golangsdk.RequestOpts{
 		MoreHeaders: subjectTokenHeaders(c, token),
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -8,9 +8,10 @@ import (
 
 func Get(client *golangsdk.ServiceClient, bandwidthId string) (r GetResult) {
 	url := GetURL(client, bandwidthId)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -43,8 +44,9 @@ func Update(client *golangsdk.ServiceClient, bandwidthId string, opts UpdateOpts
 		return
 	}
 
-	_, r.Err = client.Put(UpdateURL(client, bandwidthId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(UpdateURL(client, bandwidthId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -8,17 +8,19 @@ import (
 
 func Get(client *golangsdk.ServiceClient, CloudVolumeID string) (r GetResult) {
 	url := GetURL(client, CloudVolumeID)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func ListVolumeType(client *golangsdk.ServiceClient) (r GetResult) {
 	url := ListVolumeTypeURL(client)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -54,8 +56,9 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult)
 		listURL += query
 	}
 
-	_, r.Err = client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -52,34 +52,38 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 	url := CreateURL(client)
-	_, r.Err = client.Post(url, b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(url, b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Deploy(client *golangsdk.ServiceClient, deploymentID string) (r DeployResult) {
 
 	url := DeployURL(client, deploymentID)
-	_, r.Err = client.Post(url, "", &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(url, "", &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Expand(client *golangsdk.ServiceClient, deploymentID string) (r DeployResult) {
 
 	url := ExpandURL(client, deploymentID)
-	_, r.Err = client.Post(url, "", &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(url, "", &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, deploymentID string) (r DeleteResult) {
 
-	_, r.Err = client.Delete(DeleteURL(client, deploymentID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(DeleteURL(client, deploymentID), &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusNoContent},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
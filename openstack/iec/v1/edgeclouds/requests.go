@@ -8,15 +8,17 @@ import (
 
 func Get(client *golangsdk.ServiceClient, edgeCloudID string) (r GetResult) {
 	url := GetURL(client, edgeCloudID)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, edgeCloudID string) (r DeleteResult) {
-	_, r.Err = client.Delete(DeleteURL(client, edgeCloudID), &golangsdk.RequestOpts{
+	resp, err := client.Delete(DeleteURL(client, edgeCloudID), &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusNoContent},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
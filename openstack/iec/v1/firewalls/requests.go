@@ -30,23 +30,26 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, firewallID string) (r DeleteResult) {
 	url := DeleteURL(client, firewallID)
-	_, r.Err = client.Delete(url, nil)
+	resp, err := client.Delete(url, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, fwID string) (r GetResult) {
 	url := GetURL(client, fwID)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -81,7 +84,8 @@ func Update(client *golangsdk.ServiceClient, fwID string, opts UpdateOptsBuilder
 		return
 	}
 
-	_, r.Err = client.Put(UpdateURL(client, fwID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{http.StatusOK}})
+	resp, err := client.Put(UpdateURL(client, fwID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{http.StatusOK}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -129,7 +133,8 @@ func UpdateRule(client *golangsdk.ServiceClient, fwID string, opts UpdateRuleOpt
 		return
 	}
 
-	_, r.Err = client.Put(UpdateRuleURL(client, fwID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{http.StatusOK}})
+	resp, err := client.Put(UpdateRuleURL(client, fwID), b, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{http.StatusOK}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -163,8 +168,9 @@ func List(client *golangsdk.ServiceClient, opts ListFirewallsOptsBuilder) (r Lis
 		listURL += query
 	}
 
-	_, r.Err = client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
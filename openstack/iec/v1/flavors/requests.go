@@ -57,8 +57,9 @@ func List(client *golangsdk.ServiceClient, listOpts ListOptsBuilder) (r GetResul
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
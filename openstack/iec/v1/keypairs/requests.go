@@ -111,8 +111,9 @@ func List(client *golangsdk.ServiceClient, opts ListKeyPairsOptsBuilder) (r List
 		listKeyPairsURL += query
 	}
 
-	_, r.Err = client.Get(listKeyPairsURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listKeyPairsURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
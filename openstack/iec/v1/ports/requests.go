@@ -42,23 +42,26 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK, http.StatusCreated},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, portId string) (r DeleteResult) {
 	url := DeleteURL(client, portId)
-	_, r.Err = client.Delete(url, nil)
+	resp, err := client.Delete(url, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, portId string) (r GetResult) {
 	url := GetURL(client, portId)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -102,9 +105,10 @@ func Update(client *golangsdk.ServiceClient, portId string, opts UpdateOptsBuild
 		return
 	}
 
-	_, r.Err = client.Put(UpdateURL(client, portId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(UpdateURL(client, portId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -146,8 +150,9 @@ func List(client *golangsdk.ServiceClient, opts ListPortsOptsBuilder) (r ListRes
 		listPortsURL += query
 	}
 
-	_, r.Err = client.Get(listPortsURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listPortsURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -30,23 +30,26 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, securityGroupID string) (r DeleteResult) {
 	url := DeleteURL(client, securityGroupID)
-	_, r.Err = client.Delete(url, nil)
+	resp, err := client.Delete(url, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(client *golangsdk.ServiceClient, securityGroupID string) (r GetResult) {
 	url := GetURL(client, securityGroupID)
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -78,8 +81,9 @@ func List(client *golangsdk.ServiceClient, opts ListSecurityGroupsOptsBuilder) (
 		listURL += query
 	}
 
-	_, r.Err = client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -204,8 +204,9 @@ func List(client *golangsdk.ServiceClient, opts ListServersOptsBuilder) (r ListR
 		listURL += query
 	}
 
-	_, r.Err = client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{http.StatusOK},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
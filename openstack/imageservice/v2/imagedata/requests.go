@@ -9,10 +9,11 @@ import (
 
 // Upload uploads an image file.
 func Upload(client *golangsdk.ServiceClient, id string, data io.Reader) (r UploadResult) {
-	_, r.Err = client.Put(uploadURL(client, id), data, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(uploadURL(client, id), data, nil, &golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
 		OkCodes:     []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -20,10 +21,11 @@ func Upload(client *golangsdk.ServiceClient, id string, data io.Reader) (r Uploa
 // the provided file.
 // Existing image object must be in the "queued" status.
 func Stage(client *golangsdk.ServiceClient, id string, data io.Reader) (r StageResult) {
-	_, r.Err = client.Put(stageURL(client, id), data, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(stageURL(client, id), data, nil, &golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
 		OkCodes:     []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
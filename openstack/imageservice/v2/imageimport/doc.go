@@ -10,5 +10,40 @@ Example to Get an information about the Import API
 	}
 
 	fmt.Printf("%+v\n", importInfo)
+
+Example to Create a new image import
+
+	createOpts := imageimport.CreateOpts{
+		Name: imageimport.WebDownloadMethod,
+		URI:  "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img",
+	}
+	imageID := "da3b75d9-3f4a-40e7-8a2c-bfab23927dea"
+
+	err := imageimport.Create(imagesClient, imageID, createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Import staged image data
+
+	imageData, err := os.Open("/tmp/cirros-0.4.0-x86_64-disk.img")
+	if err != nil {
+		panic(err)
+	}
+	defer imageData.Close()
+
+	err = imagedata.Stage(imagesClient, imageID, imageData).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := imageimport.CreateOpts{
+		Name: imageimport.GlanceDirectMethod,
+	}
+
+	err = imageimport.Create(imagesClient, imageID, createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package imageimport
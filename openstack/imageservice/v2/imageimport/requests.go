@@ -15,6 +15,46 @@ const (
 
 // Get retrieves Import API information data.
 func Get(c *golangsdk.ServiceClient) (r GetResult) {
-	_, r.Err = c.Get(infoURL(c), &r.Body, nil)
+	resp, err := c.Get(infoURL(c), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows to add additional parameters to the Create request.
+type CreateOptsBuilder interface {
+	ToImportCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters of a new image import.
+type CreateOpts struct {
+	// Name is the import method, GlanceDirectMethod to import the data staged
+	// with imagedata.Stage, or WebDownloadMethod to download it from URI.
+	Name ImportMethod `json:"name" required:"true"`
+
+	// URI is the URL to download the image data from with WebDownloadMethod.
+	URI string `json:"uri,omitempty"`
+}
+
+// ToImportCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToImportCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"method": b}, nil
+}
+
+// Create requests the import of the data of an image. The import runs
+// asynchronously: the image becomes active once it has completed.
+func Create(client *golangsdk.ServiceClient, imageID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToImportCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(importURL(client, imageID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -30,3 +30,9 @@ func (r commonResult) Extract() (*ImportInfo, error) {
 	err := r.ExtractInto(&s)
 	return s, err
 }
+
+// CreateResult is the result of import Create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
@@ -13,3 +13,13 @@ const ImportGetResult = `
     }
 }
 `
+
+// ImportCreateRequest represents a request to create image import.
+const ImportCreateRequest = `
+{
+    "method": {
+        "name": "web-download",
+        "uri": "http://download.cirros-cloud.net/0.4.0/cirros-0.4.0-x86_64-disk.img"
+    }
+}
+`
@@ -3,6 +3,7 @@ package imageimport
 import "github.com/huaweicloud/golangsdk"
 
 const (
+	rootPath     = "images"
 	infoPath     = "info"
 	resourcePath = "import"
 )
@@ -10,3 +11,7 @@ const (
 func infoURL(c *golangsdk.ServiceClient) string {
 	return c.ServiceURL(infoPath, resourcePath)
 }
+
+func importURL(c *golangsdk.ServiceClient, imageID string) string {
+	return c.ServiceURL(rootPath, imageID, resourcePath)
+}
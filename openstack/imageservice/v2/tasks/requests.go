@@ -90,7 +90,8 @@ func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 
 // Get retrieves a specific Imageservice task based on its ID.
 func Get(c *golangsdk.ServiceClient, taskID string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, taskID), &r.Body, nil)
+	resp, err := c.Get(getURL(c, taskID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -121,8 +122,9 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
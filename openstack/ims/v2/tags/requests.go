@@ -48,14 +48,16 @@ func BatchAction(client *golangsdk.ServiceClient, imageID string, opts BatchOpts
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, imageID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, imageID), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the tags of a specific image.
 func Get(client *golangsdk.ServiceClient, imageID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, imageID), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, imageID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
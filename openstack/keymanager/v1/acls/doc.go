@@ -0,0 +1,40 @@
+/*
+Package acls manages the access control lists of secrets and containers in the
+key manager service.
+
+Example to Get a Secret's ACL
+
+	acl, err := acls.GetSecretACL(client, secretID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v\n", acl)
+
+Example to Set a Secret's ACL
+
+	users := []string{"GG27dVwR9gBMnsOaRoJ1DFJmZfdVjIdW"}
+	projectAccess := false
+	setOpts := acls.SetOpts{
+		acls.SetOpt{
+			Type:          "read",
+			Users:         &users,
+			ProjectAccess: &projectAccess,
+		},
+	}
+
+	aclRef, err := acls.SetSecretACL(client, secretID, setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(aclRef)
+
+Example to Delete a Secret's ACL
+
+	err := acls.DeleteSecretACL(client, secretID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package acls
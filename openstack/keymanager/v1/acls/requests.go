@@ -0,0 +1,102 @@
+package acls
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// GetContainerACL retrieves the ACL of a container.
+func GetContainerACL(client *golangsdk.ServiceClient, containerID string) (r ACLResult) {
+	resp, err := client.Get(aclURL(client, "containers", containerID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetSecretACL retrieves the ACL of a secret.
+func GetSecretACL(client *golangsdk.ServiceClient, secretID string) (r ACLResult) {
+	resp, err := client.Get(aclURL(client, "secrets", secretID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// SetOptsBuilder allows extensions to add additional parameters to the
+// Set request.
+type SetOptsBuilder interface {
+	ToACLSetMap() (map[string]interface{}, error)
+}
+
+// SetOpt represents options to set a particular ACL type on a resource.
+type SetOpt struct {
+	// Type is the type of ACL to set. ie: read.
+	Type string `json:"-" required:"true"`
+
+	// Users are the list of Keystone user UUIDs.
+	Users *[]string `json:"users,omitempty"`
+
+	// ProjectAccess toggles if all users in a project can access the resource.
+	ProjectAccess *bool `json:"project-access,omitempty"`
+}
+
+// SetOpts represents options to set an ACL on a resource.
+type SetOpts []SetOpt
+
+// ToACLSetMap formats a SetOpts into a set request.
+func (opts SetOpts) ToACLSetMap() (map[string]interface{}, error) {
+	b := make(map[string]interface{})
+	for _, v := range opts {
+		m, err := golangsdk.BuildRequestBody(v, v.Type)
+		if err != nil {
+			return nil, err
+		}
+		b[v.Type] = m[v.Type]
+	}
+	return b, nil
+}
+
+// SetContainerACL will set an ACL on a container, replacing the existing one.
+func SetContainerACL(client *golangsdk.ServiceClient, containerID string, opts SetOptsBuilder) (r ACLRefResult) {
+	return setACL(client, "PUT", aclURL(client, "containers", containerID), opts)
+}
+
+// SetSecretACL will set an ACL on a secret, replacing the existing one.
+func SetSecretACL(client *golangsdk.ServiceClient, secretID string, opts SetOptsBuilder) (r ACLRefResult) {
+	return setACL(client, "PUT", aclURL(client, "secrets", secretID), opts)
+}
+
+// UpdateContainerACL will update the given ACL types of a container.
+func UpdateContainerACL(client *golangsdk.ServiceClient, containerID string, opts SetOptsBuilder) (r ACLRefResult) {
+	return setACL(client, "PATCH", aclURL(client, "containers", containerID), opts)
+}
+
+// UpdateSecretACL will update the given ACL types of a secret.
+func UpdateSecretACL(client *golangsdk.ServiceClient, secretID string, opts SetOptsBuilder) (r ACLRefResult) {
+	return setACL(client, "PATCH", aclURL(client, "secrets", secretID), opts)
+}
+
+func setACL(client *golangsdk.ServiceClient, method, url string, opts SetOptsBuilder) (r ACLRefResult) {
+	b, err := opts.ToACLSetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Request(method, url, &golangsdk.RequestOpts{
+		JSONBody:     &b,
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// DeleteContainerACL will delete the ACL of a container.
+func DeleteContainerACL(client *golangsdk.ServiceClient, containerID string) (r DeleteResult) {
+	resp, err := client.Delete(aclURL(client, "containers", containerID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// DeleteSecretACL will delete the ACL of a secret.
+func DeleteSecretACL(client *golangsdk.ServiceClient, secretID string) (r DeleteResult) {
+	resp, err := client.Delete(aclURL(client, "secrets", secretID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
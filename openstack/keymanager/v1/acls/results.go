@@ -0,0 +1,80 @@
+package acls
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ACL represents an ACL on a resource, keyed by ACL type, e.g. "read".
+type ACL map[string]ACLDetails
+
+// ACLDetails represents the details of an ACL.
+type ACLDetails struct {
+	// Created is when the ACL was created.
+	Created time.Time `json:"-"`
+
+	// ProjectAccess denotes project-level access of the resource.
+	ProjectAccess bool `json:"project-access"`
+
+	// Updated is when the ACL was updated
+	Updated time.Time `json:"-"`
+
+	// Users are the UserIDs who have access to the resource.
+	Users []string `json:"users"`
+}
+
+// UnmarshalJSON converts the timestamps of an ACLDetails.
+func (r *ACLDetails) UnmarshalJSON(b []byte) error {
+	type tmp ACLDetails
+	var s struct {
+		tmp
+		Created golangsdk.JSONRFC3339NoZ `json:"created"`
+		Updated golangsdk.JSONRFC3339NoZ `json:"updated"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ACLDetails(s.tmp)
+
+	r.Created = time.Time(s.Created)
+	r.Updated = time.Time(s.Updated)
+
+	return nil
+}
+
+// ACLResult is the response from a Get operation. Call its Extract method
+// to interpret it as an ACL.
+type ACLResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets a ACLResult as an ACL.
+func (r ACLResult) Extract() (*ACL, error) {
+	var s *ACL
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// ACLRefResult is the response from a Set or Update operation. Call its
+// Extract method to interpret it as an ACL reference.
+type ACLRefResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets an ACLRefResult as the URL of the ACL.
+func (r ACLRefResult) Extract() (string, error) {
+	var s struct {
+		ACLRef string `json:"acl_ref"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ACLRef, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const secretID = "1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c"
+
+const getResponse = `
+{
+	"read": {
+		"created": "2018-06-22T17:54:24",
+		"project-access": false,
+		"updated": "2018-06-22T17:54:24",
+		"users": [
+			"GG27dVwR9gBMnsOaRoJ1DFJmZfdVjIdW"
+		]
+	}
+}`
+
+const setRequest = `
+{
+	"read": {
+		"project-access": false,
+		"users": [
+			"GG27dVwR9gBMnsOaRoJ1DFJmZfdVjIdW"
+		]
+	}
+}`
+
+const setResponse = `
+{
+	"acl_ref": "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c/acl"
+}`
+
+// HandleGetSecretACLSuccessfully creates an HTTP handler at
+// `/secrets/{id}/acl` on the test handler mux that responds with an ACL.
+func HandleGetSecretACLSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets/"+secretID+"/acl", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+}
+
+// HandleSetSecretACLSuccessfully creates an HTTP handler at
+// `/secrets/{id}/acl` on the test handler mux that tests setting an ACL.
+func HandleSetSecretACLSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets/"+secretID+"/acl", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, setRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, setResponse)
+	})
+}
@@ -0,0 +1,49 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/keymanager/v1/acls"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestGetSecretACL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSecretACLSuccessfully(t)
+
+	actual, err := acls.GetSecretACL(fake.ServiceClient(), secretID).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := acls.ACL{
+		"read": acls.ACLDetails{
+			Created:       time.Date(2018, 6, 22, 17, 54, 24, 0, time.UTC),
+			ProjectAccess: false,
+			Updated:       time.Date(2018, 6, 22, 17, 54, 24, 0, time.UTC),
+			Users:         []string{"GG27dVwR9gBMnsOaRoJ1DFJmZfdVjIdW"},
+		},
+	}
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestSetSecretACL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSetSecretACLSuccessfully(t)
+
+	users := []string{"GG27dVwR9gBMnsOaRoJ1DFJmZfdVjIdW"}
+	projectAccess := false
+	setOpts := acls.SetOpts{
+		acls.SetOpt{
+			Type:          "read",
+			Users:         &users,
+			ProjectAccess: &projectAccess,
+		},
+	}
+
+	actual, err := acls.SetSecretACL(fake.ServiceClient(), secretID, setOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c/acl", actual)
+}
@@ -0,0 +1,7 @@
+package acls
+
+import "github.com/huaweicloud/golangsdk"
+
+func aclURL(client *golangsdk.ServiceClient, resourceType, id string) string {
+	return client.ServiceURL(resourceType, id, "acl")
+}
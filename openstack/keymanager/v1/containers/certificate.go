@@ -0,0 +1,90 @@
+package containers
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/keymanager/v1/secrets"
+)
+
+// CertificateOpts holds the PEM encoded parts of a TLS certificate.
+type CertificateOpts struct {
+	// Name is the name of the container, and the prefix of the names of its
+	// secrets.
+	Name string
+
+	// Certificate is the PEM encoded certificate.
+	Certificate string
+
+	// PrivateKey is the PEM encoded private key of the certificate.
+	PrivateKey string
+
+	// Intermediates is the PEM encoded chain of intermediate certificates,
+	// if any.
+	Intermediates string
+
+	// PrivateKeyPassphrase is the passphrase of the private key, if it is
+	// encrypted.
+	PrivateKeyPassphrase string
+}
+
+// CreateCertificate stores the parts of a TLS certificate as secrets, and
+// creates a certificate container referencing them. The ContainerRef of the
+// container can then be set as the DefaultTlsContainerRef or one of the
+// SniContainerRefs of a TERMINATED_HTTPS listener, instead of passing the
+// PEM data inline. The secrets already created are deleted if a request fails.
+func CreateCertificate(client *golangsdk.ServiceClient, opts CertificateOpts) (*Container, error) {
+	parts := []struct {
+		name       string
+		payload    string
+		secretType secrets.SecretType
+	}{
+		{"certificate", opts.Certificate, secrets.CertificateSecret},
+		{"private_key", opts.PrivateKey, secrets.PrivateSecret},
+		{"intermediates", opts.Intermediates, secrets.CertificateSecret},
+		{"private_key_passphrase", opts.PrivateKeyPassphrase, secrets.PassphraseSecret},
+	}
+
+	var refs []SecretRef
+	cleanup := func() {
+		for _, ref := range refs {
+			secrets.Delete(client, secretID(ref.SecretRef))
+		}
+	}
+
+	for _, part := range parts {
+		if part.payload == "" {
+			continue
+		}
+		secret, err := secrets.Create(client, secrets.CreateOpts{
+			Name:               opts.Name + "-" + part.name,
+			Payload:            part.payload,
+			PayloadContentType: "text/plain",
+			SecretType:         part.secretType,
+		}).Extract()
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		refs = append(refs, SecretRef{Name: part.name, SecretRef: secret.SecretRef})
+	}
+
+	container, err := Create(client, CreateOpts{
+		Type:       CertificateContainer,
+		Name:       opts.Name,
+		SecretRefs: refs,
+	}).Extract()
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	return container, nil
+}
+
+// secretID returns the ID at the end of a secret reference.
+func secretID(ref string) string {
+	for i := len(ref) - 1; i >= 0; i-- {
+		if ref[i] == '/' {
+			return ref[i+1:]
+		}
+	}
+	return ref
+}
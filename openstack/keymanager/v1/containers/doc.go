@@ -0,0 +1,73 @@
+/*
+Package containers manages and retrieves containers in the key manager service.
+A container groups references to secrets, e.g. the certificate, private key
+and intermediates of a TLS certificate.
+
+Example to List Containers
+
+	allPages, err := containers.List(client, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allContainers, err := containers.ExtractContainers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, c := range allContainers {
+		fmt.Printf("%v\n", c)
+	}
+
+Example to Create a Container
+
+	createOpts := containers.CreateOpts{
+		Type: containers.GenericContainer,
+		Name: "mycontainer",
+		SecretRefs: []containers.SecretRef{
+			{
+				Name:      "mysecret",
+				SecretRef: "https://example.com:9311/v1/secrets/8a0c4ab5-f3a9-4d55-a1e4-1bb8e0b8b0bb",
+			},
+		},
+	}
+
+	container, err := containers.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Use a Certificate for SSL Termination
+
+	// The certificate is stored as secrets grouped in a certificate
+	// container, which the listener references instead of the PEM data.
+	container, err := containers.CreateCertificate(keyManagerClient, containers.CertificateOpts{
+		Name:          "www-example-com",
+		Certificate:   certPEM,
+		PrivateKey:    keyPEM,
+		Intermediates: chainPEM,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := listeners.CreateOpts{
+		Protocol:               listeners.ProtocolTerminatedHTTPS,
+		ProtocolPort:           443,
+		LoadbalancerID:         "0d11a7b1-96ef-4a63-aa3e-d2b2ad0e0d3a",
+		DefaultTlsContainerRef: container.ContainerRef,
+	}
+
+	listener, err := listeners.Create(lbClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Container
+
+	err := containers.Delete(client, containerID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package containers
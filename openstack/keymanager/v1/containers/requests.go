@@ -0,0 +1,106 @@
+package containers
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// ContainerType represents the valid types of containers.
+type ContainerType string
+
+const (
+	GenericContainer     ContainerType = "generic"
+	RSAContainer         ContainerType = "rsa"
+	CertificateContainer ContainerType = "certificate"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToContainerListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// Offset is the starting index within the total list of the containers that
+	// you would like to retrieve.
+	Offset int `q:"offset"`
+
+	// Limit is the maximum number of records to return.
+	Limit int `q:"limit"`
+
+	// Name will select all containers with a matching name.
+	Name string `q:"name"`
+}
+
+// ToContainerListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToContainerListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List retrieves a list of containers.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToContainerListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ContainerPage{pagination.LinkedPageBase{PageResult: r, LinkPath: []string{"next"}}}
+	})
+}
+
+// Get retrieves details of a container.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(getURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToContainerCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a container.
+type CreateOpts struct {
+	// Type represents the type of container.
+	Type ContainerType `json:"type" required:"true"`
+
+	// Name is the name of the container.
+	Name string `json:"name"`
+
+	// SecretRefs is a list of secret references.
+	SecretRefs []SecretRef `json:"secret_refs"`
+}
+
+// ToContainerCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToContainerCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Create creates a new container.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToContainerCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(createURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a container.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(deleteURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
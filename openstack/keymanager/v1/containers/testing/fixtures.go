@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const containerResponse = `
+{
+	"container_ref": "http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0",
+	"created": "2018-06-21T21:28:37",
+	"creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+	"name": "www-example-com",
+	"secret_refs": [
+		{
+			"name": "certificate",
+			"secret_ref": "http://barbican:9311/v1/secrets/cert-id"
+		},
+		{
+			"name": "private_key",
+			"secret_ref": "http://barbican:9311/v1/secrets/key-id"
+		}
+	],
+	"status": "ACTIVE",
+	"type": "certificate",
+	"updated": "2018-06-21T21:28:37"
+}`
+
+const createCertificateContainerRequest = `
+{
+	"name": "www-example-com",
+	"type": "certificate",
+	"secret_refs": [
+		{
+			"name": "certificate",
+			"secret_ref": "http://barbican:9311/v1/secrets/cert-id"
+		},
+		{
+			"name": "private_key",
+			"secret_ref": "http://barbican:9311/v1/secrets/key-id"
+		}
+	]
+}`
+
+// HandleCreateCertificateSuccessfully creates HTTP handlers on the test
+// handler mux that create the secrets and the container of a certificate.
+func HandleCreateCertificateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		var secret struct {
+			Name       string `json:"name"`
+			Payload    string `json:"payload"`
+			SecretType string `json:"secret_type"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&secret))
+
+		var id string
+		switch secret.Name {
+		case "www-example-com-certificate":
+			th.AssertEquals(t, "CERT", secret.Payload)
+			th.AssertEquals(t, "certificate", secret.SecretType)
+			id = "cert-id"
+		case "www-example-com-private_key":
+			th.AssertEquals(t, "KEY", secret.Payload)
+			th.AssertEquals(t, "private", secret.SecretType)
+			id = "key-id"
+		default:
+			t.Errorf("Unexpected secret %s", secret.Name)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"secret_ref": "http://barbican:9311/v1/secrets/%s"}`, id)
+	})
+
+	th.Mux.HandleFunc("/containers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createCertificateContainerRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, containerResponse)
+	})
+}
@@ -0,0 +1,38 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/keymanager/v1/containers"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreateCertificate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateCertificateSuccessfully(t)
+
+	actual, err := containers.CreateCertificate(fake.ServiceClient(), containers.CertificateOpts{
+		Name:        "www-example-com",
+		Certificate: "CERT",
+		PrivateKey:  "KEY",
+	})
+	th.AssertNoErr(t, err)
+
+	expected := containers.Container{
+		ContainerRef: "http://barbican:9311/v1/containers/dfdb88f3-4ddb-4525-9da6-066453caa9b0",
+		Created:      time.Date(2018, 6, 21, 21, 28, 37, 0, time.UTC),
+		CreatorID:    "5c70d99f4a8641c38f8084b32b5e5c0e",
+		Name:         "www-example-com",
+		SecretRefs: []containers.SecretRef{
+			{Name: "certificate", SecretRef: "http://barbican:9311/v1/secrets/cert-id"},
+			{Name: "private_key", SecretRef: "http://barbican:9311/v1/secrets/key-id"},
+		},
+		Status:  "ACTIVE",
+		Type:    "certificate",
+		Updated: time.Date(2018, 6, 21, 21, 28, 37, 0, time.UTC),
+	}
+	th.CheckDeepEquals(t, expected, *actual)
+}
@@ -0,0 +1,19 @@
+package containers
+
+import "github.com/huaweicloud/golangsdk"
+
+func listURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("containers")
+}
+
+func getURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id)
+}
+
+func createURL(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("containers")
+}
+
+func deleteURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("containers", id)
+}
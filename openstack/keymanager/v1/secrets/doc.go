@@ -0,0 +1,84 @@
+/*
+Package secrets manages and retrieves secrets in the key manager service.
+
+Example to List Secrets
+
+	listOpts := secrets.ListOpts{
+		SecretType: secrets.CertificateSecret,
+	}
+
+	allPages, err := secrets.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSecrets, err := secrets.ExtractSecrets(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, s := range allSecrets {
+		fmt.Printf("%v\n", s)
+	}
+
+Example to Create a Secret
+
+	createOpts := secrets.CreateOpts{
+		Algorithm:          "aes",
+		BitLength:          256,
+		Mode:               "cbc",
+		Name:               "mysecret",
+		Payload:            "super-secret",
+		PayloadContentType: "text/plain",
+		SecretType:         secrets.OpaqueSecret,
+	}
+
+	secret, err := secrets.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(secret.SecretRef)
+
+Example to Retrieve a Secret Payload
+
+	secretID := "8a0c4ab5-f3a9-4d55-a1e4-1bb8e0b8b0bb"
+
+	// The payload is streamed from the service, and must be closed once read.
+	payload, err := secrets.GetPayload(client, secretID, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+	defer payload.Close()
+
+	data, err := ioutil.ReadAll(payload)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add a Payload to a Secret
+
+	payload, err := os.Open("/path/to/key.bin")
+	if err != nil {
+		panic(err)
+	}
+	defer payload.Close()
+
+	updateOpts := secrets.UpdateOpts{
+		ContentType: "application/octet-stream",
+		Payload:     payload,
+	}
+
+	err = secrets.Update(client, secretID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Secret
+
+	err := secrets.Delete(client, secretID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package secrets
@@ -0,0 +1,232 @@
+package secrets
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// SecretType represents a valid secret type.
+type SecretType string
+
+const (
+	SymmetricSecret   SecretType = "symmetric"
+	PublicSecret      SecretType = "public"
+	PrivateSecret     SecretType = "private"
+	PassphraseSecret  SecretType = "passphrase"
+	CertificateSecret SecretType = "certificate"
+	OpaqueSecret      SecretType = "opaque"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToSecretListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// Offset is the starting index within the total list of the secrets that
+	// you would like to retrieve.
+	Offset int `q:"offset"`
+
+	// Limit is the maximum number of records to return.
+	Limit int `q:"limit"`
+
+	// Name will select all secrets with a matching name.
+	Name string `q:"name"`
+
+	// Alg will select all secrets with a matching algorithm.
+	Alg string `q:"alg"`
+
+	// Mode will select all secrets with a matching mode.
+	Mode string `q:"mode"`
+
+	// Bits will select all secrets with a matching bit length.
+	Bits int `q:"bits"`
+
+	// SecretType will select all secrets with a matching secret type.
+	SecretType SecretType `q:"secret_type"`
+}
+
+// ToSecretListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSecretListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List retrieves a list of Secrets.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := url(client)
+	if opts != nil {
+		query, err := opts.ToSecretListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SecretPage{pagination.LinkedPageBase{PageResult: r, LinkPath: []string{"next"}}}
+	})
+}
+
+// Get retrieves details of a secret.
+func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetPayloadOpts represents options used for obtaining a payload.
+type GetPayloadOpts struct {
+	PayloadContentType string `h:"Accept"`
+}
+
+// GetPayloadOptsBuilder allows extensions to add additional parameters to
+// the GetPayload request.
+type GetPayloadOptsBuilder interface {
+	ToSecretPayloadGetParams() (map[string]string, error)
+}
+
+// ToSecretPayloadGetParams formats a GetPayloadOpts into a query string.
+func (opts GetPayloadOpts) ToSecretPayloadGetParams() (map[string]string, error) {
+	return golangsdk.BuildHeaders(opts)
+}
+
+// GetPayload retrieves the payload of a secret. The payload is streamed:
+// the caller must close the reader returned by Extract.
+func GetPayload(client *golangsdk.ServiceClient, id string, opts GetPayloadOptsBuilder) (r PayloadResult) {
+	h := map[string]string{"Accept": "text/plain"}
+
+	if opts != nil {
+		headers, err := opts.ToSecretPayloadGetParams()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		for k, v := range headers {
+			h[k] = v
+		}
+	}
+
+	var resp *http.Response
+	resp, r.Err = client.Get(payloadURL(client, id), nil, &golangsdk.RequestOpts{
+		MoreHeaders:      h,
+		OkCodes:          []int{200},
+		KeepResponseBody: true,
+	})
+	if resp != nil {
+		r.Body = resp.Body
+		r.Header = resp.Header
+	}
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToSecretCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a secret.
+type CreateOpts struct {
+	// Algorithm is the algorithm of the secret.
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// BitLength is the bit length of the secret.
+	BitLength int `json:"bit_length,omitempty"`
+
+	// Mode is the mode of encryption for the secret.
+	Mode string `json:"mode,omitempty"`
+
+	// Name is the name of the secret
+	Name string `json:"name,omitempty"`
+
+	// Payload is the secret. The payload can be set later with Update if it
+	// is omitted.
+	Payload string `json:"payload,omitempty"`
+
+	// PayloadContentType is the content type of the payload, e.g.
+	// "text/plain" or "application/octet-stream".
+	PayloadContentType string `json:"payload_content_type,omitempty"`
+
+	// PayloadContentEncoding is the content encoding of the payload, e.g.
+	// "base64" for a binary payload.
+	PayloadContentEncoding string `json:"payload_content_encoding,omitempty"`
+
+	// SecretType is the type of secret.
+	SecretType SecretType `json:"secret_type,omitempty"`
+
+	// Expiration is the expiration date of the secret.
+	Expiration *time.Time `json:"-"`
+}
+
+// ToSecretCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToSecretCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Expiration != nil {
+		b["expiration"] = opts.Expiration.UTC().Format(golangsdk.RFC3339NoZ)
+	}
+
+	return b, nil
+}
+
+// Create creates a new secret.
+func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSecretCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(url(client), &b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes a secret.
+func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOpts represents parameters to add a payload to an existing secret
+// which does not already contain a payload.
+type UpdateOpts struct {
+	// ContentType represents the content type of the payload.
+	ContentType string
+
+	// ContentEncoding represents the content encoding of the payload.
+	ContentEncoding string
+
+	// Payload is the payload of the secret. It is streamed to the service.
+	Payload io.Reader
+}
+
+// Update sets the payload of a secret created without one.
+func Update(client *golangsdk.ServiceClient, id string, opts UpdateOpts) (r UpdateResult) {
+	h := map[string]string{"Content-Type": "text/plain"}
+	if opts.ContentType != "" {
+		h["Content-Type"] = opts.ContentType
+	}
+	if opts.ContentEncoding != "" {
+		h["Content-Encoding"] = opts.ContentEncoding
+	}
+
+	resp, err := client.Request("PUT", resourceURL(client, id), &golangsdk.RequestOpts{
+		RawBody:     opts.Payload,
+		MoreHeaders: h,
+		OkCodes:     []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
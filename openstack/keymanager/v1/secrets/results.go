@@ -0,0 +1,143 @@
+package secrets
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Secret represents a secret stored in the key manager service.
+type Secret struct {
+	// BitLength is the bit length of the secret.
+	BitLength int `json:"bit_length"`
+
+	// Algorithm is the algorithm type of the secret.
+	Algorithm string `json:"algorithm"`
+
+	// Expiration is the expiration date of the secret.
+	Expiration time.Time `json:"-"`
+
+	// ContentTypes are the content types of the secret.
+	ContentTypes map[string]string `json:"content_types"`
+
+	// Created is the created date of the secret.
+	Created time.Time `json:"-"`
+
+	// CreatorID is the creator of the secret.
+	CreatorID string `json:"creator_id"`
+
+	// Mode is the mode of the secret.
+	Mode string `json:"mode"`
+
+	// Name is the name of the secret.
+	Name string `json:"name"`
+
+	// SecretRef is the URL to the secret, used to reference it, e.g. in a
+	// container.
+	SecretRef string `json:"secret_ref"`
+
+	// SecretType represents the type of secret.
+	SecretType string `json:"secret_type"`
+
+	// Status represents the status of the secret.
+	Status string `json:"status"`
+
+	// Updated is the updated date of the secret.
+	Updated time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts the timestamps of a Secret.
+func (r *Secret) UnmarshalJSON(b []byte) error {
+	type tmp Secret
+	var s struct {
+		tmp
+		Created    golangsdk.JSONRFC3339NoZ `json:"created"`
+		Updated    golangsdk.JSONRFC3339NoZ `json:"updated"`
+		Expiration golangsdk.JSONRFC3339NoZ `json:"expiration"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Secret(s.tmp)
+
+	r.Created = time.Time(s.Created)
+	r.Updated = time.Time(s.Updated)
+	r.Expiration = time.Time(s.Expiration)
+
+	return nil
+}
+
+type commonResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets any commonResult as a Secret.
+func (r commonResult) Extract() (*Secret, error) {
+	var s *Secret
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a secret.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a secret.
+type CreateResult struct {
+	commonResult
+}
+
+// UpdateResult is the response from an Update operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// PayloadResult is the response from a GetPayload operation. Call its
+// Extract method to read the payload.
+type PayloadResult struct {
+	golangsdk.Result
+	Body io.ReadCloser
+}
+
+// Extract returns the payload of the secret. The caller must close it.
+func (r PayloadResult) Extract() (io.ReadCloser, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return r.Body, nil
+}
+
+// SecretPage is a single page of secrets results.
+type SecretPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of secrets contains any results.
+func (r SecretPage) IsEmpty() (bool, error) {
+	secrets, err := ExtractSecrets(r)
+	return len(secrets) == 0, err
+}
+
+// ExtractSecrets returns a slice of Secrets contained in a single page of
+// results.
+func ExtractSecrets(r pagination.Page) ([]Secret, error) {
+	var s struct {
+		Secrets []Secret `json:"secrets"`
+	}
+	err := (r.(SecretPage)).ExtractInto(&s)
+	return s.Secrets, err
+}
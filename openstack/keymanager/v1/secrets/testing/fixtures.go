@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/keymanager/v1/secrets"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const secretID = "1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c"
+
+const getResponse = `
+{
+	"algorithm": "aes",
+	"bit_length": 256,
+	"content_types": {
+		"default": "text/plain"
+	},
+	"created": "2018-06-21T02:49:48",
+	"creator_id": "5c70d99f4a8641c38f8084b32b5e5c0e",
+	"expiration": null,
+	"mode": "cbc",
+	"name": "mysecret",
+	"secret_ref": "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+	"secret_type": "opaque",
+	"status": "ACTIVE",
+	"updated": "2018-06-21T02:49:48"
+}`
+
+const createRequest = `
+{
+	"expiration": "2028-06-21T02:49:48",
+	"name": "mysecret",
+	"payload": "foobar",
+	"payload_content_type": "text/plain",
+	"secret_type": "opaque"
+}`
+
+const createResponse = `
+{
+	"secret_ref": "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c"
+}`
+
+// ExpectedSecret is the secret of getResponse.
+var ExpectedSecret = secrets.Secret{
+	Algorithm: "aes",
+	BitLength: 256,
+	ContentTypes: map[string]string{
+		"default": "text/plain",
+	},
+	Created:    time.Date(2018, 6, 21, 2, 49, 48, 0, time.UTC),
+	CreatorID:  "5c70d99f4a8641c38f8084b32b5e5c0e",
+	Mode:       "cbc",
+	Name:       "mysecret",
+	SecretRef:  "http://barbican:9311/v1/secrets/1b8068c4-3bb6-4be6-8f1e-da0d1ea0b67c",
+	SecretType: "opaque",
+	Status:     "ACTIVE",
+	Updated:    time.Date(2018, 6, 21, 2, 49, 48, 0, time.UTC),
+}
+
+// HandleGetSecretSuccessfully creates an HTTP handler at `/secrets/{id}` on
+// the test handler mux that responds with a single secret.
+func HandleGetSecretSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets/"+secretID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getResponse)
+	})
+}
+
+// HandleGetPayloadSuccessfully creates an HTTP handler at
+// `/secrets/{id}/payload` on the test handler mux that responds with the
+// payload of a secret.
+func HandleGetPayloadSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets/"+secretID+"/payload", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "text/plain")
+
+		w.Header().Add("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "foobar")
+	})
+}
+
+// HandleCreateSecretSuccessfully creates an HTTP handler at `/secrets` on the
+// test handler mux that tests secret creation.
+func HandleCreateSecretSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/secrets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, createResponse)
+	})
+}
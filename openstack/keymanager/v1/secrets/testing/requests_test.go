@@ -0,0 +1,54 @@
+package testing
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/keymanager/v1/secrets"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestGetSecret(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSecretSuccessfully(t)
+
+	actual, err := secrets.Get(fake.ServiceClient(), secretID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedSecret, *actual)
+}
+
+func TestGetPayload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetPayloadSuccessfully(t)
+
+	payload, err := secrets.GetPayload(fake.ServiceClient(), secretID, nil).Extract()
+	th.AssertNoErr(t, err)
+	defer payload.Close()
+
+	data, err := ioutil.ReadAll(payload)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "foobar", string(data))
+}
+
+func TestCreateSecret(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSecretSuccessfully(t)
+
+	expiration := time.Date(2028, 6, 21, 2, 49, 48, 0, time.UTC)
+	createOpts := secrets.CreateOpts{
+		Expiration:         &expiration,
+		Name:               "mysecret",
+		Payload:            "foobar",
+		PayloadContentType: "text/plain",
+		SecretType:         secrets.OpaqueSecret,
+	}
+
+	actual, err := secrets.Create(fake.ServiceClient(), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ExpectedSecret.SecretRef, actual.SecretRef)
+}
@@ -0,0 +1,15 @@
+package secrets
+
+import "github.com/huaweicloud/golangsdk"
+
+func url(client *golangsdk.ServiceClient) string {
+	return client.ServiceURL("secrets")
+}
+
+func resourceURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("secrets", id)
+}
+
+func payloadURL(client *golangsdk.ServiceClient, id string) string {
+	return client.ServiceURL("secrets", id, "payload")
+}
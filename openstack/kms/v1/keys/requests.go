@@ -144,9 +144,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -154,9 +155,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 // from the response, call the Extract method on the GetResult.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 	b := map[string]interface{}{"key_id": id}
-	_, r.Err = client.Post(getURL(client), &b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(getURL(client), &b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -167,10 +169,11 @@ func Delete(client *golangsdk.ServiceClient, opts DeleteOptsBuilder) (r DeleteRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(deleteURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(deleteURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:      []int{200},
 		JSONResponse: &r.Body,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -180,9 +183,10 @@ func UpdateAlias(client *golangsdk.ServiceClient, opts UpdateAliasOptsBuilder) (
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(updateAliasURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateAliasURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -192,9 +196,10 @@ func UpdateDes(client *golangsdk.ServiceClient, opts UpdateDesOptsBuilder) (r Up
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(updateDesURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateDesURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -204,9 +209,10 @@ func DataEncryptGet(client *golangsdk.ServiceClient, opts DataEncryptOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(dataEncryptURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(dataEncryptURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -216,9 +222,10 @@ func DataEncryptGetWithoutPlaintext(client *golangsdk.ServiceClient, opts DataEn
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(dataEncryptURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(dataEncryptURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -228,25 +235,28 @@ func EncryptDEKGet(client *golangsdk.ServiceClient, opts EncryptDEKOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(encryptDEKURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(encryptDEKURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func EnableKey(client *golangsdk.ServiceClient, id string) (r ExtractUpdateKeyStateResult) {
 	b := map[string]interface{}{"key_id": id}
-	_, r.Err = client.Post(enableKeyURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(enableKeyURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func DisableKey(client *golangsdk.ServiceClient, id string) (r ExtractUpdateKeyStateResult) {
 	b := map[string]interface{}{"key_id": id}
-	_, r.Err = client.Post(disableKeyURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(disableKeyURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -256,9 +266,10 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -268,9 +279,10 @@ func ListAllKeys(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListR
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -29,9 +29,10 @@ func Create(client *golangsdk.ServiceClient, ops CreateOptsBuilder) (r CreateRes
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -62,9 +63,10 @@ func Update(client *golangsdk.ServiceClient, ops UpdateOptsBuilder, id string) (
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -74,7 +76,8 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	opts := golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
 	}
-	_, r.Err = client.Delete(deleteURL(client, id), &opts)
+	resp, err := client.Delete(deleteURL(client, id), &opts)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -83,6 +86,7 @@ func List(client *golangsdk.ServiceClient) (r ListResults) {
 	opts := golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
 	}
-	_, r.Err = client.Get(listURL(client), &r.Body, &opts)
+	resp, err := client.Get(listURL(client), &r.Body, &opts)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -26,9 +26,10 @@ func Create(client *golangsdk.ServiceClient, groupId string, ops CreateOptsBuild
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client, groupId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, groupId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -38,7 +39,8 @@ func Delete(client *golangsdk.ServiceClient, groupId string, id string) (r Delet
 	opts := golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
 	}
-	_, r.Err = client.Delete(deleteURL(client, groupId, id), &opts)
+	resp, err := client.Delete(deleteURL(client, groupId, id), &opts)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -47,6 +49,7 @@ func List(client *golangsdk.ServiceClient, groupId string) (r ListResults) {
 	opts := golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/json;charset=utf8"},
 	}
-	_, r.Err = client.Get(listURL(client, groupId), &r.Body, &opts)
+	resp, err := client.Get(listURL(client, groupId), &r.Body, &opts)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
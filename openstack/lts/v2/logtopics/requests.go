@@ -26,21 +26,24 @@ func Create(client *golangsdk.ServiceClient, groupId string, ops CreateOptsBuild
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client, groupId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, groupId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Delete a log topic by id
 func Delete(client *golangsdk.ServiceClient, groupId string, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, groupId, id), nil)
+	resp, err := client.Delete(deleteURL(client, groupId, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get a log topic with detailed information by id
 func Get(client *golangsdk.ServiceClient, groupId string, id string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, groupId, id), &r.Body, nil)
+	resp, err := client.Get(getURL(client, groupId, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
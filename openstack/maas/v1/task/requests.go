@@ -56,20 +56,23 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	resp, err := c.Delete(resourceURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,216 @@
+package claims
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/messaging/v2/messages"
+)
+
+// ConsumerOpts configures a Consumer.
+type ConsumerOpts struct {
+	// TTL is the lifetime of each claim, in seconds. Defaults to 60.
+	TTL int
+
+	// Grace is the time, in seconds, added to the lifetime of the claimed
+	// messages. Defaults to 60.
+	Grace int
+
+	// Limit is the number of messages claimed at once, up to 20. Defaults
+	// to 10.
+	Limit int
+
+	// PollInterval is the time waited before claiming again when the queue is
+	// empty or a claim failed. Defaults to one second.
+	PollInterval time.Duration
+
+	// RenewInterval is the period at which the claims of the messages not
+	// acknowledged yet are renewed. Defaults to half of the TTL.
+	RenewInterval time.Duration
+}
+
+// Delivery is a claimed message delivered by a Consumer, or a claim error
+// when Err is set.
+type Delivery struct {
+	Message
+	Err error
+
+	consumer *Consumer
+	claimID  string
+}
+
+// Ack deletes the message once it has been processed, and stops renewing its
+// claim if it was the last message of the claim not acknowledged.
+func (d Delivery) Ack() error {
+	err := messages.Delete(d.consumer.client, d.consumer.queueName, d.ID, messages.DeleteOpts{
+		ClaimID: d.claimID,
+	}).ExtractErr()
+	if err != nil {
+		return err
+	}
+	d.consumer.done(d.claimID)
+	return nil
+}
+
+// Consumer claims the messages of a queue in a loop and delivers them on a
+// channel. The claims of the messages delivered but not acknowledged yet are
+// renewed, so that long running work does not lose its messages to other
+// consumers.
+type Consumer struct {
+	client    *golangsdk.ServiceClient
+	queueName string
+	opts      ConsumerOpts
+
+	mut      sync.Mutex
+	inFlight map[string]int
+}
+
+// NewConsumer returns a Consumer of the messages of a queue.
+func NewConsumer(client *golangsdk.ServiceClient, queueName string, opts ConsumerOpts) *Consumer {
+	if opts.TTL <= 0 {
+		opts.TTL = 60
+	}
+	if opts.Grace <= 0 {
+		opts.Grace = 60
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = 10
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+	if opts.RenewInterval <= 0 {
+		opts.RenewInterval = time.Duration(opts.TTL) * time.Second / 2
+	}
+	return &Consumer{
+		client:    client,
+		queueName: queueName,
+		opts:      opts,
+		inFlight:  make(map[string]int),
+	}
+}
+
+// Start claims messages until ctx is done, and sends them on the returned
+// channel, which is closed once ctx is done. Claim and renewal errors are sent
+// as deliveries with Err set, and claiming resumes after PollInterval.
+//
+// The claims are not released when ctx is done: the messages not
+// acknowledged become available to other consumers once their claim expires.
+func (c *Consumer) Start(ctx context.Context) <-chan Delivery {
+	deliveries := make(chan Delivery)
+
+	send := func(d Delivery) bool {
+		select {
+		case deliveries <- d:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.claimLoop(ctx, send)
+	}()
+	go func() {
+		defer wg.Done()
+		c.renewLoop(ctx, send)
+	}()
+	go func() {
+		wg.Wait()
+		close(deliveries)
+	}()
+
+	return deliveries
+}
+
+func (c *Consumer) claimLoop(ctx context.Context, send func(Delivery) bool) {
+	for {
+		claimed, err := Create(c.client, c.queueName, CreateOpts{
+			TTL:   c.opts.TTL,
+			Grace: c.opts.Grace,
+			Limit: c.opts.Limit,
+		}).Extract()
+		if err != nil {
+			if !send(Delivery{Err: err}) {
+				return
+			}
+		}
+
+		if len(claimed) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.opts.PollInterval):
+			}
+			continue
+		}
+
+		for _, message := range claimed {
+			claimID := message.ClaimID()
+			c.mut.Lock()
+			c.inFlight[claimID]++
+			c.mut.Unlock()
+
+			if !send(Delivery{Message: message, consumer: c, claimID: claimID}) {
+				return
+			}
+		}
+	}
+}
+
+func (c *Consumer) renewLoop(ctx context.Context, send func(Delivery) bool) {
+	ticker := time.NewTicker(c.opts.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.mut.Lock()
+		ids := make([]string, 0, len(c.inFlight))
+		for id := range c.inFlight {
+			ids = append(ids, id)
+		}
+		c.mut.Unlock()
+
+		for _, id := range ids {
+			err := Update(c.client, c.queueName, id, UpdateOpts{
+				TTL:   c.opts.TTL,
+				Grace: c.opts.Grace,
+			}).ExtractErr()
+			if err == nil {
+				continue
+			}
+			// A claim which can no longer be renewed has expired, and its
+			// messages may already be delivered to other consumers.
+			c.mut.Lock()
+			delete(c.inFlight, id)
+			c.mut.Unlock()
+			if !send(Delivery{Err: err}) {
+				return
+			}
+		}
+	}
+}
+
+// done records that a message of a claim has been acknowledged.
+func (c *Consumer) done(claimID string) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	if n, ok := c.inFlight[claimID]; ok {
+		if n <= 1 {
+			delete(c.inFlight, claimID)
+		} else {
+			c.inFlight[claimID] = n - 1
+		}
+	}
+}
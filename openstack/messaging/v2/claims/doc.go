@@ -0,0 +1,74 @@
+/*
+Package claims provides information and interaction with the claims through
+the OpenStack Messaging (Zaqar) service. A claim marks messages as being
+processed by a consumer, so that they are not returned to other consumers
+until the claim expires or is released.
+
+Example to Create a Claim
+
+	createOpts := claims.CreateOpts{
+		TTL:   60,
+		Grace: 120,
+		Limit: 20,
+	}
+
+	claimedMessages, err := claims.Create(client, "demo", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, message := range claimedMessages {
+		// Process the message, then delete it using its claim.
+		deleteOpts := messages.DeleteOpts{ClaimID: message.ClaimID()}
+		err := messages.Delete(client, "demo", message.ID, deleteOpts).ExtractErr()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Example to Get a Claim
+
+	claim, err := claims.Get(client, "demo", "51db7067821e727dc24df754").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Claim
+
+	updateOpts := claims.UpdateOpts{
+		TTL:   600,
+		Grace: 1200,
+	}
+
+	err := claims.Update(client, "demo", "51db7067821e727dc24df754", updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Release a Claim
+
+	err := claims.Delete(client, "demo", "51db7067821e727dc24df754").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Consume the Messages of a Queue
+
+	consumer := claims.NewConsumer(client, "demo", claims.ConsumerOpts{
+		TTL:   60,
+		Grace: 120,
+	})
+
+	for delivery := range consumer.Start(ctx) {
+		if delivery.Err != nil {
+			log.Printf("Unable to claim messages: %v", delivery.Err)
+			continue
+		}
+
+		// Process the message, then acknowledge it to delete it.
+		if err := delivery.Ack(); err != nil {
+			log.Printf("Unable to delete message %s: %v", delivery.ID, err)
+		}
+	}
+*/
+package claims
@@ -0,0 +1,111 @@
+package claims
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToClaimCreateRequest() (map[string]interface{}, string, error)
+}
+
+// CreateOpts params to be used with Create.
+type CreateOpts struct {
+	// Limit is the number of messages to claim, up to 20. It defaults to 10.
+	Limit int `q:"limit,omitempty" json:"-"`
+
+	// TTL is the lifetime of the claim, in seconds.
+	TTL int `json:"ttl,omitempty"`
+
+	// Grace is the time, in seconds, added to the lifetime of the claimed
+	// messages so that they outlive the claim.
+	Grace int `json:"grace,omitempty"`
+}
+
+// ToClaimCreateRequest assembles a body and URL for a Create request based on
+// the contents of a CreateOpts.
+func (opts CreateOpts) ToClaimCreateRequest() (map[string]interface{}, string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	if err != nil {
+		return nil, q.String(), err
+	}
+
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return b, "", err
+	}
+	return b, q.String(), err
+}
+
+// Create claims messages of a queue. The result is empty when there are no
+// messages to claim.
+func Create(client *golangsdk.ServiceClient, queueName string, opts CreateOptsBuilder) (r CreateResult) {
+	url := createURL(client, queueName)
+
+	b, q, err := opts.ToClaimCreateRequest()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	url += q
+
+	resp, err := client.Post(url, b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get queries the specified claim of a queue.
+func Get(client *golangsdk.ServiceClient, queueName string, claimID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, queueName, claimID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToClaimUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts params to be used with Update.
+type UpdateOpts struct {
+	// TTL is the new lifetime of the claim, in seconds.
+	TTL int `json:"ttl,omitempty"`
+
+	// Grace is the new grace period of the claimed messages, in seconds.
+	Grace int `json:"grace,omitempty"`
+}
+
+// ToClaimUpdateMap assembles a body for an Update request based on the
+// contents of an UpdateOpts.
+func (opts UpdateOpts) ToClaimUpdateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Update renews the specified claim of a queue.
+func Update(client *golangsdk.ServiceClient, queueName string, claimID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToClaimUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Patch(resourceURL(client, queueName, claimID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete releases the specified claim, making its messages available to other
+// consumers.
+func Delete(client *golangsdk.ServiceClient, queueName string, claimID string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, queueName, claimID), &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,84 @@
+package claims
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Claim represents a claim on the messages of a queue.
+type Claim struct {
+	ID       string    `json:"id"`
+	Age      int       `json:"age"`
+	Href     string    `json:"href"`
+	TTL      int       `json:"ttl"`
+	Messages []Message `json:"messages"`
+}
+
+// Message represents a claimed message.
+type Message struct {
+	ID   string                 `json:"id"`
+	Href string                 `json:"href"`
+	Age  int                    `json:"age"`
+	TTL  int                    `json:"ttl"`
+	Body map[string]interface{} `json:"body"`
+}
+
+// ClaimID returns the ID of the claim, parsed from the claim_id query
+// parameter of the message href.
+func (m Message) ClaimID() string {
+	i := strings.Index(m.Href, "?")
+	if i < 0 {
+		return ""
+	}
+	q, err := url.ParseQuery(m.Href[i+1:])
+	if err != nil {
+		return ""
+	}
+	return q.Get("claim_id")
+}
+
+// CreateResult is the response of a Create operation. Call its Extract method
+// to interpret it as the claimed messages.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a Create operation as the claimed
+// messages. It returns an empty slice when there were no messages to claim.
+func (r CreateResult) Extract() ([]Message, error) {
+	var s struct {
+		Messages []Message `json:"messages"`
+	}
+	if r.Err == nil && r.Body == nil {
+		return nil, nil
+	}
+	err := r.ExtractInto(&s)
+	return s.Messages, err
+}
+
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as a Claim.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a Get operation as a Claim.
+func (r GetResult) Extract() (*Claim, error) {
+	var s *Claim
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// UpdateResult is the response of an Update operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type UpdateResult struct {
+	golangsdk.ErrResult
+}
+
+// DeleteResult is the response of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,2 @@
+// claims unit tests
+package testing
@@ -0,0 +1,183 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/messaging/v2/claims"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+// QueueName is the name of the queue.
+var QueueName = "FakeTestQueue"
+
+// ClaimID is the ID of the claim.
+var ClaimID = "51db7067821e727dc24df754"
+
+// CreateClaimRequest is a sample request to create a claim.
+const CreateClaimRequest = `
+{
+    "ttl": 3600,
+    "grace": 3600
+}`
+
+// CreateClaimResponse is a sample response to a create claim.
+const CreateClaimResponse = `
+{
+    "messages": [
+        {
+            "body": {"event": "BackupStarted"},
+            "href": "/v2/queues/FakeTestQueue/messages/51db6f78c508f17ddc924357?claim_id=51db7067821e727dc24df754",
+            "age": 57,
+            "ttl": 300,
+            "id": "51db6f78c508f17ddc924357"
+        }
+    ]
+}`
+
+// GetClaimResponse is a sample response to a get claim.
+const GetClaimResponse = `
+{
+    "age": 50,
+    "href": "/v2/queues/FakeTestQueue/claims/51db7067821e727dc24df754",
+    "messages": [
+        {
+            "body": {"event": "BackupStarted"},
+            "href": "/v2/queues/FakeTestQueue/messages/51db6f78c508f17ddc924357?claim_id=51db7067821e727dc24df754",
+            "age": 57,
+            "ttl": 300,
+            "id": "51db6f78c508f17ddc924357"
+        }
+    ],
+    "ttl": 50,
+    "id": "51db7067821e727dc24df754"
+}`
+
+// UpdateClaimRequest is a sample request to update a claim.
+const UpdateClaimRequest = `
+{
+    "ttl": 1200,
+    "grace": 1600
+}`
+
+// ClaimedMessage is the message returned by the claim operations.
+var ClaimedMessage = claims.Message{
+	ID:   "51db6f78c508f17ddc924357",
+	Href: "/v2/queues/FakeTestQueue/messages/51db6f78c508f17ddc924357?claim_id=51db7067821e727dc24df754",
+	Age:  57,
+	TTL:  300,
+	Body: map[string]interface{}{"event": "BackupStarted"},
+}
+
+// ExpectedClaim is the expected result of a Get.
+var ExpectedClaim = claims.Claim{
+	ID:       ClaimID,
+	Age:      50,
+	Href:     "/v2/queues/FakeTestQueue/claims/51db7067821e727dc24df754",
+	TTL:      50,
+	Messages: []claims.Message{ClaimedMessage},
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestFormValues(t, r, map[string]string{"limit": "10"})
+			th.TestJSONRequest(t, r, CreateClaimRequest)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, CreateClaimResponse)
+		})
+}
+
+// HandleCreateNoContent configures the test server to respond to a Create
+// request when there are no messages to claim.
+func HandleCreateNoContent(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims/%s", QueueName, ClaimID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, GetClaimResponse)
+		})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims/%s", QueueName, ClaimID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, UpdateClaimRequest)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims/%s", QueueName, ClaimID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
+
+// HandleConsumeSuccessfully configures the test server to deliver the
+// messages of CreateClaimResponse once, renew their claim, and delete them.
+// renewals is incremented on each renewal of the claim.
+func HandleConsumeSuccessfully(t *testing.T, renewals *int32) {
+	var claimed int32
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "POST")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			if atomic.AddInt32(&claimed, 1) > 1 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, CreateClaimResponse)
+		})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/claims/%s", QueueName, ClaimID),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "PATCH")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestJSONRequest(t, r, `{"ttl": 60, "grace": 120}`)
+
+			atomic.AddInt32(renewals, 1)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	th.Mux.HandleFunc(fmt.Sprintf("/queues/%s/messages/51db6f78c508f17ddc924357", QueueName),
+		func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestFormValues(t, r, map[string]string{"claim_id": ClaimID})
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+}
@@ -0,0 +1,112 @@
+package testing
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/messaging/v2/claims"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := claims.CreateOpts{
+		TTL:   3600,
+		Grace: 3600,
+		Limit: 10,
+	}
+
+	actual, err := claims.Create(fake.ServiceClient(), QueueName, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []claims.Message{ClaimedMessage}, actual)
+	th.CheckEquals(t, ClaimID, actual[0].ClaimID())
+}
+
+func TestCreateNoContent(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateNoContent(t)
+
+	actual, err := claims.Create(fake.ServiceClient(), QueueName, claims.CreateOpts{TTL: 3600}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := claims.Get(fake.ServiceClient(), QueueName, ClaimID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedClaim, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := claims.UpdateOpts{
+		TTL:   1200,
+		Grace: 1600,
+	}
+
+	err := claims.Update(fake.ServiceClient(), QueueName, ClaimID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := claims.Delete(fake.ServiceClient(), QueueName, ClaimID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestConsumer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var renewals int32
+	HandleConsumeSuccessfully(t, &renewals)
+
+	consumer := claims.NewConsumer(fake.ServiceClient(), QueueName, claims.ConsumerOpts{
+		TTL:           60,
+		Grace:         120,
+		PollInterval:  5 * time.Millisecond,
+		RenewInterval: 5 * time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deliveries := consumer.Start(ctx)
+	delivery := <-deliveries
+	th.AssertNoErr(t, delivery.Err)
+	th.AssertEquals(t, "51db6f78c508f17ddc924357", delivery.ID)
+
+	// The claim is renewed while the message is being processed.
+	for atomic.LoadInt32(&renewals) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	th.AssertNoErr(t, delivery.Ack())
+
+	// Once acknowledged, the claim is no longer renewed.
+	time.Sleep(20 * time.Millisecond)
+	acked := atomic.LoadInt32(&renewals)
+	time.Sleep(20 * time.Millisecond)
+	th.AssertEquals(t, acked, atomic.LoadInt32(&renewals))
+
+	cancel()
+	for delivery := range deliveries {
+		t.Errorf("Unexpected delivery %+v", delivery)
+	}
+}
@@ -0,0 +1,16 @@
+package claims
+
+import "github.com/huaweicloud/golangsdk"
+
+const (
+	rootPath  = "queues"
+	claimPath = "claims"
+)
+
+func createURL(c *golangsdk.ServiceClient, queueName string) string {
+	return c.ServiceURL(rootPath, queueName, claimPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, queueName string, claimID string) string {
+	return c.ServiceURL(rootPath, queueName, claimPath, claimID)
+}
@@ -0,0 +1,76 @@
+/*
+Package messages provides information and interaction with the messages
+through the OpenStack Messaging (Zaqar) service.
+
+Example to List Messages
+
+	listOpts := messages.ListOpts{
+		Limit: 10,
+	}
+
+	pager := messages.List(client, "demo", listOpts)
+
+	err = pager.EachPage(func(page pagination.Page) (bool, error) {
+		allMessages, err := messages.ExtractMessages(page)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, message := range allMessages {
+			fmt.Printf("%+v\n", message)
+		}
+
+		return true, nil
+	})
+
+Example to Post Messages
+
+	createOpts := messages.BatchCreateOpts{
+		messages.CreateOpts{
+			TTL:   300,
+			Delay: 20,
+			Body: map[string]interface{}{
+				"event": "BackupStarted",
+			},
+		},
+	}
+
+	resources, err := messages.Create(client, "demo", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get a Set of Messages
+
+	getMessageOpts := messages.GetMessagesOpts{
+		IDs: []string{"9988776655", "1122334455"},
+	}
+
+	allMessages, err := messages.GetMessages(client, "demo", getMessageOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Pop Messages
+
+	popMessagesOpts := messages.PopMessagesOpts{
+		Pop: 5,
+	}
+
+	popMessages, err := messages.PopMessages(client, "demo", popMessagesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Claimed Message
+
+	deleteOpts := messages.DeleteOpts{
+		ClaimID: "12345",
+	}
+
+	err := messages.Delete(client, "demo", "1122334455", deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package messages
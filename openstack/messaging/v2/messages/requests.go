@@ -0,0 +1,269 @@
+package messages
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMessageListQuery() (string, error)
+}
+
+// ListOpts params to be used with List.
+type ListOpts struct {
+	// Limit instructs List to refrain from sending excessively large lists of
+	// messages.
+	Limit int `q:"limit,omitempty"`
+
+	// Marker and Limit control paging. Marker instructs List where to start
+	// listing from.
+	Marker string `q:"marker,omitempty"`
+
+	// Echo indicates whether the messages posted by the same client should be
+	// returned.
+	Echo bool `q:"echo,omitempty"`
+
+	// IncludeClaimed indicates whether claimed messages should be returned.
+	IncludeClaimed bool `q:"include_claimed,omitempty"`
+}
+
+// ToMessageListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMessageListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List lists the messages of a queue.
+func List(client *golangsdk.ServiceClient, queueName string, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client, queueName)
+	if opts != nil {
+		query, err := opts.ToMessageListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MessagePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMessageCreateMap() (map[string]interface{}, error)
+}
+
+// BatchCreateOpts is an array of CreateOpts.
+type BatchCreateOpts []CreateOpts
+
+// CreateOpts specifies a message to post.
+type CreateOpts struct {
+	// TTL is the time to live of the message, in seconds. It defaults to the
+	// queue's _default_message_ttl.
+	TTL int `json:"ttl,omitempty"`
+
+	// Delay is the time, in seconds, before the message becomes available.
+	Delay int `json:"delay,omitempty"`
+
+	// Body is the arbitrary JSON document of the message.
+	Body map[string]interface{} `json:"body" required:"true"`
+}
+
+// ToMessageCreateMap constructs a request body from BatchCreateOpts.
+func (opts BatchCreateOpts) ToMessageCreateMap() (map[string]interface{}, error) {
+	messages := make([]map[string]interface{}, len(opts))
+	for i, message := range opts {
+		m, err := golangsdk.BuildRequestBody(message, "")
+		if err != nil {
+			return nil, err
+		}
+		messages[i] = m
+	}
+	return map[string]interface{}{"messages": messages}, nil
+}
+
+// Create posts one or more messages to a queue.
+func Create(client *golangsdk.ServiceClient, queueName string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMessageCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(rootURL(client, queueName), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetMessagesOptsBuilder allows extensions to add additional parameters to
+// the GetMessages request.
+type GetMessagesOptsBuilder interface {
+	ToGetMessagesListQuery() (string, error)
+}
+
+// GetMessagesOpts params to be used with GetMessages.
+type GetMessagesOpts struct {
+	// IDs is the list of the message IDs to get.
+	IDs []string
+}
+
+// ToGetMessagesListQuery formats a GetMessagesOpts into a query string.
+func (opts GetMessagesOpts) ToGetMessagesListQuery() (string, error) {
+	return idsQuery(opts.IDs), nil
+}
+
+// GetMessages requests a set of messages by their IDs.
+func GetMessages(client *golangsdk.ServiceClient, queueName string, opts GetMessagesOptsBuilder) (r GetMessagesResult) {
+	url := rootURL(client, queueName)
+	if opts != nil {
+		query, err := opts.ToGetMessagesListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get requests the details of a single message.
+func Get(client *golangsdk.ServiceClient, queueName string, messageID string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, queueName, messageID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMessagesOptsBuilder allows extensions to add additional parameters to
+// the DeleteMessages request.
+type DeleteMessagesOptsBuilder interface {
+	ToMessagesDeleteQuery() (string, error)
+}
+
+// DeleteMessagesOpts params to be used with DeleteMessages.
+type DeleteMessagesOpts struct {
+	// IDs is the list of the message IDs to delete.
+	IDs []string
+}
+
+// ToMessagesDeleteQuery formats a DeleteMessagesOpts into a query string.
+func (opts DeleteMessagesOpts) ToMessagesDeleteQuery() (string, error) {
+	return idsQuery(opts.IDs), nil
+}
+
+// DeleteMessages deletes a set of messages by their IDs.
+func DeleteMessages(client *golangsdk.ServiceClient, queueName string, opts DeleteMessagesOptsBuilder) (r DeleteResult) {
+	url := rootURL(client, queueName)
+	if opts != nil {
+		query, err := opts.ToMessagesDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// PopMessagesOptsBuilder allows extensions to add additional parameters to
+// the PopMessages request.
+type PopMessagesOptsBuilder interface {
+	ToMessagesPopQuery() (string, error)
+}
+
+// PopMessagesOpts params to be used with PopMessages.
+type PopMessagesOpts struct {
+	// Pop is the number of messages to pop, from 1 to 20.
+	Pop int `q:"pop,omitempty"`
+}
+
+// ToMessagesPopQuery formats a PopMessagesOpts into a query string.
+func (opts PopMessagesOpts) ToMessagesPopQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// PopMessages deletes the given number of messages from a queue and returns
+// them.
+func PopMessages(client *golangsdk.ServiceClient, queueName string, opts PopMessagesOptsBuilder) (r PopResult) {
+	url := rootURL(client, queueName)
+	if opts != nil {
+		query, err := opts.ToMessagesPopQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Request("DELETE", url, &golangsdk.RequestOpts{
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200, 204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToMessageDeleteQuery() (string, error)
+}
+
+// DeleteOpts params to be used with Delete.
+type DeleteOpts struct {
+	// ClaimID is required to delete a claimed message.
+	ClaimID string `q:"claim_id,omitempty"`
+}
+
+// ToMessageDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToMessageDeleteQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Delete deletes a single message.
+func Delete(client *golangsdk.ServiceClient, queueName string, messageID string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := resourceURL(client, queueName, messageID)
+	if opts != nil {
+		query, err := opts.ToMessageDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// idsQuery formats a list of message IDs as the comma-separated "ids" query
+// parameter expected by the API.
+func idsQuery(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	q := url.Values{}
+	q.Set("ids", strings.Join(ids, ","))
+	return "?" + q.Encode()
+}
@@ -0,0 +1,125 @@
+package messages
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Message represents a message of a queue.
+type Message struct {
+	ID       string                 `json:"id"`
+	Href     string                 `json:"href"`
+	Age      int                    `json:"age"`
+	TTL      int                    `json:"ttl"`
+	Body     map[string]interface{} `json:"body"`
+	ClaimID  string                 `json:"claim_id"`
+	ClaimTTL int                    `json:"claim_ttl"`
+}
+
+// ResourceList is the list of the hrefs of the created messages.
+type ResourceList struct {
+	Resources []string `json:"resources"`
+}
+
+// MessagePage contains a single page of all messages from a List operation.
+type MessagePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines if a MessagePage contains any results.
+func (r MessagePage) IsEmpty() (bool, error) {
+	s, err := ExtractMessages(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r MessagePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := golangsdk.ExtractNextURL(s.Links)
+	if err != nil || next == "" {
+		return "", err
+	}
+	return nextPageURL(r.URL.String(), next)
+}
+
+// ExtractMessages interprets the results of a single page from a List() call,
+// producing a slice of Message.
+func ExtractMessages(r pagination.Page) ([]Message, error) {
+	var s struct {
+		Messages []Message `json:"messages"`
+	}
+	err := (r.(MessagePage)).ExtractInto(&s)
+	return s.Messages, err
+}
+
+// CreateResult is the response of a Create operation. Call its Extract method
+// to interpret it as a ResourceList.
+type CreateResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a Create operation as a ResourceList.
+func (r CreateResult) Extract() (ResourceList, error) {
+	var s ResourceList
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetMessagesResult is the response of a GetMessages operation. Call its
+// Extract method to interpret it as a slice of Message.
+type GetMessagesResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a GetMessages operation as a slice of
+// Message.
+func (r GetMessagesResult) Extract() ([]Message, error) {
+	var s struct {
+		Messages []Message `json:"messages"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Messages, err
+}
+
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as a Message.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a Get operation as a Message.
+func (r GetResult) Extract() (Message, error) {
+	var s Message
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// PopResult is the response of a PopMessages operation. Call its Extract
+// method to interpret it as a slice of Message.
+type PopResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a PopMessages operation as a slice of
+// Message.
+func (r PopResult) Extract() ([]Message, error) {
+	var s struct {
+		Messages []Message `json:"messages"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Messages, err
+}
+
+// DeleteResult is the response of a Delete or DeleteMessages operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
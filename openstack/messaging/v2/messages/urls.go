@@ -0,0 +1,35 @@
+package messages
+
+import (
+	"net/url"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+const (
+	rootPath    = "queues"
+	messagePath = "messages"
+)
+
+func rootURL(c *golangsdk.ServiceClient, queueName string) string {
+	return c.ServiceURL(rootPath, queueName, messagePath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, queueName string, messageID string) string {
+	return c.ServiceURL(rootPath, queueName, messagePath, messageID)
+}
+
+// nextPageURL builds the URL of the next page from the current URL and the
+// relative "next" link returned by the API.
+func nextPageURL(currentURL string, next string) (string, error) {
+	current, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	current.RawQuery = nextURL.RawQuery
+	return current.String(), nil
+}
@@ -0,0 +1,83 @@
+/*
+Package queues provides information and interaction with the queues through
+the OpenStack Messaging (Zaqar) service.
+
+Every request of the messaging API must carry a Client-ID header, which is set
+by openstack.NewMessagingV2.
+
+Example to List Queues
+
+	listOpts := queues.ListOpts{
+		Limit: 10,
+	}
+
+	pager := queues.List(client, listOpts)
+
+	err = pager.EachPage(func(page pagination.Page) (bool, error) {
+		queues, err := queues.ExtractQueues(page)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, queue := range queues {
+			fmt.Printf("%+v\n", queue)
+		}
+
+		return true, nil
+	})
+
+Example to Create a Queue
+
+	createOpts := queues.CreateOpts{
+		MaxMessagesPostSize: 262143,
+		DefaultMessageTTL:   3700,
+		DefaultMessageDelay: 25,
+		Extra: map[string]interface{}{"description": "Test queue."},
+	}
+
+	err := queues.Create(client, "demo", createOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Queue
+
+	updateOpts := queues.UpdateOpts{
+		queues.UpdateQueueBody{
+			Op:    "replace",
+			Path:  "/metadata/_max_claim_count",
+			Value: 15,
+		},
+	}
+
+	updateResult, err := queues.Update(client, "demo", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Stats of a Queue
+
+	stats, err := queues.GetStats(client, "demo").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Purge the Messages of a Queue
+
+	purgeOpts := queues.PurgeOpts{
+		ResourceTypes: []string{"messages"},
+	}
+
+	err := queues.Purge(client, "demo", purgeOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Queue
+
+	err := queues.Delete(client, "demo").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package queues
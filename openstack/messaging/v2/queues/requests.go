@@ -0,0 +1,218 @@
+package queues
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToQueueListQuery() (string, error)
+}
+
+// ListOpts params to be used with List.
+type ListOpts struct {
+	// Limit instructs List to refrain from sending excessively large lists of
+	// queues.
+	Limit int `q:"limit,omitempty"`
+
+	// Marker and Limit control paging. Marker instructs List where to start
+	// listing from.
+	Marker string `q:"marker,omitempty"`
+
+	// Detailed specifies if showing the detailed information when querying
+	// queues.
+	Detailed bool `q:"detailed,omitempty"`
+}
+
+// ToQueueListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToQueueListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List instructs OpenStack to provide a list of queues.
+func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(client)
+	if opts != nil {
+		query, err := opts.ToQueueListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return QueuePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToQueueCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the queue creation parameters.
+type CreateOpts struct {
+	// MaxMessagesPostSize is the max size in bytes of messages posted to the
+	// queue.
+	MaxMessagesPostSize int `json:"_max_messages_post_size,omitempty"`
+
+	// DefaultMessageTTL is the default time to live of messages, in seconds.
+	DefaultMessageTTL int `json:"_default_message_ttl,omitempty"`
+
+	// DefaultMessageDelay is the default delay of messages, in seconds.
+	DefaultMessageDelay int `json:"_default_message_delay,omitempty"`
+
+	// DeadLetterQueue is the name of the queue which receives the messages
+	// that have been claimed more than DeadLetterQueueMessagesTTL times.
+	DeadLetterQueue string `json:"_dead_letter_queue,omitempty"`
+
+	// DeadLetterQueueMessagesTTL is the TTL of the messages moved to the dead
+	// letter queue.
+	DeadLetterQueueMessagesTTL int `json:"_dead_letter_queue_messages_ttl,omitempty"`
+
+	// MaxClaimCount is the maximum number of times a message can be claimed
+	// before it is moved to the dead letter queue.
+	MaxClaimCount int `json:"_max_claim_count,omitempty"`
+
+	// Extra is free-form extra metadata of the queue.
+	Extra map[string]interface{} `json:"-"`
+}
+
+// ToQueueCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToQueueCreateMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.Extra {
+		b[k] = v
+	}
+
+	return b, nil
+}
+
+// Create requests the creation of a new queue.
+func Create(client *golangsdk.ServiceClient, queueName string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToQueueCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Put(resourceURL(client, queueName), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201, 204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToQueueUpdateMap() ([]map[string]interface{}, error)
+}
+
+// UpdateOpts is an array of UpdateQueueBody.
+type UpdateOpts []UpdateQueueBody
+
+// UpdateQueueBody is a JSON-patch operation on the queue metadata.
+type UpdateQueueBody struct {
+	// Op is the operation, one of "add", "replace" or "remove".
+	Op string `json:"op" required:"true"`
+
+	// Path is the metadata key, e.g. "/metadata/_default_message_ttl".
+	Path string `json:"path" required:"true"`
+
+	// Value is the new value. It is ignored by "remove" operations.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ToQueueUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToQueueUpdateMap() ([]map[string]interface{}, error) {
+	s := make([]map[string]interface{}, len(opts))
+	for i, v := range opts {
+		body, err := golangsdk.BuildRequestBody(v, "")
+		if err != nil {
+			return nil, err
+		}
+		s[i] = body
+	}
+	return s, nil
+}
+
+// Update updates the metadata of a queue.
+func Update(client *golangsdk.ServiceClient, queueName string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToQueueUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Patch(resourceURL(client, queueName), b, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 201, 204},
+		MoreHeaders: map[string]string{
+			"Content-Type": "application/openstack-messaging-v2.0-json-patch",
+		},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Get requests the metadata of a queue.
+func Get(client *golangsdk.ServiceClient, queueName string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, queueName), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Delete deletes the specified queue and all its messages.
+func Delete(client *golangsdk.ServiceClient, queueName string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, queueName), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetStats returns the message statistics of a queue.
+func GetStats(client *golangsdk.ServiceClient, queueName string) (r StatResult) {
+	resp, err := client.Get(statURL(client, queueName), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// PurgeOptsBuilder allows extensions to add additional parameters to the
+// Purge request.
+type PurgeOptsBuilder interface {
+	ToQueuePurgeMap() (map[string]interface{}, error)
+}
+
+// PurgeOpts specifies the resources to purge from a queue.
+type PurgeOpts struct {
+	// ResourceTypes is a list of "messages" and/or "subscriptions".
+	ResourceTypes []string `json:"resource_types" required:"true"`
+}
+
+// ToQueuePurgeMap constructs a request body from PurgeOpts.
+func (opts PurgeOpts) ToQueuePurgeMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+// Purge removes the messages and/or subscriptions of a queue, without
+// deleting the queue itself.
+func Purge(client *golangsdk.ServiceClient, queueName string, opts PurgeOptsBuilder) (r PurgeResult) {
+	b, err := opts.ToQueuePurgeMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(purgeURL(client, queueName), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{204},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,145 @@
+package queues
+
+import (
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// Queue represents a messaging queue.
+type Queue struct {
+	Href     string                 `json:"href"`
+	Name     string                 `json:"name"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// QueueDetails represents the metadata of a queue.
+type QueueDetails struct {
+	MaxMessagesPostSize        int                    `json:"_max_messages_post_size"`
+	DefaultMessageTTL          int                    `json:"_default_message_ttl"`
+	DefaultMessageDelay        int                    `json:"_default_message_delay"`
+	DeadLetterQueue            string                 `json:"_dead_letter_queue"`
+	DeadLetterQueueMessagesTTL int                    `json:"_dead_letter_queue_messages_ttl"`
+	MaxClaimCount              int                    `json:"_max_claim_count"`
+	Extra                      map[string]interface{} `json:"-"`
+}
+
+// Stats represents the message statistics of a queue.
+type Stats struct {
+	Claimed int `json:"claimed"`
+	Free    int `json:"free"`
+	Total   int `json:"total"`
+}
+
+// QueuePage contains a single page of all queues from a List operation.
+type QueuePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines if a QueuesPage contains any results.
+func (r QueuePage) IsEmpty() (bool, error) {
+	s, err := ExtractQueues(r)
+	return len(s) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r QueuePage) NextPageURL() (string, error) {
+	var s struct {
+		Links []golangsdk.Link `json:"links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := golangsdk.ExtractNextURL(s.Links)
+	if err != nil || next == "" {
+		return "", err
+	}
+	return nextPageURL(r.URL.String(), next)
+}
+
+// ExtractQueues interprets the results of a single page from a
+// List() call, producing a slice of Queue.
+func ExtractQueues(r pagination.Page) ([]Queue, error) {
+	var s struct {
+		Queues []Queue `json:"queues"`
+	}
+	err := (r.(QueuePage)).ExtractInto(&s)
+	return s.Queues, err
+}
+
+// CreateResult is the response of a Create operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type CreateResult struct {
+	golangsdk.ErrResult
+}
+
+// UpdateResult is the response of an Update operation. Call its Extract
+// method to interpret it as the updated QueueDetails.
+type UpdateResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of an Update operation as QueueDetails.
+func (r UpdateResult) Extract() (QueueDetails, error) {
+	var s QueueDetails
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// GetResult is the response of a Get operation. Call its Extract method to
+// interpret it as QueueDetails.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a Get operation as QueueDetails. Metadata
+// keys which are not known to QueueDetails are returned in Extra.
+func (r GetResult) Extract() (QueueDetails, error) {
+	var s QueueDetails
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return s, err
+	}
+
+	var extra map[string]interface{}
+	if err := r.ExtractInto(&extra); err != nil {
+		return s, err
+	}
+	for _, k := range []string{"_max_messages_post_size", "_default_message_ttl", "_default_message_delay",
+		"_dead_letter_queue", "_dead_letter_queue_messages_ttl", "_max_claim_count"} {
+		delete(extra, k)
+	}
+	if len(extra) > 0 {
+		s.Extra = extra
+	}
+	return s, nil
+}
+
+// DeleteResult is the response of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	golangsdk.ErrResult
+}
+
+// StatResult is the response of a GetStats operation. Call its Extract
+// method to interpret it as Stats.
+type StatResult struct {
+	golangsdk.Result
+}
+
+// Extract interprets the result of a GetStats operation as Stats.
+func (r StatResult) Extract() (Stats, error) {
+	var s struct {
+		Messages Stats `json:"messages"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Messages, err
+}
+
+// PurgeResult is the response of a Purge operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type PurgeResult struct {
+	golangsdk.ErrResult
+}
@@ -0,0 +1,2 @@
+// queues unit tests
+package testing
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/messaging/v2/queues"
+	"github.com/huaweicloud/golangsdk/pagination"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	listOpts := queues.ListOpts{
+		Limit: 1,
+	}
+
+	count := 0
+	err := queues.List(fake.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		actual, err := queues.ExtractQueues(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedQueueSlice[count], actual)
+		count++
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 2, count)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	createOpts := queues.CreateOpts{
+		MaxMessagesPostSize:        262144,
+		DefaultMessageTTL:          3600,
+		DefaultMessageDelay:        30,
+		DeadLetterQueue:            "dead_letter",
+		DeadLetterQueueMessagesTTL: 3600,
+		MaxClaimCount:              10,
+		Extra:                      map[string]interface{}{"description": "Queue for unit testing."},
+	}
+
+	err := queues.Create(fake.ServiceClient(), QueueName, createOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := queues.UpdateOpts{
+		queues.UpdateQueueBody{
+			Op:    "replace",
+			Path:  "/metadata/_max_claim_count",
+			Value: 10,
+		},
+	}
+
+	actual, err := queues.Update(fake.ServiceClient(), QueueName, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 10, actual.MaxClaimCount)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := queues.Get(fake.ServiceClient(), QueueName).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, QueueDetails, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := queues.Delete(fake.ServiceClient(), QueueName).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetStat(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetStatsSuccessfully(t)
+
+	actual, err := queues.GetStats(fake.ServiceClient(), QueueName).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedStats, actual)
+}
+
+func TestPurge(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePurgeSuccessfully(t)
+
+	purgeOpts := queues.PurgeOpts{
+		ResourceTypes: []string{"messages", "subscriptions"},
+	}
+
+	err := queues.Purge(fake.ServiceClient(), QueueName, purgeOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,40 @@
+package queues
+
+import (
+	"net/url"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+const rootPath = "queues"
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath)
+}
+
+func resourceURL(c *golangsdk.ServiceClient, queueName string) string {
+	return c.ServiceURL(rootPath, queueName)
+}
+
+func statURL(c *golangsdk.ServiceClient, queueName string) string {
+	return c.ServiceURL(rootPath, queueName, "stats")
+}
+
+func purgeURL(c *golangsdk.ServiceClient, queueName string) string {
+	return c.ServiceURL(rootPath, queueName, "purge")
+}
+
+// nextPageURL builds the URL of the next page from the current URL and the
+// relative "next" link returned by the API.
+func nextPageURL(currentURL string, next string) (string, error) {
+	current, err := url.Parse(currentURL)
+	if err != nil {
+		return "", err
+	}
+	nextURL, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	current.RawQuery = nextURL.RawQuery
+	return current.String(), nil
+}
@@ -59,26 +59,29 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //delete an instance via id
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	b := make(map[string]interface{})
-	_, r.Err = client.DeleteWithBody(resourceURL(client, id), b, &golangsdk.RequestOpts{
+	resp, err := client.DeleteWithBody(resourceURL(client, id), b, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //get an instance with detailed information by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -117,15 +117,17 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	}
 
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(createURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: requestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -213,9 +215,10 @@ func Update(client *golangsdk.ServiceClient, clusterId string, opts UpdateOptsBu
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(resourceURL(client, clusterId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(resourceURL(client, clusterId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -224,7 +227,8 @@ func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 		OkCodes:     []int{204},
 		MoreHeaders: requestOpts.MoreHeaders,
 	}
-	_, r.Err = c.Delete(deleteURL(c, id), reqOpt)
+	resp, err := c.Delete(deleteURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
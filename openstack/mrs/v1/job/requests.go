@@ -41,20 +41,23 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 	log.Printf("[DEBUG] create url:%q, body=%#v", createURL(c), b)
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(createURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Get(getURL(c, id), &r.Body, reqOpt)
+	resp, err := c.Get(getURL(c, id), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = c.Delete(deleteURL(c, id), reqOpt)
+	resp, err := c.Delete(deleteURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
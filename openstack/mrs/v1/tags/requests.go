@@ -52,18 +52,20 @@ func BatchAction(client *golangsdk.ServiceClient, clusterID string, opts BatchOp
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, clusterID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, clusterID), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{204},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the tags of a specific instance.
 func Get(client *golangsdk.ServiceClient, clusterID string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(resourceURL(client, clusterID), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -63,15 +63,17 @@ func Create(client *golangsdk.ServiceClient, clusterId string, opts CreateOptsBu
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, clusterId), reqBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, clusterId), reqBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method to get an existing mapreduce job by cluster ID and job ID.
 func Get(client *golangsdk.ServiceClient, clsuterId, jobId string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, clsuterId, jobId), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, clsuterId, jobId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -172,6 +174,7 @@ func Delete(client *golangsdk.ServiceClient, clusterId string, opts DeleteOptsBu
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(deleteURL(client, clusterId), reqBody, nil, nil)
+	resp, err := client.Post(deleteURL(client, clusterId), reqBody, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// ServiceClientFactory creates the ServiceClient of a service for the region
+// set in the EndpointOpts, e.g. NewLoadBalancerV2 or NewComputeV2.
+type ServiceClientFactory func(*golangsdk.ProviderClient, golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error)
+
+// MultiRegionClient holds the ServiceClients of a service in several regions,
+// and fans out requests across them to provide a global view.
+type MultiRegionClient struct {
+	regions []string
+	clients map[string]*golangsdk.ServiceClient
+}
+
+// NewMultiRegionClient creates the ServiceClients of a service in each of the
+// given regions with newClient. eo provides the other endpoint options.
+func NewMultiRegionClient(client *golangsdk.ProviderClient, regions []string, eo golangsdk.EndpointOpts,
+	newClient ServiceClientFactory) (*MultiRegionClient, error) {
+	m := &MultiRegionClient{
+		clients: make(map[string]*golangsdk.ServiceClient, len(regions)),
+	}
+	for _, region := range regions {
+		eo.Region = region
+		sc, err := newClient(client, eo)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create client for region %s: %v", region, err)
+		}
+		m.regions = append(m.regions, region)
+		m.clients[region] = sc
+	}
+	return m, nil
+}
+
+// Regions returns the regions of the MultiRegionClient, in the order they
+// were given.
+func (m *MultiRegionClient) Regions() []string {
+	return append([]string(nil), m.regions...)
+}
+
+// Client returns the ServiceClient of a region, or nil if the region is
+// unknown.
+func (m *MultiRegionClient) Client(region string) *golangsdk.ServiceClient {
+	return m.clients[region]
+}
+
+// RegionResult is the outcome of a request sent to one region.
+type RegionResult struct {
+	Region string
+	Value  interface{}
+	Err    error
+}
+
+// RegionalItem is a resource annotated with the region it belongs to.
+type RegionalItem struct {
+	Region string
+	Item   interface{}
+}
+
+// ErrRegionsFailed is returned when requests failed in some regions. It holds
+// the error of each of them.
+type ErrRegionsFailed struct {
+	golangsdk.BaseError
+	Errors map[string]error
+}
+
+func (e ErrRegionsFailed) Error() string {
+	regions := make([]string, 0, len(e.Errors))
+	for region := range e.Errors {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	msgs := make([]string, len(regions))
+	for i, region := range regions {
+		msgs[i] = fmt.Sprintf("%s: %v", region, e.Errors[region])
+	}
+	return fmt.Sprintf("Request failed in %d region(s): %s", len(regions), strings.Join(msgs, "; "))
+}
+
+// Each calls fn concurrently for every region, and returns the results in the
+// order of the regions.
+func (m *MultiRegionClient) Each(fn func(region string, client *golangsdk.ServiceClient) (interface{}, error)) []RegionResult {
+	results := make([]RegionResult, len(m.regions))
+
+	var wg sync.WaitGroup
+	for i, region := range m.regions {
+		wg.Add(1)
+		go func(i int, region string) {
+			defer wg.Done()
+			value, err := fn(region, m.clients[region])
+			results[i] = RegionResult{Region: region, Value: value, Err: err}
+		}(i, region)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// List lists a collection in every region and merges the results. list
+// returns the pager of the collection for a client, and extract is the
+// matching extraction function, which must have the signature
+// func(pagination.Page) ([]T, error), e.g. loadbalancers.ExtractLoadBalancers.
+//
+// The items of the regions where the listing succeeded are returned even if
+// it failed in other regions, along with an ErrRegionsFailed.
+func (m *MultiRegionClient) List(list func(*golangsdk.ServiceClient) pagination.Pager, extract interface{}) ([]RegionalItem, error) {
+	extractFn := reflect.ValueOf(extract)
+	if !validExtractor(extractFn) {
+		return nil, fmt.Errorf("extract must be a func(pagination.Page) ([]T, error), got %T", extract)
+	}
+
+	results := m.Each(func(region string, client *golangsdk.ServiceClient) (interface{}, error) {
+		var items []RegionalItem
+		err := list(client).EachPage(func(page pagination.Page) (bool, error) {
+			out := extractFn.Call([]reflect.Value{reflect.ValueOf(page)})
+			if err, _ := out[1].Interface().(error); err != nil {
+				return false, err
+			}
+			for i := 0; i < out[0].Len(); i++ {
+				items = append(items, RegionalItem{Region: region, Item: out[0].Index(i).Interface()})
+			}
+			return true, nil
+		})
+		return items, err
+	})
+
+	var items []RegionalItem
+	failed := make(map[string]error)
+	for _, result := range results {
+		if result.Err != nil {
+			failed[result.Region] = result.Err
+			continue
+		}
+		items = append(items, result.Value.([]RegionalItem)...)
+	}
+	if len(failed) > 0 {
+		return items, ErrRegionsFailed{Errors: failed}
+	}
+	return items, nil
+}
+
+var (
+	pageType  = reflect.TypeOf((*pagination.Page)(nil)).Elem()
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// validExtractor reports whether fn can be called with a pagination.Page and
+// returns a slice and an error, as required by List.
+func validExtractor(fn reflect.Value) bool {
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return false
+	}
+	t := fn.Type()
+	return t.NumIn() == 1 && pageType.AssignableTo(t.In(0)) &&
+		t.NumOut() == 2 && t.Out(0).Kind() == reflect.Slice && t.Out(1) == errorType
+}
+
+// Find looks for a resource in every region with get, e.g. a Get request by
+// ID, and returns it along with its region. Regions answering with a 404 are
+// skipped. If the resource is found in several regions, the first one in the
+// order of the regions is returned.
+func (m *MultiRegionClient) Find(get func(*golangsdk.ServiceClient) (interface{}, error)) (*RegionalItem, error) {
+	results := m.Each(func(region string, client *golangsdk.ServiceClient) (interface{}, error) {
+		return get(client)
+	})
+
+	failed := make(map[string]error)
+	for _, result := range results {
+		if result.Err == nil {
+			return &RegionalItem{Region: result.Region, Item: result.Value}, nil
+		}
+		if _, ok := result.Err.(golangsdk.ErrDefault404); !ok {
+			failed[result.Region] = result.Err
+		}
+	}
+	if len(failed) > 0 {
+		return nil, ErrRegionsFailed{Errors: failed}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
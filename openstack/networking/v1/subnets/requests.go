@@ -172,13 +172,15 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular subnets based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -213,14 +215,16 @@ func Update(c *golangsdk.ServiceClient, vpcid string, id string, opts UpdateOpts
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateURL(c, vpcid, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(updateURL(c, vpcid, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular subnets based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, vpcid string, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(updateURL(c, vpcid, id), nil)
+	resp, err := c.Delete(updateURL(c, vpcid, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
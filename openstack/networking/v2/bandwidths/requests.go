@@ -41,9 +41,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		return
 	}
 
-	_, r.Err = client.Post(PostURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(PostURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -98,9 +99,10 @@ func Insert(client *golangsdk.ServiceClient, bandwidthID string, opts BandWidthI
 		return
 	}
 
-	_, r.Err = client.Post(InsertURL(client, bandwidthID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(InsertURL(client, bandwidthID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -111,9 +113,10 @@ func Remove(client *golangsdk.ServiceClient, bandwidthID string, opts BandWidthR
 		return
 	}
 
-	_, r.Err = client.Post(RemoveURL(client, bandwidthID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(RemoveURL(client, bandwidthID), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -124,15 +127,17 @@ func BatchCreate(client *golangsdk.ServiceClient, opts BatchCreateOptsBuilder) (
 		return
 	}
 
-	_, r.Err = client.Post(BatchPostURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(BatchPostURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, bandwidthID string) (r DeleteResult) {
 	url := DeleteURL(client, bandwidthID)
-	_, r.Err = client.Delete(url, nil)
+	resp, err := client.Delete(url, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -143,7 +148,8 @@ func Update(c *golangsdk.ServiceClient, bandwidthID string, opts UpdateOpts) (in
 		return nil, err
 	}
 
-	_, r.Err = c.Put(UpdateURL(c, bandwidthID), body, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	resp, err := c.Put(UpdateURL(c, bandwidthID), body, &r.Body, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	onDemandData, onDemandErr := r.Extract()
 	orderData, orderErr := r.ExtractOrderID()
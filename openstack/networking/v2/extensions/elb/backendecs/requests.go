@@ -46,7 +46,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder, lId string) (r e
 	log.Printf("[DEBUG] create ELB-BackendECS url:%q, body=%#v", rootURL(c, lId), body)
 
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c, lId), body, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, lId), body, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -76,7 +77,8 @@ func Get(c *golangsdk.ServiceClient, lId string, backendId string) (r GetResult)
 	url += query
 	log.Printf("[DEBUG] get ELB-BackendECS url:%q, backendId:%q", url, backendId)
 
-	_, r.Err = c.Get(url, &r.Body, nil)
+	resp, err := c.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -120,8 +122,9 @@ func Delete(c *golangsdk.ServiceClient, lId string, opts DeleteOpts) (r elb.JobR
 	}
 	log.Printf("[DEBUG] deleting ELB-BackendECS, request opts=%#v", b)
 
-	_, r.Err = c.Post(actionURL(c, lId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(actionURL(c, lId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -46,6 +46,7 @@ func (r JobInfoResult) Extract() (*JobInfo, error) {
 func QueryJobInfo(c *golangsdk.ServiceClient, jobId string) (r JobInfoResult) {
 	e := c.ResourceBaseURL()
 	e = e + c.ProjectID + "/jobs/" + jobId
-	_, r.Err = c.Get(e, &r.Body, nil)
+	resp, err := c.Get(e, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
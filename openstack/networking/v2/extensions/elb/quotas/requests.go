@@ -6,6 +6,7 @@ import (
 
 // Get retrieves a particular Loadbalancer based on its unique ID.
 func Get(c *golangsdk.ServiceClient) (r GetResult) {
-	_, r.Err = c.Get(rootURL(c), &r.Body, nil)
+	resp, err := c.Get(rootURL(c), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
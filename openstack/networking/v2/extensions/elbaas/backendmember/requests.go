@@ -44,9 +44,10 @@ func Add(c *golangsdk.ServiceClient, listener_id string, opts AddOptsBuilder) (r
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(addURL(c, listener_id), a, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(addURL(c, listener_id), a, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -96,14 +97,16 @@ func Remove(c *golangsdk.ServiceClient, listener_id string, id string) (r Remove
 		r.Err = err
 		return
 	} */
-	_, r.Err = c.Post(removeURL(c, listener_id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(removeURL(c, listener_id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular Health Monitor based on its unique ID.
 func Get(c *golangsdk.ServiceClient, listener_id, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, listener_id, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, listener_id, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -39,6 +39,7 @@ func (r JobInfoResult) Extract() (*JobInfo, error) {
 }
 
 func QueryJobInfo(c *golangsdk.ServiceClient, jobId string) (r JobInfoResult) {
-	_, r.Err = c.Get(c.ServiceURL("jobs", jobId), &r.Body, nil)
+	resp, err := c.Get(c.ServiceURL("jobs", jobId), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -12,6 +12,8 @@ const (
 	ProtocolTCP   Protocol = "TCP"
 	ProtocolHTTP  Protocol = "HTTP"
 	ProtocolHTTPS Protocol = "HTTPS"
+	ProtocolSSL   Protocol = "SSL"
+	ProtocolUDP   Protocol = "UDP"
 )
 
 // ListOptsBuilder allows extensions to add additional parameters to the
@@ -150,8 +152,13 @@ type CreateOpts struct {
 	SSLCiphers string `json:"ssl_ciphers,omitempty"`
 }
 
-// ToListenerCreateMap casts a CreateOpts struct to a map.
+// ToListenerCreateMap casts a CreateOpts struct to a map. It returns an
+// ErrInvalidOpts if some options are out of range or don't apply to the
+// protocol.
 func (opts CreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
@@ -168,15 +175,17 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular Listeners based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -189,14 +198,16 @@ type UpdateOptsBuilder interface {
 }
 
 // UpdateOpts is the common options struct used in this package's Update
-// operation.
+// operation. The attributes left unset are not changed, and are sent in the
+// same request otherwise. Pointer fields allow to set a zero value, e.g. to
+// clear the description or disable TCP draining.
 type UpdateOpts struct {
 	// Required.  Specifies the load balancer name.
 	// The name is a string of 1 to 64 characters that consist of letters, digits, underscores (_), and hyphens (-).
 	Name string `json:"name,omitempty"`
 	// Optional. Provides supplementary information about the listener.
 	// The value is a string of 0 to 128 characters and cannot contain angle brackets (<>).
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 	// Required.  Specifies the listening port.
 	// The value ranges from 1 to 65535.
 	ProtocolPort int `json:"port,omitempty"`
@@ -212,11 +223,11 @@ type UpdateOpts struct {
 	// Optional.  Specifies whether to maintain the TCP connection to the backend ECS after the ECS is deleted.
 	// This parameter is valid when protocol is set to TCP.
 	// The value can be true or false.
-	TcpDraining bool `json:"tcp_draining,omitempty"`
+	TcpDraining *bool `json:"tcp_draining,omitempty"`
 	// Optional.  Specifies the timeout duration (minutes) for the TCP connection to the backend ECS after the ECS
 	// is deleted. This parameter is valid when protocol is set to TCP and tcp_draining to true.
 	// The value ranges from 0 to 60.
-	TcpDrainingTimeout int `json:"tcp_draining_timeout,omitempty"`
+	TcpDrainingTimeout *int `json:"tcp_draining_timeout,omitempty"`
 	// Optional.  Specifies the UDP session timeout duration (minutes). This parameter is valid when protocol is set to UDP.
 	// The value ranges from 1 to 1440.
 	UDPTimeout int `json:"udp_timeout,omitempty"`
@@ -240,8 +251,12 @@ type UpdateOpts struct {
 	SSLCiphers string `json:"ssl_ciphers,omitempty"`
 }
 
-// ToListenerUpdateMap casts a UpdateOpts struct to a map.
+// ToListenerUpdateMap casts a UpdateOpts struct to a map. It returns an
+// ErrInvalidOpts if some options are out of range.
 func (opts UpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
 	return golangsdk.BuildRequestBody(opts, "")
 }
 
@@ -252,9 +267,10 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOpts) (r UpdateRes
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -262,8 +278,9 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOpts) (r UpdateRes
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	url := resourceURL(c, id)
 	//fmt.Printf("Delete listener url: %s.\n", url)
-	_, r.Err = c.Delete(url, &golangsdk.RequestOpts{
+	resp, err := c.Delete(url, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,149 @@
+package listeners
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ErrInvalidOpts is returned by Create and Update when options are out of
+// range or don't apply to the protocol of the listener. It lists all the
+// invalid options at once, instead of letting the API reject them one by one.
+type ErrInvalidOpts struct {
+	golangsdk.BaseError
+	Errors []golangsdk.ErrInvalidInput
+}
+
+func (e ErrInvalidOpts) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return "Invalid listener options: " + strings.Join(messages, "; ")
+}
+
+// optsValidator collects the invalid options of a listener.
+type optsValidator struct {
+	errs []golangsdk.ErrInvalidInput
+}
+
+func (v *optsValidator) fail(argument string, value interface{}, format string, a ...interface{}) {
+	err := golangsdk.ErrInvalidInput{}
+	err.Argument = argument
+	err.Value = value
+	err.Info = fmt.Sprintf("%s: %s", argument, fmt.Sprintf(format, a...))
+	v.errs = append(v.errs, err)
+}
+
+func (v *optsValidator) inRange(argument string, value, min, max int) {
+	if value < min || value > max {
+		v.fail(argument, value, "%d is not between %d and %d", value, min, max)
+	}
+}
+
+// onlyFor checks that an option which is set is valid for the protocol.
+func (v *optsValidator) onlyFor(argument string, value interface{}, set bool, protocol Protocol, valid ...Protocol) {
+	if !set {
+		return
+	}
+	for _, p := range valid {
+		if protocol == p {
+			return
+		}
+	}
+	v.fail(argument, value, "only valid for %s listeners, not %s", joinProtocols(valid), protocol)
+}
+
+func (v *optsValidator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return ErrInvalidOpts{Errors: v.errs}
+}
+
+func joinProtocols(protocols []Protocol) string {
+	names := make([]string, len(protocols))
+	for i, p := range protocols {
+		names[i] = string(p)
+	}
+	return strings.Join(names, "/")
+}
+
+func (opts CreateOpts) validate() error {
+	v := new(optsValidator)
+	p := opts.Protocol
+
+	// Missing options are reported by BuildRequestBody.
+	if opts.ProtocolPort != 0 {
+		v.inRange("ProtocolPort", opts.ProtocolPort, 1, 65535)
+	}
+	if opts.BackendProtocolPort != 0 {
+		v.inRange("BackendProtocolPort", opts.BackendProtocolPort, 1, 65535)
+	}
+
+	switch {
+	case p == ProtocolUDP && opts.BackendProtocol != ProtocolUDP:
+		v.fail("BackendProtocol", opts.BackendProtocol, "must be UDP for a UDP listener")
+	case p == ProtocolSSL && opts.BackendProtocol != ProtocolTCP:
+		v.fail("BackendProtocol", opts.BackendProtocol, "must be TCP for an SSL listener")
+	}
+
+	v.onlyFor("TcpTimeout", opts.TcpTimeout, opts.TcpTimeout != 0, p, ProtocolTCP)
+	if opts.TcpTimeout != 0 {
+		v.inRange("TcpTimeout", opts.TcpTimeout, 1, 5)
+	}
+	v.onlyFor("TcpDraining", opts.TcpDraining, opts.TcpDraining, p, ProtocolTCP)
+	if opts.TcpDrainingTimeout != 0 {
+		if !opts.TcpDraining {
+			v.fail("TcpDrainingTimeout", opts.TcpDrainingTimeout, "only valid with TcpDraining")
+		}
+		v.inRange("TcpDrainingTimeout", opts.TcpDrainingTimeout, 0, 60)
+	}
+	v.onlyFor("UDPTimeout", opts.UDPTimeout, opts.UDPTimeout != 0, p, ProtocolUDP)
+	if opts.UDPTimeout != 0 {
+		v.inRange("UDPTimeout", opts.UDPTimeout, 1, 1440)
+	}
+
+	if (p == ProtocolHTTPS || p == ProtocolSSL) && opts.CertificateID == "" {
+		v.fail("CertificateID", opts.CertificateID, "required for the HTTPS and SSL protocols")
+	}
+	v.onlyFor("SSLProtocols", opts.SSLProtocols, opts.SSLProtocols != "", p, ProtocolHTTPS, ProtocolSSL)
+	v.onlyFor("SSLCiphers", opts.SSLCiphers, opts.SSLCiphers != "", p, ProtocolHTTPS, ProtocolSSL)
+
+	v.onlyFor("SessionSticky", opts.SessionSticky, opts.SessionSticky, p, ProtocolHTTP, ProtocolHTTPS, ProtocolTCP)
+	if opts.CookieTimeout != 0 {
+		if p != ProtocolHTTP || !opts.SessionSticky {
+			v.fail("CookieTimeout", opts.CookieTimeout, "only valid for HTTP listeners with SessionSticky")
+		}
+		v.inRange("CookieTimeout", opts.CookieTimeout, 1, 1440)
+	}
+
+	return v.err()
+}
+
+// validate checks the ranges of the options. Whether they apply to the
+// protocol of the listener is left to the API, since it is not part of the
+// update.
+func (opts UpdateOpts) validate() error {
+	v := new(optsValidator)
+	if opts.ProtocolPort != 0 {
+		v.inRange("ProtocolPort", opts.ProtocolPort, 1, 65535)
+	}
+	if opts.BackendProtocolPort != 0 {
+		v.inRange("BackendProtocolPort", opts.BackendProtocolPort, 1, 65535)
+	}
+	if opts.TcpTimeout != 0 {
+		v.inRange("TcpTimeout", opts.TcpTimeout, 1, 5)
+	}
+	if opts.TcpDrainingTimeout != nil {
+		if opts.TcpDraining != nil && !*opts.TcpDraining {
+			v.fail("TcpDrainingTimeout", *opts.TcpDrainingTimeout, "only valid with TcpDraining")
+		}
+		v.inRange("TcpDrainingTimeout", *opts.TcpDrainingTimeout, 0, 60)
+	}
+	if opts.UDPTimeout != 0 {
+		v.inRange("UDPTimeout", opts.UDPTimeout, 1, 1440)
+	}
+	return v.err()
+}
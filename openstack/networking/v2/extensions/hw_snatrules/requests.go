@@ -33,21 +33,24 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(rootURL(c), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get is a method by which can get the detailed information of the specified
 // snat rule.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete is a method by which can be able to delete a snat rule
 func Delete(c *golangsdk.ServiceClient, id, natGatewayID string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURLDelete(c, id, natGatewayID), nil)
+	resp, err := c.Delete(resourceURLDelete(c, id, natGatewayID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -60,6 +60,16 @@ Example to Disassociate a Floating IP with a Port
 		panic(err)
 	}
 
+Example to Ensure a Port Has a Floating IP
+
+	portID := "76911c48-1cde-4cb4-9e5c-a1f3b1a1b3cc"
+	externalNetworkID := "376da547-b977-4cfe-9cba-275c80debf57"
+
+	fip, err := floatingips.EnsureFloatingIP(networkingClient, portID, externalNetworkID)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Floating IP
 
 	fipID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
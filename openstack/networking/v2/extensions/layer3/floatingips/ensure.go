@@ -0,0 +1,44 @@
+package floatingips
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// EnsureFloatingIP returns the floating IP associated with a port. If the port
+// has none, it associates an unassociated floating IP of the external network
+// floatingNetworkID, or creates one on that network if there is none.
+//
+// EnsureFloatingIP is not atomic: a concurrent caller may associate the same
+// unassociated floating IP, in which case one of the associations fails.
+func EnsureFloatingIP(c *golangsdk.ServiceClient, portID, floatingNetworkID string) (*FloatingIP, error) {
+	associated, err := listAll(c, ListOpts{PortID: portID})
+	if err != nil {
+		return nil, err
+	}
+	if len(associated) > 0 {
+		return &associated[0], nil
+	}
+
+	fips, err := listAll(c, ListOpts{FloatingNetworkID: floatingNetworkID})
+	if err != nil {
+		return nil, err
+	}
+	for _, fip := range fips {
+		if fip.PortID == "" {
+			return Update(c, fip.ID, UpdateOpts{PortID: &portID}).Extract()
+		}
+	}
+
+	return Create(c, CreateOpts{
+		FloatingNetworkID: floatingNetworkID,
+		PortID:            portID,
+	}).Extract()
+}
+
+func listAll(c *golangsdk.ServiceClient, opts ListOpts) ([]FloatingIP, error) {
+	allPages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return ExtractFloatingIPs(allPages)
+}
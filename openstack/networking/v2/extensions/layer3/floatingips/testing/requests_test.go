@@ -413,3 +413,71 @@ func TestDelete(t *testing.T) {
 	res := floatingips.Delete(fake.ServiceClient(), "2f245a7b-796b-4f26-9cf9-9e82d248fda7")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestEnsureFloatingIP(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if r.URL.Query().Get("port_id") != "" {
+			fmt.Fprintf(w, `{"floatingips": []}`)
+			return
+		}
+		th.TestFormValues(t, r, map[string]string{"floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57"})
+		fmt.Fprintf(w, `
+{
+    "floatingips": [
+        {
+            "floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+            "floating_ip_address": "172.24.4.229",
+            "port_id": "74a342ce-8e07-4e91-880c-9f834b68fa25",
+            "id": "ada25a95-f321-4f59-b0e0-f3a970dd3d63"
+        },
+        {
+            "floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+            "floating_ip_address": "172.24.4.228",
+            "port_id": null,
+            "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+        }
+    ]
+}
+		`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/floatingips/2f245a7b-796b-4f26-9cf9-9e82d248fda7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+	"floatingip": {
+		"port_id": "423abc8d-2991-4a55-ba98-2aaea84cc72e"
+	}
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+	"floatingip": {
+			"floating_network_id": "376da547-b977-4cfe-9cba-275c80debf57",
+			"floating_ip_address": "172.24.4.228",
+			"port_id": "423abc8d-2991-4a55-ba98-2aaea84cc72e",
+			"id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+	}
+}
+	`)
+	})
+
+	fip, err := floatingips.EnsureFloatingIP(fake.ServiceClient(), "423abc8d-2991-4a55-ba98-2aaea84cc72e", "376da547-b977-4cfe-9cba-275c80debf57")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2f245a7b-796b-4f26-9cf9-9e82d248fda7", fip.ID)
+	th.CheckEquals(t, "423abc8d-2991-4a55-ba98-2aaea84cc72e", fip.PortID)
+}
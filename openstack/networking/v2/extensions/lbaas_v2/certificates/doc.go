@@ -0,0 +1,33 @@
+/*
+Package certificates manages the certificates used by the listeners of the
+LBaaS v2 extension for SSL termination.
+
+Example to Create a Certificate
+
+	createOpts := certificates.CreateOpts{
+		Name:        "www-example-com",
+		Domain:      "www.example.com",
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+	}
+
+	cert, err := certificates.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Certificates Expiring Within 30 Days
+
+	expiring, err := certificates.ListExpiring(networkClient, certificates.ExpiryScanOpts{
+		Days: 30,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, e := range expiring {
+		fmt.Printf("%s (%s) expires on %s, used by listeners %v\n",
+			e.Certificate.Name, e.Subject, e.NotAfter, e.ListenerIDs)
+	}
+*/
+package certificates
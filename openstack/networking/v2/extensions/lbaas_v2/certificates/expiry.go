@@ -0,0 +1,205 @@
+package certificates
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// ExpiryScanOpts configures ListExpiring.
+type ExpiryScanOpts struct {
+	// Days is the number of days from now within which a certificate must
+	// expire to be reported. Certificates already expired are reported too.
+	Days int
+
+	// Parallelism is the number of requests sent concurrently. Defaults to 4.
+	Parallelism int
+}
+
+// ExpiringCertificate is a certificate used by the listeners of load balancers
+// which expires within the scanned period.
+type ExpiringCertificate struct {
+	Certificate Certificate
+
+	// Subject and NotAfter are parsed from the leaf certificate of the PEM data.
+	Subject  string
+	NotAfter time.Time
+
+	// LoadBalancerIDs and ListenerIDs are the load balancers and listeners
+	// using the certificate, either as server, SNI or client CA certificate.
+	LoadBalancerIDs []string
+	ListenerIDs     []string
+}
+
+// certificateUsage records the listeners referencing a certificate.
+type certificateUsage struct {
+	loadBalancerIDs []string
+	listenerIDs     []string
+}
+
+func (u *certificateUsage) add(lbID, listenerID string) {
+	if len(u.loadBalancerIDs) == 0 || u.loadBalancerIDs[len(u.loadBalancerIDs)-1] != lbID {
+		u.loadBalancerIDs = append(u.loadBalancerIDs, lbID)
+	}
+	u.listenerIDs = append(u.listenerIDs, listenerID)
+}
+
+// ListExpiring walks all the load balancers of the region of the client, looks
+// up the certificates referenced by their listeners, and returns those
+// expiring within opts.Days, sorted by expiration date. The listeners of the
+// load balancers, and then the certificates, are fetched concurrently.
+func ListExpiring(c *golangsdk.ServiceClient, opts ExpiryScanOpts) ([]ExpiringCertificate, error) {
+	if opts.Days < 0 {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "certificates.ExpiryScanOpts.Days"
+		err.Value = opts.Days
+		return nil, err
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 4
+	}
+
+	allPages, err := loadbalancers.List(c, loadbalancers.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	lbListeners := make([][]listeners.Listener, len(lbs))
+	err = forEach(len(lbs), parallelism, func(i int) error {
+		return listeners.List(c, listeners.ListOpts{LoadbalancerID: lbs[i].ID}).EachPage(func(page pagination.Page) (bool, error) {
+			l, err := listeners.ExtractListeners(page)
+			if err != nil {
+				return false, err
+			}
+			lbListeners[i] = append(lbListeners[i], l...)
+			return true, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	usages := make(map[string]*certificateUsage)
+	for i, lb := range lbs {
+		for _, l := range lbListeners[i] {
+			refs := append([]string{l.DefaultTlsContainerRef, l.CAContainerRef}, l.SniContainerRefs...)
+			for _, id := range refs {
+				if id == "" {
+					continue
+				}
+				usage, ok := usages[id]
+				if !ok {
+					usage = &certificateUsage{}
+					usages[id] = usage
+					ids = append(ids, id)
+				}
+				usage.add(lb.ID, l.ID)
+			}
+		}
+	}
+
+	deadline := time.Now().AddDate(0, 0, opts.Days)
+	found := make([]*ExpiringCertificate, len(ids))
+	err = forEach(len(ids), parallelism, func(i int) error {
+		cert, err := Get(c, ids[i]).Extract()
+		if err != nil {
+			return err
+		}
+		leaf, err := parseLeaf(cert.Certificate)
+		if err != nil {
+			return fmt.Errorf("Unable to parse certificate %s: %v", cert.ID, err)
+		}
+		if leaf.NotAfter.Before(deadline) {
+			found[i] = &ExpiringCertificate{
+				Certificate:     *cert,
+				Subject:         leaf.Subject.String(),
+				NotAfter:        leaf.NotAfter,
+				LoadBalancerIDs: usages[ids[i]].loadBalancerIDs,
+				ListenerIDs:     usages[ids[i]].listenerIDs,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var expiring []ExpiringCertificate
+	for _, e := range found {
+		if e != nil {
+			expiring = append(expiring, *e)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].NotAfter.Before(expiring[j].NotAfter)
+	})
+	return expiring, nil
+}
+
+// parseLeaf parses the first certificate of PEM data, which is the leaf
+// certificate of a chain.
+func parseLeaf(data string) (*x509.Certificate, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// forEach calls fn for each index below n, running at most parallelism calls
+// concurrently. It returns the first error encountered, and stops starting new
+// calls once one has failed.
+func forEach(n, parallelism int, fn func(i int) error) error {
+	var (
+		wg       sync.WaitGroup
+		mut      sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, parallelism)
+	for i := 0; i < n; i++ {
+		mut.Lock()
+		failed := firstErr != nil
+		mut.Unlock()
+		if failed {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := fn(i); err != nil {
+				mut.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mut.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,2 @@
+// certificates unit tests
+package testing
@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const loadBalancersListBody = `
+{
+	"loadbalancers": [
+		{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "name": "web"},
+		{"id": "123d9a16-7f75-4a91-9c3a-cd9b8b8a0c49", "name": "api"}
+	]
+}`
+
+const webListenersBody = `
+{
+	"listeners": [
+		{
+			"id": "db902c0c-d5ff-4753-b465-668ad9656918",
+			"protocol": "TERMINATED_HTTPS",
+			"loadbalancers": [{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"}],
+			"default_tls_container_ref": "soon",
+			"sni_container_refs": ["later"]
+		},
+		{
+			"id": "5a3d8f2e-bb5a-4c53-8f4c-6c2b7ad0f5b4",
+			"protocol": "TCP",
+			"loadbalancers": [{"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"}]
+		}
+	]
+}`
+
+const apiListenersBody = `
+{
+	"listeners": [
+		{
+			"id": "3ad4e1b2-71c6-43a5-8c5e-0d3d7f1c9e21",
+			"protocol": "TERMINATED_HTTPS",
+			"loadbalancers": [{"id": "123d9a16-7f75-4a91-9c3a-cd9b8b8a0c49"}],
+			"default_tls_container_ref": "soon"
+		}
+	]
+}`
+
+// GenerateCertificate returns a PEM encoded self-signed certificate with the
+// given common name, expiring at notAfter.
+func GenerateCertificate(t *testing.T, commonName string, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	th.AssertNoErr(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	th.AssertNoErr(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// HandleExpiryScanSuccessfully sets up the test server to respond to the
+// requests of a certificate expiry scan. certs maps the certificate IDs to
+// their PEM data.
+func HandleExpiryScanSuccessfully(t *testing.T, certs map[string]string) {
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		if r.Form.Get("marker") != "" {
+			fmt.Fprintf(w, `{"loadbalancers": []}`)
+			return
+		}
+		fmt.Fprintf(w, loadBalancersListBody)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		if r.Form.Get("marker") != "" {
+			fmt.Fprintf(w, `{"listeners": []}`)
+			return
+		}
+		switch r.Form.Get("loadbalancer_id") {
+		case "36e08a3e-a78f-4b40-a229-1e7e23eee1ab":
+			fmt.Fprintf(w, webListenersBody)
+		case "123d9a16-7f75-4a91-9c3a-cd9b8b8a0c49":
+			fmt.Fprintf(w, apiListenersBody)
+		default:
+			t.Errorf("Unexpected listeners request for load balancer %s", r.Form.Get("loadbalancer_id"))
+		}
+	})
+
+	for id, data := range certs {
+		id, data := id, data
+		th.Mux.HandleFunc("/v2.0/lbaas/certificates/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(map[string]string{
+				"id":          id,
+				"type":        "server",
+				"certificate": data,
+			})
+		})
+	}
+}
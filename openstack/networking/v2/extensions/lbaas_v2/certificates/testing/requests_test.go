@@ -0,0 +1,37 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	fake "github.com/huaweicloud/golangsdk/openstack/networking/v2/common"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/certificates"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestListExpiring(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	soon := time.Now().Add(10 * 24 * time.Hour).UTC().Truncate(time.Second)
+	later := time.Now().Add(100 * 24 * time.Hour).UTC().Truncate(time.Second)
+	HandleExpiryScanSuccessfully(t, map[string]string{
+		"soon":  GenerateCertificate(t, "www.example.com", soon),
+		"later": GenerateCertificate(t, "api.example.com", later),
+	})
+
+	actual, err := certificates.ListExpiring(fake.ServiceClient(), certificates.ExpiryScanOpts{Days: 30})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+
+	th.AssertEquals(t, "soon", actual[0].Certificate.ID)
+	th.AssertEquals(t, "CN=www.example.com", actual[0].Subject)
+	th.AssertEquals(t, true, actual[0].NotAfter.Equal(soon))
+	th.CheckDeepEquals(t, []string{"36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "123d9a16-7f75-4a91-9c3a-cd9b8b8a0c49"}, actual[0].LoadBalancerIDs)
+	th.CheckDeepEquals(t, []string{"db902c0c-d5ff-4753-b465-668ad9656918", "3ad4e1b2-71c6-43a5-8c5e-0d3d7f1c9e21"}, actual[0].ListenerIDs)
+
+	actual, err = certificates.ListExpiring(fake.ServiceClient(), certificates.ExpiryScanOpts{Days: 365, Parallelism: 1})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "later", actual[1].Certificate.ID)
+}
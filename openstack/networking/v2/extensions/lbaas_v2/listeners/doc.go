@@ -38,6 +38,37 @@ Example to Create a Listener
 		panic(err)
 	}
 
+Example to Create a Listener Terminating SSL
+
+	// The certificate is referenced by the ref of a key manager certificate
+	// container, see keymanager/v1/containers.CreateCertificate.
+	createOpts := listeners.CreateOpts{
+		Protocol:               listeners.ProtocolTerminatedHTTPS,
+		Name:                   "www",
+		LoadbalancerID:         "79e05663-7f03-45d2-a092-8b94062f22ab",
+		ProtocolPort:           443,
+		DefaultTlsContainerRef: container.ContainerRef,
+	}
+
+	listener, err := listeners.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an HTTP Listener on the Default Port
+
+	createOpts := listeners.CreateOpts{
+		Protocol:       listeners.ProtocolHTTP,
+		Name:           "web",
+		LoadbalancerID: "ca430f80-1737-4712-8dc6-3f640d55594b",
+	}
+
+	// ProtocolPort is set to 80, the default port of HTTP.
+	listener, err := listeners.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Listener
 
 	listenerID := "d67d56a6-4a86-4688-a282-f46444705c64"
@@ -59,5 +90,28 @@ Example to Delete a Listener
 	if err != nil {
 		panic(err)
 	}
+
+Example to Log the Request ID of a Failed Operation
+
+	res := listeners.Delete(networkClient, listenerID)
+	if res.Err != nil {
+		log.Printf("deleting listener %s failed (request %s): %s", listenerID, res.RequestID(), res.Err)
+	}
+
+Example to Redirect HTTP Requests to an HTTPS Listener
+
+	httpListenerID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	httpsListenerID := "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f"
+
+	_, err := listeners.EnableHTTPSRedirect(networkClient, httpListenerID, httpsListenerID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// Serve the HTTP requests again.
+	err = listeners.DisableHTTPSRedirect(networkClient, httpListenerID)
+	if err != nil {
+		panic(err)
+	}
 */
 package listeners
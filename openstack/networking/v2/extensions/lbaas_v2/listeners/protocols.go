@@ -0,0 +1,15 @@
+package listeners
+
+// defaultPorts are the well-known ports of the protocols which have one.
+var defaultPorts = map[Protocol]int{
+	ProtocolHTTP:            80,
+	ProtocolHTTPS:           443,
+	ProtocolTerminatedHTTPS: 443,
+}
+
+// DefaultPort returns the well-known port of a protocol, e.g. 443 for HTTPS.
+// It returns false for the protocols without one, such as TCP.
+func DefaultPort(protocol Protocol) (int, bool) {
+	port, ok := defaultPorts[protocol]
+	return port, ok
+}
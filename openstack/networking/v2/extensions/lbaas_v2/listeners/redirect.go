@@ -0,0 +1,111 @@
+package listeners
+
+import (
+	"fmt"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/l7policies"
+)
+
+// HTTPSRedirectPolicyName is the name of the L7 policy created by
+// EnableHTTPSRedirect.
+const HTTPSRedirectPolicyName = "https-redirect"
+
+// EnableHTTPSRedirect makes an HTTP listener redirect its requests to an
+// HTTPS or TERMINATED_HTTPS listener of the same load balancer, by creating
+// an L7 policy with the REDIRECT_TO_LISTENER action. The protocols of both
+// listeners are checked first, and an ErrInvalidInput is returned if they
+// don't allow the redirection.
+func EnableHTTPSRedirect(c *golangsdk.ServiceClient, listenerID, httpsListenerID string) (r l7policies.CreateResult) {
+	from, err := Get(c, listenerID).Extract()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	to, err := Get(c, httpsListenerID).Extract()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if err := checkHTTPSRedirect(from, to); err != nil {
+		r.Err = err
+		return
+	}
+
+	return l7policies.Create(c, l7policies.CreateOpts{
+		Name:               HTTPSRedirectPolicyName,
+		ListenerID:         listenerID,
+		Action:             l7policies.ActionRedirectToListener,
+		RedirectListenerID: httpsListenerID,
+	})
+}
+
+func checkHTTPSRedirect(from, to *Listener) error {
+	var problem string
+	switch {
+	case Protocol(from.Protocol) != ProtocolHTTP:
+		problem = fmt.Sprintf("listener %s uses %s, only HTTP listeners can be redirected", from.ID, from.Protocol)
+	case Protocol(to.Protocol) != ProtocolHTTPS && Protocol(to.Protocol) != ProtocolTerminatedHTTPS:
+		problem = fmt.Sprintf("listener %s uses %s, redirections must target an HTTPS or TERMINATED_HTTPS listener",
+			to.ID, to.Protocol)
+	case !sameLoadBalancer(from, to):
+		problem = fmt.Sprintf("listeners %s and %s don't belong to the same load balancer", from.ID, to.ID)
+	default:
+		return nil
+	}
+
+	err := golangsdk.ErrInvalidInput{}
+	err.Argument = "httpsListenerID"
+	err.Value = to.ID
+	err.Info = "Cannot redirect to HTTPS: " + problem
+	return err
+}
+
+func sameLoadBalancer(a, b *Listener) bool {
+	for _, x := range a.Loadbalancers {
+		for _, y := range b.Loadbalancers {
+			if x.ID == y.ID {
+				return true
+			}
+		}
+	}
+	// The load balancers of the listeners are not always returned.
+	return len(a.Loadbalancers) == 0 || len(b.Loadbalancers) == 0
+}
+
+// HTTPSRedirect returns the ID of the listener to which a listener redirects
+// its requests, or "" if it doesn't redirect them.
+func HTTPSRedirect(c *golangsdk.ServiceClient, listenerID string) (string, error) {
+	policies, err := redirectPolicies(c, listenerID)
+	if err != nil || len(policies) == 0 {
+		return "", err
+	}
+	return policies[0].RedirectListenerID, nil
+}
+
+// DisableHTTPSRedirect deletes the REDIRECT_TO_LISTENER L7 policies of a
+// listener, so that it serves its requests again.
+func DisableHTTPSRedirect(c *golangsdk.ServiceClient, listenerID string) error {
+	policies, err := redirectPolicies(c, listenerID)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		err := l7policies.Delete(c, policy.ID).ExtractErr()
+		if _, ok := err.(golangsdk.ErrDefault404); err != nil && !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func redirectPolicies(c *golangsdk.ServiceClient, listenerID string) ([]l7policies.L7Policy, error) {
+	allPages, err := l7policies.List(c, l7policies.ListOpts{
+		ListenerID: listenerID,
+		Action:     string(l7policies.ActionRedirectToListener),
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	return l7policies.ExtractL7Policies(allPages)
+}
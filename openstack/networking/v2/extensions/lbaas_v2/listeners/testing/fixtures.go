@@ -180,6 +180,7 @@ func HandleListenerGetSuccessfully(t *testing.T) {
 		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
 		th.TestHeader(t, r, "Accept", "application/json")
 
+		w.Header().Add("X-Openstack-Request-Id", "req-3f9b8c1e-0d2a-4c57-8b6e-1a2b3c4d5e6f")
 		fmt.Fprintf(w, SingleListenerBody)
 	})
 }
@@ -194,6 +195,18 @@ func HandleListenerDeletionSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleListenerDeletionConflict sets up the test server to refuse a listener
+// deletion request, as when the load balancer is still updating.
+func HandleListenerDeletionConflict(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners/4ec89087-d057-4e2c-911f-60a3b47ee304", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("X-Openstack-Request-Id", "req-7c1d2e3f-4a5b-4c6d-9e8f-0a1b2c3d4e5f")
+		w.WriteHeader(http.StatusConflict)
+	})
+}
+
 // HandleListenerUpdateSuccessfully sets up the test server to respond to a listener Update request.
 func HandleListenerUpdateSuccessfully(t *testing.T) {
 	th.Mux.HandleFunc("/v2.0/lbaas/listeners/4ec89087-d057-4e2c-911f-60a3b47ee304", func(w http.ResponseWriter, r *http.Request) {
@@ -211,3 +224,74 @@ func HandleListenerUpdateSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, PostUpdateListenerBody)
 	})
 }
+
+// HTTPListenerBody and HTTPSListenerBody are the canned bodies of the Get
+// requests on two listeners of the same load balancer.
+const HTTPListenerBody = `
+{
+	"listener": {
+		"id": "8c7ee4f3-1b8e-4d49-9a37-5a5b0a1c2d3e",
+		"name": "http",
+		"loadbalancers": [{"id": "53306cda-815d-4354-9444-59e09da9c3c5"}],
+		"protocol": "HTTP",
+		"protocol_port": 80,
+		"admin_state_up": true
+	}
+}
+`
+
+const HTTPSListenerBody = `
+{
+	"listener": {
+		"id": "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f",
+		"name": "https",
+		"loadbalancers": [{"id": "53306cda-815d-4354-9444-59e09da9c3c5"}],
+		"protocol": "TERMINATED_HTTPS",
+		"protocol_port": 443,
+		"admin_state_up": true
+	}
+}
+`
+
+// HandleHTTPSRedirectCreationSuccessfully sets up the test server to respond
+// to the requests enabling the redirection of the HTTP listener to the HTTPS
+// listener.
+func HandleHTTPSRedirectCreationSuccessfully(t *testing.T) {
+	for id, body := range map[string]string{
+		"8c7ee4f3-1b8e-4d49-9a37-5a5b0a1c2d3e": HTTPListenerBody,
+		"0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f": HTTPSListenerBody,
+	} {
+		body := body
+		th.Mux.HandleFunc("/v2.0/lbaas/listeners/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			fmt.Fprintf(w, body)
+		})
+	}
+
+	th.Mux.HandleFunc("/v2.0/lbaas/l7policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"l7policy": {
+				"name": "https-redirect",
+				"listener_id": "8c7ee4f3-1b8e-4d49-9a37-5a5b0a1c2d3e",
+				"action": "REDIRECT_TO_LISTENER",
+				"redirect_listener_id": "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f"
+			}
+		}`)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{
+			"l7policy": {
+				"id": "5e7a1c6b-3d2f-4a8e-9b1c-0d2e3f4a5b6c",
+				"name": "https-redirect",
+				"listener_id": "8c7ee4f3-1b8e-4d49-9a37-5a5b0a1c2d3e",
+				"action": "REDIRECT_TO_LISTENER",
+				"redirect_listener_id": "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f",
+				"admin_state_up": true
+			}
+		}`)
+	})
+}
@@ -95,6 +95,23 @@ func TestRequiredCreateOpts(t *testing.T) {
 	}
 }
 
+func TestCreateListenerDefaultPort(t *testing.T) {
+	b, err := listeners.CreateOpts{
+		LoadbalancerID: "79e05663-7f03-45d2-a092-8b94062f22ab",
+		Protocol:       listeners.ProtocolTerminatedHTTPS,
+	}.ToListenerCreateMap()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, float64(443), b["listener"].(map[string]interface{})["protocol_port"])
+
+	_, err = listeners.CreateOpts{
+		LoadbalancerID: "79e05663-7f03-45d2-a092-8b94062f22ab",
+		Protocol:       listeners.ProtocolTCP,
+	}.ToListenerCreateMap()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
 func TestGetListener(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -118,6 +135,32 @@ func TestDeleteListener(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestGetListenerRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListenerGetSuccessfully(t)
+
+	res := listeners.Get(fake.ServiceClient(), "4ec89087-d057-4e2c-911f-60a3b47ee304")
+	th.AssertNoErr(t, res.Err)
+	th.AssertEquals(t, "req-3f9b8c1e-0d2a-4c57-8b6e-1a2b3c4d5e6f", res.RequestID())
+	th.AssertEquals(t, "req-3f9b8c1e-0d2a-4c57-8b6e-1a2b3c4d5e6f", res.Header.Get("X-Openstack-Request-Id"))
+}
+
+func TestDeleteListenerConflictRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListenerDeletionConflict(t)
+
+	res := listeners.Delete(fake.ServiceClient(), "4ec89087-d057-4e2c-911f-60a3b47ee304")
+	th.AssertEquals(t, "req-7c1d2e3f-4a5b-4c6d-9e8f-0a1b2c3d4e5f", res.RequestID())
+
+	err, ok := res.Err.(golangsdk.ErrUnexpectedResponseCode)
+	if !ok {
+		t.Fatalf("Expected ErrUnexpectedResponseCode, got %T: %v", res.Err, res.Err)
+	}
+	th.AssertEquals(t, "req-7c1d2e3f-4a5b-4c6d-9e8f-0a1b2c3d4e5f", err.RequestID())
+}
+
 func TestUpdateListener(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -135,3 +178,27 @@ func TestUpdateListener(t *testing.T) {
 
 	th.CheckDeepEquals(t, ListenerUpdated, *actual)
 }
+
+func TestEnableHTTPSRedirect(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleHTTPSRedirectCreationSuccessfully(t)
+
+	policy, err := listeners.EnableHTTPSRedirect(fake.ServiceClient(),
+		"8c7ee4f3-1b8e-4d49-9a37-5a5b0a1c2d3e", "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "5e7a1c6b-3d2f-4a8e-9b1c-0d2e3f4a5b6c", policy.ID)
+	th.AssertEquals(t, "0b3d5f6e-2c4a-4b8d-9e1f-7a6b5c4d3e2f", policy.RedirectListenerID)
+}
+
+func TestEnableHTTPSRedirectFromTCPListener(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListenerGetSuccessfully(t)
+
+	id := "4ec89087-d057-4e2c-911f-60a3b47ee304"
+	err := listeners.EnableHTTPSRedirect(fake.ServiceClient(), id, id).Err
+	if _, ok := err.(golangsdk.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %T: %v", err, err)
+	}
+}
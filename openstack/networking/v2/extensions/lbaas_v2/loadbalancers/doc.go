@@ -22,6 +22,39 @@ Example to List Load Balancers
 		fmt.Printf("%+v\n", lb)
 	}
 
+Example to List the Load Balancers of All Projects
+
+	// With an administrator token, List returns the load balancers of all
+	// the projects, which can be filtered by ProjectID.
+	allPages, err := loadbalancers.List(networkClient, loadbalancers.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allLoadbalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	byProject := make(map[string][]loadbalancers.LoadBalancer)
+	for _, lb := range allLoadbalancers {
+		projectID := lb.ProjectID
+		if projectID == "" {
+			projectID = lb.TenantID
+		}
+		byProject[projectID] = append(byProject[projectID], lb)
+	}
+
+Example to Go Through Many Load Balancers Without Holding Them in Memory
+
+	err := loadbalancers.EachLB(networkClient, loadbalancers.ListOpts{}, func(lb loadbalancers.LoadBalancer) error {
+		fmt.Printf("%s %s\n", lb.ID, lb.ProvisioningStatus)
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 Example to Create a Load Balancer
 
 	createOpts := loadbalancers.CreateOpts{
@@ -67,5 +100,143 @@ Example to Get the Status of a Load Balancer
 	if err != nil {
 		panic(err)
 	}
+
+Example to Suspend a Load Balancer
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	lb, err := loadbalancers.Suspend(networkClient, lbID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Load Balancer and Wait Until It Is Active
+
+	op := loadbalancers.Create(networkClient, createOpts).Operation(networkClient)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	lb, err := op.Wait(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Report the Progress of a Load Balancer Creation
+
+	op := loadbalancers.Create(networkClient, createOpts).Operation(networkClient)
+	op.Backoff = &golangsdk.Backoff{
+		Initial: 2 * time.Second,
+		Max:     30 * time.Second,
+		Jitter:  0.2,
+	}
+	op.Observer = func(e golangsdk.WaitEvent) {
+		fmt.Printf("[%s] poll %d: %s\n", e.Elapsed.Round(time.Second), e.Attempt, e.Status)
+	}
+
+	lb, err := op.Wait(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Clone a Load Balancer in Another Region
+
+	spec, err := loadbalancers.Export(networkClient, "d67d56a6-4a86-4688-a282-f46444705c64")
+	if err != nil {
+		panic(err)
+	}
+
+	spec.VipSubnetID = "1981f108-3c48-48d2-b908-30f7d28532c9"
+	spec.VipAddress = ""
+
+	lb, err := loadbalancers.CreateFromSpec(context.Background(), otherRegionClient, *spec)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Reconcile a Load Balancer with a Desired Spec
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+
+	actual, err := loadbalancers.Export(networkClient, lbID)
+	if err != nil {
+		panic(err)
+	}
+
+	changes := loadbalancers.Diff(desired, *actual)
+	if !changes.IsEmpty() {
+		err = loadbalancers.Apply(context.Background(), networkClient, lbID, changes)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+Example to Report the Offline and Draining Members of Load Balancers
+
+	report, err := loadbalancers.HealthReport(ctx, networkClient, loadbalancers.HealthReportOpts{
+		ListOpts: loadbalancers.ListOpts{VipSubnetID: "9cedb85d-0759-4898-8a4b-fa5a5ea10086"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, lb := range report.Unhealthy() {
+		if lb.Err != nil {
+			fmt.Printf("%s: %v\n", lb.Name, lb.Err)
+			continue
+		}
+		fmt.Printf("%s: %d offline, %d draining of %d members\n",
+			lb.Name, len(lb.Offline), len(lb.Draining), lb.Members)
+	}
+
+Example to Preview the Update of a Load Balancer
+
+	previewClient := *networkClient
+	previewClient.DryRun = true
+
+	updateOpts := loadbalancers.UpdateOpts{
+		Name: "new-name",
+	}
+
+	err := loadbalancers.Update(&previewClient, lbID, updateOpts).Err
+	if preview, ok := err.(golangsdk.ErrDryRun); ok {
+		fmt.Printf("%s %s\n%s\n", preview.Method, preview.URL, preview.Body)
+	}
+
+Example to Check Whether a Load Balancer Accepts Changes
+
+	lb, err := loadbalancers.Get(networkClient, lbID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	status := loadbalancers.ProvisioningStatus(lb.ProvisioningStatus)
+	switch {
+	case status.CanUpdate():
+		// Apply the change.
+	case status.IsTransient():
+		// Retry once the current change has completed.
+	default:
+		fmt.Printf("load balancer %s is in %s status\n", lb.ID, status)
+	}
+
+Example to Replace the Members of a Pool, One at a Time
+
+	lbID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	poolID := "9d8c7a0e-4c3b-4a5e-8f2b-8d6c7e1f0a3b"
+	newMembers := []pools.CreateMemberOpts{
+		{Address: "192.168.2.14", ProtocolPort: 80},
+		{Address: "192.168.2.15", ProtocolPort: 80},
+	}
+
+	err := loadbalancers.RollMembers(context.Background(), networkClient, lbID, poolID, newMembers, loadbalancers.RollStrategy{
+		BatchSize: 1,
+		Drain:     pools.DrainOpts{Timeout: time.Minute},
+		Observer: func(e loadbalancers.RollEvent) {
+			fmt.Printf("batch %d/%d: %s %s\n", e.Batch+1, e.Batches, e.Phase, e.Member.Address)
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
 */
 package loadbalancers
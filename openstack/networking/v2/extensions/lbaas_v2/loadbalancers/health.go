@@ -0,0 +1,172 @@
+package loadbalancers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// NodeHealth is a member of a pool of a load balancer, as reported by its
+// status tree.
+type NodeHealth struct {
+	ListenerID      string
+	PoolID          string
+	MemberID        string
+	Name            string
+	Address         string
+	ProtocolPort    int
+	OperatingStatus string
+}
+
+// LoadBalancerHealth summarizes the health of the members of a load balancer.
+type LoadBalancerHealth struct {
+	ID              string
+	Name            string
+	OperatingStatus string
+
+	// Members is the number of distinct members of the pools of the load
+	// balancer.
+	Members int
+
+	// Offline are the members whose operating status is OFFLINE or ERROR.
+	Offline []NodeHealth
+
+	// Draining are the members whose operating status is DRAINING.
+	Draining []NodeHealth
+
+	// Err is set when the status tree of the load balancer could not be
+	// retrieved, in which case the other fields are those of the listing.
+	Err error
+}
+
+// Healthy reports whether the status of the load balancer was retrieved and
+// none of its members are offline or draining.
+func (h LoadBalancerHealth) Healthy() bool {
+	return h.Err == nil && len(h.Offline) == 0 && len(h.Draining) == 0
+}
+
+// HealthSummary is the report returned by HealthReport, with one entry per
+// load balancer in the order of the listing.
+type HealthSummary struct {
+	LoadBalancers []LoadBalancerHealth
+}
+
+// Unhealthy returns the load balancers which have offline or draining
+// members, or whose status could not be retrieved.
+func (s HealthSummary) Unhealthy() []LoadBalancerHealth {
+	var unhealthy []LoadBalancerHealth
+	for _, h := range s.LoadBalancers {
+		if !h.Healthy() {
+			unhealthy = append(unhealthy, h)
+		}
+	}
+	return unhealthy
+}
+
+// HealthReportOpts configures HealthReport.
+type HealthReportOpts struct {
+	// ListOpts selects the load balancers to report on.
+	ListOpts ListOptsBuilder
+
+	// Parallelism is the number of status trees fetched concurrently.
+	// Defaults to 8.
+	Parallelism int
+}
+
+// HealthReport lists the load balancers matching opts.ListOpts, and fetches
+// their status trees concurrently to report the members that are offline or
+// draining. A load balancer whose status tree could not be retrieved is
+// reported with its Err set, without failing the whole report. An error is
+// returned if the listing fails or ctx is done before the report completes.
+func HealthReport(ctx context.Context, c *golangsdk.ServiceClient, opts HealthReportOpts) (*HealthSummary, error) {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 8
+	}
+
+	allPages, err := List(c, opts.ListOpts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &HealthSummary{LoadBalancers: make([]LoadBalancerHealth, len(lbs))}
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, lb := range lbs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int, lb LoadBalancer) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			summary.LoadBalancers[i] = loadBalancerHealth(c, lb)
+		}(i, lb)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func loadBalancerHealth(c *golangsdk.ServiceClient, lb LoadBalancer) LoadBalancerHealth {
+	health := LoadBalancerHealth{
+		ID:              lb.ID,
+		Name:            lb.Name,
+		OperatingStatus: lb.OperatingStatus,
+	}
+
+	tree, err := GetStatuses(c, lb.ID).Extract()
+	if err != nil {
+		health.Err = err
+		return health
+	}
+	if tree == nil || tree.Loadbalancer == nil {
+		return health
+	}
+	health.OperatingStatus = tree.Loadbalancer.OperatingStatus
+
+	// Pools shared by several listeners appear once per listener in the tree.
+	seen := make(map[string]bool)
+	for _, listener := range tree.Loadbalancer.Listeners {
+		for _, pool := range listener.Pools {
+			for _, member := range pool.Members {
+				if seen[member.ID] {
+					continue
+				}
+				seen[member.ID] = true
+				health.Members++
+
+				node := NodeHealth{
+					ListenerID:      listener.ID,
+					PoolID:          pool.ID,
+					MemberID:        member.ID,
+					Name:            member.Name,
+					Address:         member.Address,
+					ProtocolPort:    member.ProtocolPort,
+					OperatingStatus: member.OperatingStatus,
+				}
+				switch member.OperatingStatus {
+				case "OFFLINE", "ERROR":
+					health.Offline = append(health.Offline, node)
+				case "DRAINING":
+					health.Draining = append(health.Draining, node)
+				}
+			}
+		}
+	}
+	return health
+}
@@ -0,0 +1,144 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// DefaultPollInterval is the default time between two polls of an Operation.
+const DefaultPollInterval = 5 * time.Second
+
+// ErrProvisioningFailed is returned by an Operation when the load balancer
+// reaches the ERROR provisioning status.
+type ErrProvisioningFailed struct {
+	golangsdk.BaseError
+	LoadBalancer *LoadBalancer
+}
+
+func (e ErrProvisioningFailed) Error() string {
+	return fmt.Sprintf("Load balancer [%s] went into ERROR provisioning status", e.LoadBalancer.ID)
+}
+
+// Operation tracks an asynchronous change of a load balancer. The change is
+// complete when the load balancer reaches the ACTIVE or ERROR provisioning
+// status or, for a deletion, when the load balancer is gone.
+type Operation struct {
+	// Interval is the time between two polls in Wait. It defaults to
+	// DefaultPollInterval.
+	Interval time.Duration
+
+	// Backoff, if set, replaces the fixed Interval between two polls in Wait.
+	Backoff *golangsdk.Backoff
+
+	// Observer, if set, is called after every poll in Wait, with the
+	// provisioning status of the load balancer.
+	Observer golangsdk.WaitObserver
+
+	client   *golangsdk.ServiceClient
+	id       string
+	deleting bool
+	done     bool
+	lb       *LoadBalancer
+	err      error
+}
+
+// Operation returns a handle on the asynchronous creation of the load balancer.
+func (r CreateResult) Operation(c *golangsdk.ServiceClient) *Operation {
+	return newOperation(c, r.commonResult)
+}
+
+// Operation returns a handle on the asynchronous update of the load balancer.
+func (r UpdateResult) Operation(c *golangsdk.ServiceClient) *Operation {
+	return newOperation(c, r.commonResult)
+}
+
+func newOperation(c *golangsdk.ServiceClient, r commonResult) *Operation {
+	op := &Operation{client: c}
+	if r.Err != nil {
+		op.done, op.err = true, r.Err
+		return op
+	}
+	op.lb, op.err = r.Extract()
+	if op.err != nil || op.lb == nil {
+		op.done = true
+		return op
+	}
+	op.id = op.lb.ID
+	op.update(op.lb)
+	return op
+}
+
+// DeleteOperation deletes the load balancer and returns a handle on its
+// asynchronous removal.
+func DeleteOperation(c *golangsdk.ServiceClient, id string) *Operation {
+	op := &Operation{client: c, id: id, deleting: true}
+	if err := Delete(c, id).ExtractErr(); err != nil {
+		op.done, op.err = true, err
+	}
+	return op
+}
+
+func (op *Operation) update(lb *LoadBalancer) {
+	op.lb = lb
+	switch ProvisioningStatus(lb.ProvisioningStatus) {
+	case StatusActive:
+		op.done = !op.deleting
+	case StatusError:
+		op.done = true
+		op.err = ErrProvisioningFailed{LoadBalancer: lb}
+	}
+}
+
+// Done reports whether the operation has completed, as of the last poll.
+func (op *Operation) Done() bool {
+	return op.done
+}
+
+// Poll fetches the load balancer once and updates the state of the operation.
+// It returns the latest known load balancer, which is nil once a deletion has
+// completed.
+func (op *Operation) Poll() (*LoadBalancer, error) {
+	if op.done {
+		return op.lb, op.err
+	}
+
+	lb, err := Get(op.client, op.id).Extract()
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok && op.deleting {
+			op.done, op.lb = true, nil
+			return nil, nil
+		}
+		return op.lb, err
+	}
+
+	op.update(lb)
+	return op.lb, op.err
+}
+
+// Wait polls the load balancer until the operation completes or ctx is done,
+// and returns the final load balancer.
+func (op *Operation) Wait(ctx context.Context) (*LoadBalancer, error) {
+	interval := op.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	backoff := golangsdk.Backoff{Initial: interval, Max: interval, Multiplier: 1}
+	if op.Backoff != nil {
+		backoff = *op.Backoff
+	}
+
+	var lb *LoadBalancer
+	err := golangsdk.WaitForBackoff(ctx, backoff, func() (bool, string, error) {
+		var err error
+		lb, err = op.Poll()
+		status := string(StatusDeleted)
+		if lb != nil {
+			status = lb.ProvisioningStatus
+		}
+		return op.done, status, err
+	}, op.Observer)
+	return lb, err
+}
@@ -0,0 +1,506 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// ChangeAction is the kind of an entry of a ChangeSet.
+type ChangeAction string
+
+const (
+	ChangeCreate ChangeAction = "create"
+	ChangeUpdate ChangeAction = "update"
+	ChangeDelete ChangeAction = "delete"
+)
+
+// ChangeSet is the list of changes turning a live load balancer into a
+// desired LoadBalancerSpec, as computed by Diff and carried out by Apply.
+type ChangeSet struct {
+	LoadBalancer *LoadBalancerChange `json:"loadbalancer,omitempty"`
+	Listeners    []ListenerChange    `json:"listeners,omitempty"`
+	Pools        []PoolChange        `json:"pools,omitempty"`
+	Members      []MemberChange      `json:"members,omitempty"`
+}
+
+// IsEmpty reports whether the ChangeSet holds no change.
+func (cs ChangeSet) IsEmpty() bool {
+	return cs.LoadBalancer == nil && len(cs.Listeners) == 0 && len(cs.Pools) == 0 && len(cs.Members) == 0
+}
+
+// LoadBalancerChange updates the attributes of the load balancer itself.
+type LoadBalancerChange struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	AdminStateUp bool   `json:"admin_state_up"`
+}
+
+// ListenerChange creates, updates or deletes the listener on
+// Spec.ProtocolPort. Spec is the desired listener, or the live one for a
+// deletion. A created listener comes with its whitelist and default pool.
+type ListenerChange struct {
+	Action ChangeAction `json:"action"`
+	Spec   ListenerSpec `json:"spec"`
+}
+
+// PoolRef identifies a pool of a LoadBalancerSpec: the default pool of the
+// listener on ListenerPort if it is set, or else the load balancer pool named
+// Name.
+type PoolRef struct {
+	ListenerPort int    `json:"listener_port,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+func (ref PoolRef) String() string {
+	if ref.ListenerPort != 0 {
+		return fmt.Sprintf("default pool of listener %d", ref.ListenerPort)
+	}
+	return ref.Name
+}
+
+// PoolChange creates, updates or deletes a pool. Spec is the desired pool, or
+// the live one for a deletion. A created pool comes with its members and
+// health monitor.
+type PoolChange struct {
+	Action ChangeAction `json:"action"`
+	Pool   PoolRef      `json:"pool"`
+	Spec   PoolSpec     `json:"spec"`
+}
+
+// MemberChange creates, updates or deletes the member of a pool with the
+// address and port of Spec.
+type MemberChange struct {
+	Action ChangeAction `json:"action"`
+	Pool   PoolRef      `json:"pool"`
+	Spec   MemberSpec   `json:"spec"`
+}
+
+// Diff compares a desired LoadBalancerSpec with the actual one, usually
+// obtained from Export, and returns the changes to apply.
+//
+// Listeners are matched by port, load balancer pools by name and members by
+// address and port. A listener, pool or member whose protocol or subnet
+// changes is deleted and created again. Whitelists and health monitors of
+// existing resources, as well as the VIP, are not compared.
+func Diff(desired, actual LoadBalancerSpec) ChangeSet {
+	var cs ChangeSet
+
+	if desired.Name != actual.Name || desired.Description != actual.Description ||
+		desired.AdminStateUp != actual.AdminStateUp {
+		cs.LoadBalancer = &LoadBalancerChange{
+			Name:         desired.Name,
+			Description:  desired.Description,
+			AdminStateUp: desired.AdminStateUp,
+		}
+	}
+
+	wantedListeners := make(map[int]bool, len(desired.Listeners))
+	for _, d := range desired.Listeners {
+		wantedListeners[d.ProtocolPort] = true
+	}
+	liveListeners := make(map[int]ListenerSpec, len(actual.Listeners))
+	for _, a := range actual.Listeners {
+		liveListeners[a.ProtocolPort] = a
+		if !wantedListeners[a.ProtocolPort] {
+			cs.Listeners = append(cs.Listeners, ListenerChange{Action: ChangeDelete, Spec: a})
+		}
+	}
+	for _, d := range desired.Listeners {
+		a, ok := liveListeners[d.ProtocolPort]
+		switch {
+		case !ok:
+			cs.Listeners = append(cs.Listeners, ListenerChange{Action: ChangeCreate, Spec: d})
+		case a.Protocol != d.Protocol:
+			cs.Listeners = append(cs.Listeners,
+				ListenerChange{Action: ChangeDelete, Spec: a},
+				ListenerChange{Action: ChangeCreate, Spec: d})
+		default:
+			if listenerChanged(d, a) {
+				cs.Listeners = append(cs.Listeners, ListenerChange{Action: ChangeUpdate, Spec: d})
+			}
+			cs.diffPool(PoolRef{ListenerPort: d.ProtocolPort}, d.DefaultPool, a.DefaultPool)
+		}
+	}
+
+	wantedPools := make(map[string]bool, len(desired.Pools))
+	for _, d := range desired.Pools {
+		wantedPools[d.Name] = true
+	}
+	livePools := make(map[string]*PoolSpec, len(actual.Pools))
+	for i, a := range actual.Pools {
+		livePools[a.Name] = &actual.Pools[i]
+		if !wantedPools[a.Name] {
+			cs.Pools = append(cs.Pools, PoolChange{Action: ChangeDelete, Pool: PoolRef{Name: a.Name}, Spec: a})
+		}
+	}
+	for i, d := range desired.Pools {
+		cs.diffPool(PoolRef{Name: d.Name}, &desired.Pools[i], livePools[d.Name])
+	}
+
+	return cs
+}
+
+func listenerChanged(d, a ListenerSpec) bool {
+	return d.Name != a.Name || d.Description != a.Description || d.AdminStateUp != a.AdminStateUp ||
+		d.ConnLimit != a.ConnLimit || d.Http2Enable != a.Http2Enable ||
+		d.DefaultTlsContainerRef != a.DefaultTlsContainerRef || d.CAContainerRef != a.CAContainerRef ||
+		d.TlsCiphersPolicy != a.TlsCiphersPolicy || !reflect.DeepEqual(d.SniContainerRefs, a.SniContainerRefs)
+}
+
+func (cs *ChangeSet) diffPool(ref PoolRef, d, a *PoolSpec) {
+	switch {
+	case d == nil && a == nil:
+		return
+	case d == nil:
+		cs.Pools = append(cs.Pools, PoolChange{Action: ChangeDelete, Pool: ref, Spec: *a})
+		return
+	case a == nil:
+		cs.Pools = append(cs.Pools, PoolChange{Action: ChangeCreate, Pool: ref, Spec: *d})
+		return
+	case d.Protocol != a.Protocol:
+		cs.Pools = append(cs.Pools,
+			PoolChange{Action: ChangeDelete, Pool: ref, Spec: *a},
+			PoolChange{Action: ChangeCreate, Pool: ref, Spec: *d})
+		return
+	}
+
+	if d.Name != a.Name || d.Description != a.Description || d.LBMethod != a.LBMethod ||
+		d.AdminStateUp != a.AdminStateUp || !reflect.DeepEqual(d.Persistence, a.Persistence) {
+		cs.Pools = append(cs.Pools, PoolChange{Action: ChangeUpdate, Pool: ref, Spec: *d})
+	}
+
+	wanted := make(map[string]bool, len(d.Members))
+	for _, m := range d.Members {
+		wanted[memberKey(m.Address, m.ProtocolPort)] = true
+	}
+	live := make(map[string]MemberSpec, len(a.Members))
+	for _, m := range a.Members {
+		key := memberKey(m.Address, m.ProtocolPort)
+		live[key] = m
+		if !wanted[key] {
+			cs.Members = append(cs.Members, MemberChange{Action: ChangeDelete, Pool: ref, Spec: m})
+		}
+	}
+	for _, m := range d.Members {
+		l, ok := live[memberKey(m.Address, m.ProtocolPort)]
+		switch {
+		case !ok:
+			cs.Members = append(cs.Members, MemberChange{Action: ChangeCreate, Pool: ref, Spec: m})
+		case l.SubnetID != m.SubnetID:
+			cs.Members = append(cs.Members,
+				MemberChange{Action: ChangeDelete, Pool: ref, Spec: l},
+				MemberChange{Action: ChangeCreate, Pool: ref, Spec: m})
+		case l.Name != m.Name || weightChanged(l.Weight, m.Weight) || l.AdminStateUp != m.AdminStateUp:
+			cs.Members = append(cs.Members, MemberChange{Action: ChangeUpdate, Pool: ref, Spec: m})
+		}
+	}
+}
+
+// weightChanged returns whether the desired weight of a member differs from
+// the actual one. A nil desired weight keeps the actual one.
+func weightChanged(actual, desired *int) bool {
+	return desired != nil && (actual == nil || *actual != *desired)
+}
+
+func memberKey(address string, port int) string {
+	return fmt.Sprintf("%s:%d", address, port)
+}
+
+// Apply carries out a ChangeSet on the load balancer with the given ID, one
+// call at a time, waiting for the load balancer to be ACTIVE between calls.
+// It stops at the first error, or when ctx is done.
+func Apply(ctx context.Context, c *golangsdk.ServiceClient, id string, cs ChangeSet) error {
+	if cs.LoadBalancer != nil {
+		adminStateUp := cs.LoadBalancer.AdminStateUp
+		_, err := Update(c, id, UpdateOpts{
+			Name:         cs.LoadBalancer.Name,
+			Description:  cs.LoadBalancer.Description,
+			AdminStateUp: &adminStateUp,
+		}).Extract()
+		if err != nil {
+			return err
+		}
+		if err := waitForActive(ctx, c, id); err != nil {
+			return err
+		}
+	}
+
+	if len(cs.Listeners) == 0 && len(cs.Pools) == 0 && len(cs.Members) == 0 {
+		return nil
+	}
+
+	state, err := loadLiveState(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	for _, change := range cs.Listeners {
+		if err := state.applyListenerChange(change); err != nil {
+			return err
+		}
+	}
+	for _, change := range cs.Pools {
+		if err := state.applyPoolChange(change); err != nil {
+			return err
+		}
+	}
+	for _, change := range cs.Members {
+		if err := state.applyMemberChange(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// liveState maps the references of a ChangeSet to the IDs of the live
+// resources.
+type liveState struct {
+	ctx       context.Context
+	client    *golangsdk.ServiceClient
+	lbID      string
+	listeners map[int]listeners.Listener
+	pools     map[string]pools.Pool
+	members   map[string][]pools.Member
+}
+
+func loadLiveState(ctx context.Context, c *golangsdk.ServiceClient, id string) (*liveState, error) {
+	s := &liveState{
+		ctx:       ctx,
+		client:    c,
+		lbID:      id,
+		listeners: make(map[int]listeners.Listener),
+		pools:     make(map[string]pools.Pool),
+		members:   make(map[string][]pools.Member),
+	}
+
+	allPages, err := listeners.List(c, listeners.ListOpts{LoadbalancerID: id}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range allListeners {
+		s.listeners[listener.ProtocolPort] = listener
+	}
+
+	allPages, err = pools.List(c, pools.ListOpts{LoadbalancerID: id}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range allPools {
+		s.pools[pool.ID] = pool
+	}
+
+	return s, nil
+}
+
+func (s *liveState) listener(port int) (listeners.Listener, error) {
+	listener, ok := s.listeners[port]
+	if !ok {
+		return listener, golangsdk.ErrResourceNotFound{Name: fmt.Sprintf("port %d", port), ResourceType: "listener"}
+	}
+	return listener, nil
+}
+
+func (s *liveState) pool(ref PoolRef) (pools.Pool, error) {
+	if ref.ListenerPort != 0 {
+		listener, err := s.listener(ref.ListenerPort)
+		if err != nil {
+			return pools.Pool{}, err
+		}
+		if pool, ok := s.pools[listener.DefaultPoolID]; ok {
+			return pool, nil
+		}
+	} else {
+		defaultPools := make(map[string]bool, len(s.listeners))
+		for _, listener := range s.listeners {
+			defaultPools[listener.DefaultPoolID] = true
+		}
+		for _, pool := range s.pools {
+			if pool.Name == ref.Name && !defaultPools[pool.ID] {
+				return pool, nil
+			}
+		}
+	}
+	return pools.Pool{}, golangsdk.ErrResourceNotFound{Name: ref.String(), ResourceType: "pool"}
+}
+
+func (s *liveState) member(poolID string, spec MemberSpec) (pools.Member, error) {
+	members, ok := s.members[poolID]
+	if !ok {
+		allPages, err := pools.ListMembers(s.client, poolID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return pools.Member{}, err
+		}
+		members, err = pools.ExtractMembers(allPages)
+		if err != nil {
+			return pools.Member{}, err
+		}
+		s.members[poolID] = members
+	}
+
+	for _, member := range members {
+		if member.Address == spec.Address && member.ProtocolPort == spec.ProtocolPort {
+			return member, nil
+		}
+	}
+	return pools.Member{}, golangsdk.ErrResourceNotFound{
+		Name:         memberKey(spec.Address, spec.ProtocolPort),
+		ResourceType: "member",
+	}
+}
+
+func (s *liveState) applyListenerChange(change ListenerChange) error {
+	spec := change.Spec
+	if change.Action == ChangeCreate {
+		return createListenerFromSpec(s.ctx, s.client, s.lbID, spec)
+	}
+
+	listener, err := s.listener(spec.ProtocolPort)
+	if err != nil {
+		return err
+	}
+
+	if change.Action == ChangeDelete {
+		if pool, ok := s.pools[listener.DefaultPoolID]; ok {
+			if err := s.deletePool(pool); err != nil {
+				return err
+			}
+		}
+		if err := listeners.Delete(s.client, listener.ID).ExtractErr(); err != nil {
+			return err
+		}
+		delete(s.listeners, spec.ProtocolPort)
+		return waitForActive(s.ctx, s.client, s.lbID)
+	}
+
+	adminStateUp := spec.AdminStateUp
+	connLimit := spec.ConnLimit
+	http2Enable := spec.Http2Enable
+	_, err = listeners.Update(s.client, listener.ID, listeners.UpdateOpts{
+		Name:                   spec.Name,
+		Description:            spec.Description,
+		ConnLimit:              &connLimit,
+		Http2Enable:            &http2Enable,
+		DefaultTlsContainerRef: spec.DefaultTlsContainerRef,
+		SniContainerRefs:       spec.SniContainerRefs,
+		CAContainerRef:         spec.CAContainerRef,
+		TlsCiphersPolicy:       spec.TlsCiphersPolicy,
+		AdminStateUp:           &adminStateUp,
+	}).Extract()
+	if err != nil {
+		return err
+	}
+	return waitForActive(s.ctx, s.client, s.lbID)
+}
+
+func (s *liveState) applyPoolChange(change PoolChange) error {
+	if change.Action == ChangeCreate {
+		opts := pools.CreateOpts{LoadbalancerID: s.lbID}
+		if change.Pool.ListenerPort != 0 {
+			listener, err := s.listener(change.Pool.ListenerPort)
+			if err != nil {
+				return err
+			}
+			opts = pools.CreateOpts{ListenerID: listener.ID}
+		}
+		return createPoolFromSpec(s.ctx, s.client, s.lbID, opts, change.Spec)
+	}
+
+	pool, err := s.pool(change.Pool)
+	if err != nil {
+		return err
+	}
+
+	if change.Action == ChangeDelete {
+		return s.deletePool(pool)
+	}
+
+	adminStateUp := change.Spec.AdminStateUp
+	_, err = pools.Update(s.client, pool.ID, pools.UpdateOpts{
+		Name:             change.Spec.Name,
+		Description:      change.Spec.Description,
+		LBMethod:         pools.LBMethod(change.Spec.LBMethod),
+		AdminStateUp:     &adminStateUp,
+		Persistence:      change.Spec.Persistence,
+		ClearPersistence: change.Spec.Persistence == nil && pool.Persistence.Type != "",
+	}).Extract()
+	if err != nil {
+		return err
+	}
+	return waitForActive(s.ctx, s.client, s.lbID)
+}
+
+func (s *liveState) deletePool(pool pools.Pool) error {
+	if pool.MonitorID != "" {
+		if err := monitors.Delete(s.client, pool.MonitorID).ExtractErr(); err != nil {
+			return err
+		}
+		if err := waitForActive(s.ctx, s.client, s.lbID); err != nil {
+			return err
+		}
+	}
+	if err := pools.Delete(s.client, pool.ID).ExtractErr(); err != nil {
+		return err
+	}
+	delete(s.pools, pool.ID)
+	return waitForActive(s.ctx, s.client, s.lbID)
+}
+
+func (s *liveState) applyMemberChange(change MemberChange) error {
+	pool, err := s.pool(change.Pool)
+	if err != nil {
+		return err
+	}
+	spec := change.Spec
+
+	switch change.Action {
+	case ChangeCreate:
+		adminStateUp := spec.AdminStateUp
+		_, err = pools.CreateMember(s.client, pool.ID, pools.CreateMemberOpts{
+			Address:      spec.Address,
+			ProtocolPort: spec.ProtocolPort,
+			Name:         spec.Name,
+			Weight:       spec.Weight,
+			SubnetID:     spec.SubnetID,
+			AdminStateUp: &adminStateUp,
+		}).Extract()
+	case ChangeDelete:
+		var member pools.Member
+		member, err = s.member(pool.ID, spec)
+		if err == nil {
+			err = pools.DeleteMember(s.client, pool.ID, member.ID).ExtractErr()
+		}
+	default:
+		var member pools.Member
+		member, err = s.member(pool.ID, spec)
+		if err == nil {
+			opts := pools.UpdateMemberOpts{
+				Name:      spec.Name,
+				Weight:    spec.Weight,
+				Condition: pools.MemberConditionEnabled,
+			}
+			if !spec.AdminStateUp {
+				opts.Condition = pools.MemberConditionDisabled
+			} else if spec.Weight != nil && *spec.Weight == 0 {
+				opts.Condition = pools.MemberConditionDraining
+			}
+			_, err = pools.UpdateMember(s.client, pool.ID, member.ID, opts).Extract()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return waitForActive(s.ctx, s.client, s.lbID)
+}
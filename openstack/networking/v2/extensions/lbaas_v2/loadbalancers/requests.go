@@ -27,6 +27,7 @@ type ListOpts struct {
 	VipAddress         string `q:"vip_address"`
 	VipPortID          string `q:"vip_port_id"`
 	VipSubnetID        string `q:"vip_subnet_id"`
+	VipNetworkID       string `q:"vip_network_id"`
 	ID                 string `q:"id"`
 	OperatingStatus    string `q:"operating_status"`
 	Name               string `q:"name"`
@@ -79,10 +80,19 @@ type CreateOpts struct {
 	// Human-readable description for the Loadbalancer.
 	Description string `json:"description,omitempty"`
 
-	// The network on which to allocate the Loadbalancer's address. A tenant can
+	// The subnet on which to allocate the Loadbalancer's address. A tenant can
 	// only create Loadbalancers on networks authorized by policy (e.g. networks
 	// that belong to them or networks that are shared).
-	VipSubnetID string `json:"vip_subnet_id" required:"true"`
+	// One of VipSubnetID, VipNetworkID or VipPortID must be provided.
+	VipSubnetID string `json:"vip_subnet_id,omitempty"`
+
+	// The network on which to allocate the Loadbalancer's address. Only
+	// supported by Octavia load balancers.
+	VipNetworkID string `json:"vip_network_id,omitempty"`
+
+	// An existing port to use as the Loadbalancer's address. Only supported by
+	// Octavia load balancers.
+	VipPortID string `json:"vip_port_id,omitempty"`
 
 	// TenantID is the UUID of the project who owns the Loadbalancer.
 	// Only administrative users can specify a project UUID other than their own.
@@ -108,6 +118,9 @@ type CreateOpts struct {
 
 // ToLoadBalancerCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	if opts.VipSubnetID == "" && opts.VipNetworkID == "" && opts.VipPortID == "" {
+		return nil, golangsdk.ErrMissingInput{Argument: "VipSubnetID/VipNetworkID/VipPortID"}
+	}
 	return golangsdk.BuildRequestBody(opts, "loadbalancer")
 }
 
@@ -121,13 +134,15 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular Loadbalancer based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -164,16 +179,18 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOpts) (r UpdateRes
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular LoadBalancer based on its
 // unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -187,12 +204,46 @@ func CascadingDelete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 		return
 	}
 	u := fmt.Sprintf("%s?cascade=true", resourceURL(c, id))
-	_, r.Err = c.Delete(u, nil)
+	resp, err := c.Delete(u, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetStatuses will return the status of a particular LoadBalancer.
 func GetStatuses(c *golangsdk.ServiceClient, id string) (r GetStatusesResult) {
-	_, r.Err = c.Get(statusRootURL(c, id), &r.Body, nil)
+	resp, err := c.Get(statusRootURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// Suspend takes the LoadBalancer administratively down, so that it stops
+// serving traffic while keeping its configuration. The load balancer must be
+// in the ACTIVE provisioning status, otherwise an ErrNotTransitionable is
+// returned.
+func Suspend(c *golangsdk.ServiceClient, id string) (r SuspendResult) {
+	r.commonResult = setAdminState(c, id, false)
+	return
+}
+
+// Unsuspend brings a suspended LoadBalancer administratively up again. The
+// load balancer must be in the ACTIVE provisioning status, otherwise an
+// ErrNotTransitionable is returned.
+func Unsuspend(c *golangsdk.ServiceClient, id string) (r UnsuspendResult) {
+	r.commonResult = setAdminState(c, id, true)
 	return
 }
+
+func setAdminState(c *golangsdk.ServiceClient, id string, up bool) (r commonResult) {
+	lb, err := Get(c, id).Extract()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if !ProvisioningStatus(lb.ProvisioningStatus).CanUpdate() {
+		r.Err = ErrNotTransitionable{ID: id, ProvisioningStatus: lb.ProvisioningStatus}
+		return
+	}
+
+	res := Update(c, id, UpdateOpts{AdminStateUp: &up})
+	return res.commonResult
+}
@@ -1,6 +1,8 @@
 package loadbalancers
 
 import (
+	"fmt"
+
 	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
@@ -21,8 +23,12 @@ type LoadBalancer struct {
 	// Owner of the LoadBalancer.
 	TenantID string `json:"tenant_id"`
 
+	// ProjectID is the owner of the LoadBalancer as returned by Octavia, which
+	// doesn't set TenantID.
+	ProjectID string `json:"project_id"`
+
 	// The provisioning status of the LoadBalancer.
-	// This value is ACTIVE, PENDING_CREATE or ERROR.
+	// This value is ACTIVE, PENDING_CREATE or ERROR, see ProvisioningStatus.
 	ProvisioningStatus string `json:"provisioning_status"`
 
 	// The IP address of the Loadbalancer.
@@ -35,6 +41,10 @@ type LoadBalancer struct {
 	// Loadbalancer address.
 	VipSubnetID string `json:"vip_subnet_id"`
 
+	// The UUID of the network on which the virtual IP is allocated. Only
+	// returned by Octavia load balancers.
+	VipNetworkID string `json:"vip_network_id"`
+
 	// The unique ID for the LoadBalancer.
 	ID string `json:"id"`
 
@@ -92,11 +102,17 @@ func (r LoadBalancerPage) IsEmpty() (bool, error) {
 // struct, and extracts the elements into a slice of LoadBalancer structs. In
 // other words, a generic collection is mapped into a relevant slice.
 func ExtractLoadBalancers(r pagination.Page) ([]LoadBalancer, error) {
-	var s struct {
-		LoadBalancers []LoadBalancer `json:"loadbalancers"`
-	}
-	err := (r.(LoadBalancerPage)).ExtractInto(&s)
-	return s.LoadBalancers, err
+	var s []LoadBalancer
+	err := ExtractLoadBalancersInto(r, &s)
+	return s, err
+}
+
+// ExtractLoadBalancersInto extracts the load balancers of a LoadBalancerPage
+// into v, which must be a pointer to a slice. The slice elements may be user
+// structs embedding LoadBalancer along with other structs holding extra
+// vendor fields.
+func ExtractLoadBalancersInto(r pagination.Page, v interface{}) error {
+	return r.(LoadBalancerPage).Result.ExtractIntoSlicePtr(v, "loadbalancers")
 }
 
 type commonResult struct {
@@ -108,10 +124,17 @@ func (r commonResult) Extract() (*LoadBalancer, error) {
 	var s struct {
 		LoadBalancer *LoadBalancer `json:"loadbalancer"`
 	}
-	err := r.ExtractInto(&s)
+	err := r.Result.ExtractInto(&s)
 	return s.LoadBalancer, err
 }
 
+// ExtractInto extracts the load balancer into v, which must be a pointer to a
+// struct. It may be a user struct embedding LoadBalancer, to decode extra
+// vendor fields.
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "loadbalancer")
+}
+
 // GetStatusesResult represents the result of a GetStatuses operation.
 // Call its Extract method to interpret it as a StatusTree.
 type GetStatusesResult struct {
@@ -151,3 +174,29 @@ type UpdateResult struct {
 type DeleteResult struct {
 	golangsdk.ErrResult
 }
+
+// SuspendResult represents the result of a suspend operation. Call its Extract
+// method to interpret it as a LoadBalancer.
+type SuspendResult struct {
+	commonResult
+}
+
+// UnsuspendResult represents the result of an unsuspend operation. Call its
+// Extract method to interpret it as a LoadBalancer.
+type UnsuspendResult struct {
+	commonResult
+}
+
+// ErrNotTransitionable is returned by Suspend and Unsuspend when the load
+// balancer is in a provisioning status which doesn't allow changes, such as
+// PENDING_UPDATE or ERROR.
+type ErrNotTransitionable struct {
+	golangsdk.BaseError
+	ID                 string
+	ProvisioningStatus string
+}
+
+func (e ErrNotTransitionable) Error() string {
+	return fmt.Sprintf("Load balancer [%s] cannot be changed while in %s provisioning status",
+		e.ID, e.ProvisioningStatus)
+}
@@ -0,0 +1,253 @@
+package loadbalancers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// Default settings of RollMembers.
+const (
+	DefaultRollBatchSize      = 1
+	DefaultRollHealthTimeout  = 5 * time.Minute
+	DefaultRollHealthInterval = 10 * time.Second
+)
+
+// RollPhase is the step of RollMembers reported by a RollEvent.
+type RollPhase string
+
+const (
+	// RollMemberAdded is reported once a new member is created.
+	RollMemberAdded RollPhase = "ADDED"
+
+	// RollMemberHealthy is reported once a new member is online.
+	RollMemberHealthy RollPhase = "HEALTHY"
+
+	// RollMemberDraining is reported once an old member is draining.
+	RollMemberDraining RollPhase = "DRAINING"
+
+	// RollMemberRemoved is reported once an old member is deleted.
+	RollMemberRemoved RollPhase = "REMOVED"
+)
+
+// RollEvent reports the progress of RollMembers.
+type RollEvent struct {
+	// Phase is the step which has just completed.
+	Phase RollPhase
+
+	// Member is the member the step applies to.
+	Member pools.Member
+
+	// Batch is the index of the current batch, starting at 0, and Batches
+	// the number of batches of the rollout.
+	Batch   int
+	Batches int
+}
+
+// RollStrategy are the options of RollMembers.
+type RollStrategy struct {
+	// BatchSize is the number of members added, then removed, at once. It
+	// defaults to DefaultRollBatchSize.
+	BatchSize int
+
+	// HealthTimeout is the longest time to wait for a new member to come
+	// online. It defaults to DefaultRollHealthTimeout.
+	HealthTimeout time.Duration
+
+	// HealthInterval is the time between two checks of the new members. It
+	// defaults to DefaultRollHealthInterval.
+	HealthInterval time.Duration
+
+	// Drain sets how long the old members are drained before their removal.
+	// Its Then and Observer fields are ignored.
+	Drain pools.DrainOpts
+
+	// ActiveConnections, if set, replaces Drain.ActiveConnections and
+	// returns the number of connections still open on the given members.
+	ActiveConnections func(ctx context.Context, members []pools.Member) (int, error)
+
+	// Observer, if set, is called after each step of the rollout.
+	Observer func(RollEvent)
+}
+
+// ErrMemberUnhealthy is returned by RollMembers when a new member doesn't come
+// online in time. The old members of its batch are kept.
+type ErrMemberUnhealthy struct {
+	golangsdk.BaseError
+	Member pools.Member
+}
+
+func (e ErrMemberUnhealthy) Error() string {
+	return fmt.Sprintf("Member %s (%s:%d) is not healthy: provisioning status %s, operating status %s",
+		e.Member.ID, e.Member.Address, e.Member.ProtocolPort, e.Member.ProvisioningStatus, e.Member.OperatingStatus)
+}
+
+// RollMembers replaces the members of a pool of a load balancer with
+// newMembers, one batch at a time: the members of a batch are created, their
+// health is awaited, then as many old members are drained and removed. The
+// capacity of the pool is thus never reduced, which is the usual way to perform
+// a blue/green deployment behind a load balancer.
+//
+// The load balancer is immutable while a change is in progress, so
+// RollMembers waits for it to be ACTIVE again after each member is created,
+// drained or deleted.
+//
+// The existing members whose address and port match one of newMembers are
+// kept as they are. RollMembers stops at the first error, leaving the pool
+// with both old and new members.
+func RollMembers(ctx context.Context, c *golangsdk.ServiceClient, lbID, poolID string, newMembers []pools.CreateMemberOpts, strategy RollStrategy) error {
+	pages, err := pools.ListMembers(c, poolID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return err
+	}
+	current, err := pools.ExtractMembers(pages)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[string]bool)
+	for _, m := range current {
+		kept[memberKey(m.Address, m.ProtocolPort)] = false
+	}
+	var added []pools.CreateMemberOpts
+	for _, opts := range newMembers {
+		key := memberKey(opts.Address, opts.ProtocolPort)
+		if _, ok := kept[key]; ok {
+			kept[key] = true
+			continue
+		}
+		added = append(added, opts)
+	}
+	var removed []pools.Member
+	for _, m := range current {
+		if !kept[memberKey(m.Address, m.ProtocolPort)] {
+			removed = append(removed, m)
+		}
+	}
+
+	size := strategy.BatchSize
+	if size <= 0 {
+		size = DefaultRollBatchSize
+	}
+	n := len(added)
+	if len(removed) > n {
+		n = len(removed)
+	}
+	batches := (n + size - 1) / size
+
+	notify := func(phase RollPhase, m pools.Member, batch int) {
+		if strategy.Observer != nil {
+			strategy.Observer(RollEvent{Phase: phase, Member: m, Batch: batch, Batches: batches})
+		}
+	}
+
+	for batch := 0; batch < batches; batch++ {
+		var members []pools.Member
+		for _, opts := range rollBatch(added, batch, size) {
+			m, err := pools.CreateMember(c, poolID, opts).Extract()
+			if err != nil {
+				return err
+			}
+			if err := waitForActive(ctx, c, lbID); err != nil {
+				return err
+			}
+			notify(RollMemberAdded, *m, batch)
+			members = append(members, *m)
+		}
+		for _, m := range members {
+			m, err := waitMemberHealthy(ctx, c, poolID, m, strategy)
+			if err != nil {
+				return err
+			}
+			notify(RollMemberHealthy, m, batch)
+		}
+
+		start, end := rollBounds(len(removed), batch, size)
+		old := removed[start:end]
+		if len(old) == 0 {
+			continue
+		}
+		for _, m := range old {
+			if _, err := pools.Drain(c, poolID, m.ID).Extract(); err != nil {
+				return err
+			}
+			if err := waitForActive(ctx, c, lbID); err != nil {
+				return err
+			}
+			notify(RollMemberDraining, m, batch)
+		}
+		drain := strategy.Drain
+		drain.Then, drain.Observer = pools.DrainKeep, nil
+		if strategy.ActiveConnections != nil {
+			drain.ActiveConnections = func(ctx context.Context) (int, error) {
+				return strategy.ActiveConnections(ctx, old)
+			}
+		}
+		if err := pools.WaitDrained(ctx, drain); err != nil {
+			return err
+		}
+		for _, m := range old {
+			if err := pools.DeleteMember(c, poolID, m.ID).ExtractErr(); err != nil {
+				return err
+			}
+			if err := waitForActive(ctx, c, lbID); err != nil {
+				return err
+			}
+			notify(RollMemberRemoved, m, batch)
+		}
+	}
+	return nil
+}
+
+// waitMemberHealthy polls a member until it is active and online. A member
+// without health monitor is considered healthy once active.
+func waitMemberHealthy(ctx context.Context, c *golangsdk.ServiceClient, poolID string, m pools.Member, strategy RollStrategy) (pools.Member, error) {
+	timeout := strategy.HealthTimeout
+	if timeout <= 0 {
+		timeout = DefaultRollHealthTimeout
+	}
+	interval := strategy.HealthInterval
+	if interval <= 0 {
+		interval = DefaultRollHealthInterval
+	}
+	healthCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := golangsdk.Backoff{Initial: interval, Max: interval, Multiplier: 1}
+	err := golangsdk.WaitForBackoff(healthCtx, backoff, func() (bool, string, error) {
+		current, err := pools.GetMember(c, poolID, m.ID).Extract()
+		if err != nil {
+			return false, "", err
+		}
+		m = *current
+		if m.ProvisioningStatus == "ERROR" {
+			return false, m.ProvisioningStatus, ErrMemberUnhealthy{Member: m}
+		}
+		healthy := m.ProvisioningStatus == "ACTIVE" &&
+			(m.OperatingStatus == "ONLINE" || m.OperatingStatus == "NO_MONITOR")
+		return healthy, m.OperatingStatus, nil
+	}, nil)
+	if err == context.DeadlineExceeded && ctx.Err() == nil {
+		err = ErrMemberUnhealthy{Member: m}
+	}
+	return m, err
+}
+
+func rollBounds(n, batch, size int) (int, int) {
+	start, end := batch*size, (batch+1)*size
+	if start > n {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+func rollBatch(opts []pools.CreateMemberOpts, batch, size int) []pools.CreateMemberOpts {
+	start, end := rollBounds(len(opts), batch, size)
+	return opts[start:end]
+}
@@ -0,0 +1,407 @@
+package loadbalancers
+
+import (
+	"context"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/whitelists"
+)
+
+// LoadBalancerSpec is a declarative, serializable description of a load
+// balancer and of its listeners, pools, members and health monitors. It holds
+// no IDs, so that it can be kept in version control or used to clone a load
+// balancer, possibly in another region.
+type LoadBalancerSpec struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// VipSubnetID is the subnet on which the VIP is allocated. It usually has
+	// to be changed when cloning a load balancer in another region.
+	VipSubnetID string `json:"vip_subnet_id"`
+
+	// VipAddress is the fixed IP of the VIP. Clear it to have a new address
+	// allocated.
+	VipAddress string `json:"vip_address,omitempty"`
+
+	Flavor       string `json:"flavor,omitempty"`
+	Provider     string `json:"provider,omitempty"`
+	AdminStateUp bool   `json:"admin_state_up"`
+
+	Listeners []ListenerSpec `json:"listeners,omitempty"`
+
+	// Pools are the pools attached to the load balancer which are not the
+	// default pool of one of its listeners.
+	Pools []PoolSpec `json:"pools,omitempty"`
+}
+
+// ListenerSpec describes a listener of a LoadBalancerSpec.
+type ListenerSpec struct {
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Protocol     string `json:"protocol"`
+	ProtocolPort int    `json:"protocol_port"`
+	AdminStateUp bool   `json:"admin_state_up"`
+
+	// ConnLimit throttles the number of connections to the listener. -1 means
+	// unlimited.
+	ConnLimit int `json:"connection_limit"`
+
+	Http2Enable            bool     `json:"http2_enable,omitempty"`
+	DefaultTlsContainerRef string   `json:"default_tls_container_ref,omitempty"`
+	SniContainerRefs       []string `json:"sni_container_refs,omitempty"`
+	CAContainerRef         string   `json:"client_ca_tls_container_ref,omitempty"`
+	TlsCiphersPolicy       string   `json:"tls_ciphers_policy,omitempty"`
+
+	// Whitelist is the access control list of the listener, if any.
+	Whitelist *WhitelistSpec `json:"whitelist,omitempty"`
+
+	DefaultPool *PoolSpec `json:"default_pool,omitempty"`
+}
+
+// WhitelistSpec describes the access control list of a listener.
+type WhitelistSpec struct {
+	EnableWhitelist bool   `json:"enable_whitelist"`
+	Whitelist       string `json:"whitelist"`
+}
+
+// PoolSpec describes a pool of a LoadBalancerSpec.
+type PoolSpec struct {
+	Name         string                    `json:"name,omitempty"`
+	Description  string                    `json:"description,omitempty"`
+	Protocol     string                    `json:"protocol"`
+	LBMethod     string                    `json:"lb_algorithm"`
+	AdminStateUp bool                      `json:"admin_state_up"`
+	Persistence  *pools.SessionPersistence `json:"session_persistence,omitempty"`
+	Members      []MemberSpec              `json:"members,omitempty"`
+	Monitor      *MonitorSpec              `json:"healthmonitor,omitempty"`
+}
+
+// MemberSpec describes a member of a PoolSpec.
+type MemberSpec struct {
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address"`
+	ProtocolPort int    `json:"protocol_port"`
+	SubnetID     string `json:"subnet_id,omitempty"`
+	AdminStateUp bool   `json:"admin_state_up"`
+
+	// Weight is the weight of the member. The API default applies when it
+	// is nil, and a weight of 0 drains the member.
+	Weight *int `json:"weight,omitempty"`
+}
+
+// MonitorSpec describes the health monitor of a PoolSpec.
+type MonitorSpec struct {
+	Name          string `json:"name,omitempty"`
+	Type          string `json:"type"`
+	Delay         int    `json:"delay"`
+	Timeout       int    `json:"timeout"`
+	MaxRetries    int    `json:"max_retries"`
+	URLPath       string `json:"url_path,omitempty"`
+	HTTPMethod    string `json:"http_method,omitempty"`
+	ExpectedCodes string `json:"expected_codes,omitempty"`
+	MonitorPort   int    `json:"monitor_port,omitempty"`
+	AdminStateUp  bool   `json:"admin_state_up"`
+}
+
+// Export retrieves a load balancer along with its listeners, pools, members,
+// health monitors and whitelists, and describes them as a LoadBalancerSpec.
+func Export(c *golangsdk.ServiceClient, id string) (*LoadBalancerSpec, error) {
+	lb, err := Get(c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &LoadBalancerSpec{
+		Name:         lb.Name,
+		Description:  lb.Description,
+		VipSubnetID:  lb.VipSubnetID,
+		VipAddress:   lb.VipAddress,
+		Flavor:       lb.Flavor,
+		Provider:     lb.Provider,
+		AdminStateUp: lb.AdminStateUp,
+	}
+
+	allPages, err := pools.List(c, pools.ListOpts{LoadbalancerID: id}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allPools, err := pools.ExtractPools(allPages)
+	if err != nil {
+		return nil, err
+	}
+	poolSpecs := make(map[string]*PoolSpec, len(allPools))
+	for _, pool := range allPools {
+		poolSpec, err := exportPool(c, pool)
+		if err != nil {
+			return nil, err
+		}
+		poolSpecs[pool.ID] = poolSpec
+	}
+
+	allPages, err = listeners.List(c, listeners.ListOpts{LoadbalancerID: id}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allListeners, err := listeners.ExtractListeners(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range allListeners {
+		listenerSpec := ListenerSpec{
+			Name:                   listener.Name,
+			Description:            listener.Description,
+			Protocol:               listener.Protocol,
+			ProtocolPort:           listener.ProtocolPort,
+			AdminStateUp:           listener.AdminStateUp,
+			ConnLimit:              listener.ConnLimit,
+			Http2Enable:            listener.Http2Enable,
+			DefaultTlsContainerRef: listener.DefaultTlsContainerRef,
+			SniContainerRefs:       listener.SniContainerRefs,
+			CAContainerRef:         listener.CAContainerRef,
+			TlsCiphersPolicy:       listener.TlsCiphersPolicy,
+			DefaultPool:            poolSpecs[listener.DefaultPoolID],
+		}
+
+		allPages, err := whitelists.List(c, whitelists.ListOpts{ListenerId: listener.ID}).AllPages()
+		if err != nil {
+			return nil, err
+		}
+		allWhitelists, err := whitelists.ExtractWhitelists(allPages)
+		if err != nil {
+			return nil, err
+		}
+		if len(allWhitelists) > 0 {
+			listenerSpec.Whitelist = &WhitelistSpec{
+				EnableWhitelist: allWhitelists[0].EnableWhitelist,
+				Whitelist:       allWhitelists[0].Whitelist,
+			}
+		}
+
+		spec.Listeners = append(spec.Listeners, listenerSpec)
+	}
+
+	// A pool may be the default pool of several listeners, so the default
+	// pools are only left out of spec.Pools once every listener got its own.
+	defaultPools := make(map[string]bool, len(allListeners))
+	for _, listener := range allListeners {
+		defaultPools[listener.DefaultPoolID] = true
+	}
+	for _, pool := range allPools {
+		if !defaultPools[pool.ID] {
+			spec.Pools = append(spec.Pools, *poolSpecs[pool.ID])
+		}
+	}
+
+	return spec, nil
+}
+
+func exportPool(c *golangsdk.ServiceClient, pool pools.Pool) (*PoolSpec, error) {
+	spec := &PoolSpec{
+		Name:         pool.Name,
+		Description:  pool.Description,
+		Protocol:     pool.Protocol,
+		LBMethod:     pool.LBMethod,
+		AdminStateUp: pool.AdminStateUp,
+	}
+	if pool.Persistence.Type != "" {
+		persistence := pool.Persistence
+		spec.Persistence = &persistence
+	}
+
+	allPages, err := pools.ListMembers(c, pool.ID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	allMembers, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range allMembers {
+		spec.Members = append(spec.Members, MemberSpec{
+			Name:         member.Name,
+			Address:      member.Address,
+			ProtocolPort: member.ProtocolPort,
+			Weight:       golangsdk.IntToPointer(member.Weight),
+			SubnetID:     member.SubnetID,
+			AdminStateUp: member.AdminStateUp,
+		})
+	}
+
+	if pool.MonitorID != "" {
+		monitor, err := monitors.Get(c, pool.MonitorID).Extract()
+		if err != nil {
+			return nil, err
+		}
+		spec.Monitor = &MonitorSpec{
+			Name:          monitor.Name,
+			Type:          monitor.Type,
+			Delay:         monitor.Delay,
+			Timeout:       monitor.Timeout,
+			MaxRetries:    monitor.MaxRetries,
+			URLPath:       monitor.URLPath,
+			HTTPMethod:    monitor.HTTPMethod,
+			ExpectedCodes: monitor.ExpectedCodes,
+			MonitorPort:   monitor.MonitorPort,
+			AdminStateUp:  monitor.AdminStateUp,
+		}
+	}
+
+	return spec, nil
+}
+
+// CreateFromSpec creates a load balancer and its listeners, pools, members,
+// health monitors and whitelists as described by spec. Each resource is
+// created once the load balancer is ACTIVE again.
+//
+// If an error occurs, the load balancer created so far is returned along with
+// the error, so that the caller can delete it. This includes ctx being done
+// while the load balancer is still in a PENDING_* status.
+func CreateFromSpec(ctx context.Context, c *golangsdk.ServiceClient, spec LoadBalancerSpec) (*LoadBalancer, error) {
+	adminStateUp := spec.AdminStateUp
+	lb, err := Create(c, CreateOpts{
+		Name:         spec.Name,
+		Description:  spec.Description,
+		VipSubnetID:  spec.VipSubnetID,
+		VipAddress:   spec.VipAddress,
+		Flavor:       spec.Flavor,
+		Provider:     spec.Provider,
+		AdminStateUp: &adminStateUp,
+	}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if err := waitForActive(ctx, c, lb.ID); err != nil {
+		return lb, err
+	}
+
+	for _, listenerSpec := range spec.Listeners {
+		if err := createListenerFromSpec(ctx, c, lb.ID, listenerSpec); err != nil {
+			return lb, err
+		}
+	}
+
+	for _, poolSpec := range spec.Pools {
+		if err := createPoolFromSpec(ctx, c, lb.ID, pools.CreateOpts{LoadbalancerID: lb.ID}, poolSpec); err != nil {
+			return lb, err
+		}
+	}
+
+	return Get(c, lb.ID).Extract()
+}
+
+func createListenerFromSpec(ctx context.Context, c *golangsdk.ServiceClient, lbID string, spec ListenerSpec) error {
+	adminStateUp := spec.AdminStateUp
+	connLimit := spec.ConnLimit
+	http2Enable := spec.Http2Enable
+	listener, err := listeners.Create(c, listeners.CreateOpts{
+		LoadbalancerID:         lbID,
+		Protocol:               listeners.Protocol(spec.Protocol),
+		ProtocolPort:           spec.ProtocolPort,
+		Name:                   spec.Name,
+		Description:            spec.Description,
+		ConnLimit:              &connLimit,
+		Http2Enable:            &http2Enable,
+		DefaultTlsContainerRef: spec.DefaultTlsContainerRef,
+		SniContainerRefs:       spec.SniContainerRefs,
+		CAContainerRef:         spec.CAContainerRef,
+		TlsCiphersPolicy:       spec.TlsCiphersPolicy,
+		AdminStateUp:           &adminStateUp,
+	}).Extract()
+	if err != nil {
+		return err
+	}
+	if err := waitForActive(ctx, c, lbID); err != nil {
+		return err
+	}
+
+	if spec.Whitelist != nil {
+		enableWhitelist := spec.Whitelist.EnableWhitelist
+		_, err := whitelists.Create(c, whitelists.CreateOpts{
+			ListenerId:      listener.ID,
+			EnableWhitelist: &enableWhitelist,
+			Whitelist:       spec.Whitelist.Whitelist,
+		}).Extract()
+		if err != nil {
+			return err
+		}
+		if err := waitForActive(ctx, c, lbID); err != nil {
+			return err
+		}
+	}
+
+	if spec.DefaultPool != nil {
+		return createPoolFromSpec(ctx, c, lbID, pools.CreateOpts{ListenerID: listener.ID}, *spec.DefaultPool)
+	}
+	return nil
+}
+
+// createPoolFromSpec creates a pool attached to the load balancer or the
+// listener set in opts.
+func createPoolFromSpec(ctx context.Context, c *golangsdk.ServiceClient, lbID string, opts pools.CreateOpts, spec PoolSpec) error {
+	adminStateUp := spec.AdminStateUp
+	opts.Name = spec.Name
+	opts.Description = spec.Description
+	opts.Protocol = pools.Protocol(spec.Protocol)
+	opts.LBMethod = pools.LBMethod(spec.LBMethod)
+	opts.Persistence = spec.Persistence
+	opts.AdminStateUp = &adminStateUp
+
+	pool, err := pools.Create(c, opts).Extract()
+	if err != nil {
+		return err
+	}
+	if err := waitForActive(ctx, c, lbID); err != nil {
+		return err
+	}
+
+	for _, memberSpec := range spec.Members {
+		adminStateUp := memberSpec.AdminStateUp
+		_, err := pools.CreateMember(c, pool.ID, pools.CreateMemberOpts{
+			Address:      memberSpec.Address,
+			ProtocolPort: memberSpec.ProtocolPort,
+			Name:         memberSpec.Name,
+			Weight:       memberSpec.Weight,
+			SubnetID:     memberSpec.SubnetID,
+			AdminStateUp: &adminStateUp,
+		}).Extract()
+		if err != nil {
+			return err
+		}
+		if err := waitForActive(ctx, c, lbID); err != nil {
+			return err
+		}
+	}
+
+	if spec.Monitor != nil {
+		adminStateUp := spec.Monitor.AdminStateUp
+		_, err := monitors.Create(c, monitors.CreateOpts{
+			PoolID:        pool.ID,
+			Type:          spec.Monitor.Type,
+			Delay:         spec.Monitor.Delay,
+			Timeout:       spec.Monitor.Timeout,
+			MaxRetries:    spec.Monitor.MaxRetries,
+			URLPath:       spec.Monitor.URLPath,
+			HTTPMethod:    spec.Monitor.HTTPMethod,
+			ExpectedCodes: spec.Monitor.ExpectedCodes,
+			MonitorPort:   spec.Monitor.MonitorPort,
+			Name:          spec.Monitor.Name,
+			AdminStateUp:  &adminStateUp,
+		}).Extract()
+		if err != nil {
+			return err
+		}
+		return waitForActive(ctx, c, lbID)
+	}
+	return nil
+}
+
+// waitForActive waits for the load balancer to leave its PENDING_* status
+// after a change, or for ctx to be done.
+func waitForActive(ctx context.Context, c *golangsdk.ServiceClient, id string) error {
+	op := &Operation{client: c, id: id}
+	_, err := op.Wait(ctx)
+	return err
+}
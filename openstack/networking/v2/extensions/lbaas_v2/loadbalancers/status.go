@@ -0,0 +1,73 @@
+package loadbalancers
+
+// ProvisioningStatus is the provisioning status of a load balancer, as found
+// in LoadBalancer.ProvisioningStatus. It tells whether the load balancer can
+// be changed, is being changed, or failed to change:
+//
+//	status := loadbalancers.ProvisioningStatus(lb.ProvisioningStatus)
+//	if status.IsTransient() {
+//		// Wait before changing it.
+//	}
+type ProvisioningStatus string
+
+// Provisioning statuses of a load balancer.
+const (
+	StatusActive        ProvisioningStatus = "ACTIVE"
+	StatusDeleted       ProvisioningStatus = "DELETED"
+	StatusError         ProvisioningStatus = "ERROR"
+	StatusPendingCreate ProvisioningStatus = "PENDING_CREATE"
+	StatusPendingUpdate ProvisioningStatus = "PENDING_UPDATE"
+	StatusPendingDelete ProvisioningStatus = "PENDING_DELETE"
+)
+
+// transitions are the statuses a load balancer may move to from each status.
+// A load balancer in ERROR may still be updated, e.g. by a failover, or
+// deleted.
+var transitions = map[ProvisioningStatus][]ProvisioningStatus{
+	StatusPendingCreate: {StatusActive, StatusError},
+	StatusActive:        {StatusPendingUpdate, StatusPendingDelete},
+	StatusPendingUpdate: {StatusActive, StatusError},
+	StatusPendingDelete: {StatusDeleted, StatusError},
+	StatusError:         {StatusPendingUpdate, StatusPendingDelete},
+}
+
+// IsTransient reports whether the load balancer is being created, updated or
+// deleted. It rejects any change until it leaves the status.
+func (s ProvisioningStatus) IsTransient() bool {
+	switch s {
+	case StatusPendingCreate, StatusPendingUpdate, StatusPendingDelete:
+		return true
+	}
+	return false
+}
+
+// IsTerminal reports whether the last change of the load balancer has
+// completed, successfully or not. Unknown statuses are neither transient nor
+// terminal.
+func (s ProvisioningStatus) IsTerminal() bool {
+	switch s {
+	case StatusActive, StatusError, StatusDeleted:
+		return true
+	}
+	return false
+}
+
+// CanUpdate reports whether the load balancer, and the listeners, pools and
+// monitors attached to it, accept changes.
+func (s ProvisioningStatus) CanUpdate() bool {
+	return s == StatusActive
+}
+
+// CanTransitionTo reports whether a load balancer in status s may move to
+// status next. A load balancer staying in its status is a valid transition.
+func (s ProvisioningStatus) CanTransitionTo(next ProvisioningStatus) bool {
+	if s == next {
+		return true
+	}
+	for _, t := range transitions[s] {
+		if t == next {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+package loadbalancers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// EachLB lists the load balancers matching opts and calls fn for each of
+// them. Unlike List and ExtractLoadBalancers, which unmarshal a whole page at
+// once, EachLB reads each response body with a json.Decoder and decodes the
+// load balancers one at a time, so that going through tens of thousands of
+// them doesn't allocate large transient slices.
+//
+// The pages are followed through their "next" link. EachLB stops at the first
+// error returned by fn and returns it.
+func EachLB(c *golangsdk.ServiceClient, opts ListOptsBuilder, fn func(LoadBalancer) error) error {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToLoadBalancerListQuery()
+		if err != nil {
+			return err
+		}
+		url += query
+	}
+
+	for url != "" {
+		next, err := eachLBPage(c, url, fn)
+		if err != nil {
+			return err
+		}
+		if next == url {
+			break
+		}
+		url = next
+	}
+	return nil
+}
+
+// eachLBPage streams the load balancers of the page at url to fn, and returns
+// the URL of the next page, if any and if the page wasn't empty.
+func eachLBPage(c *golangsdk.ServiceClient, url string, fn func(LoadBalancer) error) (string, error) {
+	resp, err := c.Get(url, nil, &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	count := 0
+	links, err := decodeEachLB(json.NewDecoder(resp.Body), func(lb LoadBalancer) error {
+		count++
+		return fn(lb)
+	})
+	if err != nil || count == 0 {
+		return "", err
+	}
+	return golangsdk.ExtractNextURL(links)
+}
+
+// decodeEachLB walks a {"loadbalancers": [...], "loadbalancers_links": [...]}
+// document, decodes the load balancers one by one and returns the links. The
+// other keys of the document are skipped.
+func decodeEachLB(dec *json.Decoder, fn func(LoadBalancer) error) ([]golangsdk.Link, error) {
+	var links []golangsdk.Link
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "loadbalancers":
+			if err := expectDelim(dec, '['); err != nil {
+				return nil, err
+			}
+			for dec.More() {
+				var lb LoadBalancer
+				if err := dec.Decode(&lb); err != nil {
+					return nil, err
+				}
+				if err := fn(lb); err != nil {
+					return nil, err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return nil, err
+			}
+		case "loadbalancers_links":
+			if err := dec.Decode(&links); err != nil {
+				return nil, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return links, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("Unexpected token %v in load balancer list, expected %v", tok, delim)
+	}
+	return nil
+}
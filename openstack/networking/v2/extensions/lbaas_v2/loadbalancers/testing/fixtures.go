@@ -1,10 +1,13 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/huaweicloud/golangsdk"
 	th "github.com/huaweicloud/golangsdk/testhelper"
 	"github.com/huaweicloud/golangsdk/testhelper/client"
 
@@ -21,6 +24,7 @@ const LoadbalancersListBody = `
 	         {
 			"id": "c331058c-6a40-4144-948e-b9fb1df9db4b",
 			"tenant_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
 			"name": "web_lb",
 			"description": "lb config for the web tier",
 			"vip_subnet_id": "8a49c438-848f-467b-9655-ea1548708154",
@@ -35,6 +39,7 @@ const LoadbalancersListBody = `
 		{
 			"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
 			"tenant_id": "54030507-44f7-473c-9342-b4d14a95f692",
+			"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
 			"name": "db_lb",
 			"description": "lb config for the db tier",
 			"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
@@ -56,6 +61,7 @@ const SingleLoadbalancerBody = `
 	"loadbalancer": {
 		"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
 		"tenant_id": "54030507-44f7-473c-9342-b4d14a95f692",
+		"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
 		"name": "db_lb",
 		"description": "lb config for the db tier",
 		"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
@@ -76,6 +82,7 @@ const PostUpdateLoadbalancerBody = `
 	"loadbalancer": {
 		"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
 		"tenant_id": "54030507-44f7-473c-9342-b4d14a95f692",
+		"project_id": "54030507-44f7-473c-9342-b4d14a95f692",
 		"name": "NewLoadbalancerName",
 		"description": "lb config for the db tier",
 		"vip_subnet_id": "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
@@ -130,6 +137,7 @@ var (
 	LoadbalancerWeb = loadbalancers.LoadBalancer{
 		ID:                 "c331058c-6a40-4144-948e-b9fb1df9db4b",
 		TenantID:           "54030507-44f7-473c-9342-b4d14a95f692",
+		ProjectID:          "54030507-44f7-473c-9342-b4d14a95f692",
 		Name:               "web_lb",
 		Description:        "lb config for the web tier",
 		VipSubnetID:        "8a49c438-848f-467b-9655-ea1548708154",
@@ -144,6 +152,7 @@ var (
 	LoadbalancerDb = loadbalancers.LoadBalancer{
 		ID:                 "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
 		TenantID:           "54030507-44f7-473c-9342-b4d14a95f692",
+		ProjectID:          "54030507-44f7-473c-9342-b4d14a95f692",
 		Name:               "db_lb",
 		Description:        "lb config for the db tier",
 		VipSubnetID:        "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
@@ -158,6 +167,7 @@ var (
 	LoadbalancerUpdated = loadbalancers.LoadBalancer{
 		ID:                 "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
 		TenantID:           "54030507-44f7-473c-9342-b4d14a95f692",
+		ProjectID:          "54030507-44f7-473c-9342-b4d14a95f692",
 		Name:               "NewLoadbalancerName",
 		Description:        "lb config for the db tier",
 		VipSubnetID:        "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
@@ -219,6 +229,34 @@ func HandleLoadbalancerListSuccessfully(t *testing.T) {
 	})
 }
 
+// HandleLoadbalancerEachSuccessfully sets up the test server to respond to
+// the requests of EachLB with the load balancers of LoadbalancersListBody, one
+// per page. The first page links to the second one, which has no next link.
+func HandleLoadbalancerEachSuccessfully(t *testing.T) {
+	var list struct {
+		LoadBalancers []json.RawMessage `json:"loadbalancers"`
+	}
+	th.AssertNoErr(t, json.Unmarshal([]byte(LoadbalancersListBody), &list))
+
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `{"loadbalancers": [%s], "loadbalancers_links": [{"href": "%s/v2.0/lbaas/loadbalancers?marker=c331058c-6a40-4144-948e-b9fb1df9db4b", "rel": "next"}]}`,
+				list.LoadBalancers[0], th.Server.URL)
+		case "c331058c-6a40-4144-948e-b9fb1df9db4b":
+			fmt.Fprintf(w, `{"loadbalancers": [%s]}`, list.LoadBalancers[1])
+		default:
+			t.Fatalf("/v2.0/lbaas/loadbalancers invoked with unexpected marker=[%s]", marker)
+		}
+	})
+}
+
 // HandleLoadbalancerCreationSuccessfully sets up the test server to respond to a loadbalancer creation request
 // with a given response.
 func HandleLoadbalancerCreationSuccessfully(t *testing.T, response string) {
@@ -290,3 +328,488 @@ func HandleLoadbalancerUpdateSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, PostUpdateLoadbalancerBody)
 	})
 }
+
+// HandleLoadbalancerGetActive sets up the test server to respond to a
+// loadbalancer Get request with a loadbalancer that is PENDING_CREATE on the
+// first call and ACTIVE afterwards.
+func HandleLoadbalancerGetActive(t *testing.T) {
+	calls := 0
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		calls++
+		if calls == 1 {
+			fmt.Fprintf(w, SingleLoadbalancerBody)
+			return
+		}
+		fmt.Fprintf(w, strings.Replace(SingleLoadbalancerBody, "PENDING_CREATE", "ACTIVE", 1))
+	})
+}
+
+// HandleLoadbalancerExportSuccessfully sets up the test server to respond to
+// the requests sent when exporting the db_lb loadbalancer.
+func HandleLoadbalancerExportSuccessfully(t *testing.T) {
+	HandleLoadbalancerGetSuccessfully(t)
+	HandleLoadbalancerChildrenListSuccessfully(t)
+}
+
+// HandleLoadbalancerChildrenListSuccessfully sets up the test server to list
+// the resources of the db_lb loadbalancer: one HTTPS listener with a whitelist
+// and a default pool, and a second pool without listener.
+func HandleLoadbalancerChildrenListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"listeners": [{
+				"id": "db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1",
+				"name": "https",
+				"protocol": "TERMINATED_HTTPS",
+				"protocol_port": 443,
+				"default_pool_id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4",
+				"connection_limit": 1000,
+				"default_tls_container_ref": "https://barbican/v1/containers/1",
+				"admin_state_up": true
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/whitelists", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"listener_id": "db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"whitelists": [{
+				"id": "eabfefa3fd1740a88a47ad98e132d238",
+				"listener_id": "db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1",
+				"enable_whitelist": true,
+				"whitelist": "192.168.11.1,192.168.0.1/24"
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"pools": [{
+				"id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4",
+				"name": "web",
+				"protocol": "HTTP",
+				"lb_algorithm": "ROUND_ROBIN",
+				"healthmonitor_id": "466c8345-28d8-4f84-a246-e04380b0461d",
+				"session_persistence": {"type": "HTTP_COOKIE"},
+				"admin_state_up": true
+			}, {
+				"id": "c3741b06-df4d-4715-b142-276b6bce75ab",
+				"name": "spare",
+				"protocol": "TCP",
+				"lb_algorithm": "LEAST_CONNECTIONS",
+				"admin_state_up": false
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"members": [{
+				"id": "2a280670-c202-4b0b-a562-34077415aabf",
+				"address": "10.0.2.10",
+				"protocol_port": 80,
+				"weight": 5,
+				"subnet_id": "1981f108-3c48-48d2-b908-30f7d28532c9",
+				"admin_state_up": true
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/c3741b06-df4d-4715-b142-276b6bce75ab/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"members": []}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/healthmonitors/466c8345-28d8-4f84-a246-e04380b0461d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		fmt.Fprintf(w, `{
+			"healthmonitor": {
+				"id": "466c8345-28d8-4f84-a246-e04380b0461d",
+				"type": "HTTP",
+				"delay": 5,
+				"timeout": 3,
+				"max_retries": 2,
+				"url_path": "/health",
+				"http_method": "GET",
+				"expected_codes": "200",
+				"admin_state_up": true
+			}
+		}`)
+	})
+}
+
+// HandleLoadbalancerSharedPoolExportSuccessfully sets up the test server to
+// respond to the requests sent when exporting the db_lb loadbalancer, with an
+// HTTP and an HTTPS listener sharing the same default pool.
+func HandleLoadbalancerSharedPoolExportSuccessfully(t *testing.T) {
+	HandleLoadbalancerGetSuccessfully(t)
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"listeners": [{
+				"id": "db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1",
+				"name": "https",
+				"protocol": "TERMINATED_HTTPS",
+				"protocol_port": 443,
+				"default_pool_id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4",
+				"connection_limit": 1000,
+				"default_tls_container_ref": "https://barbican/v1/containers/1",
+				"admin_state_up": true
+			}, {
+				"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ac",
+				"name": "http",
+				"protocol": "HTTP",
+				"protocol_port": 80,
+				"default_pool_id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4",
+				"connection_limit": -1,
+				"admin_state_up": true
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/whitelists", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"whitelists": []}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestFormValues(t, r, map[string]string{"loadbalancer_id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab"})
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"pools": [{
+				"id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4",
+				"name": "web",
+				"protocol": "HTTP",
+				"lb_algorithm": "ROUND_ROBIN",
+				"admin_state_up": true
+			}]
+		}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"members": []}`)
+	})
+}
+
+// ExportedLoadbalancerSpec is the spec expected from exporting the db_lb
+// loadbalancer.
+var ExportedLoadbalancerSpec = loadbalancers.LoadBalancerSpec{
+	Name:         "db_lb",
+	Description:  "lb config for the db tier",
+	VipSubnetID:  "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+	VipAddress:   "10.30.176.48",
+	Flavor:       "medium",
+	Provider:     "haproxy",
+	AdminStateUp: true,
+	Listeners: []loadbalancers.ListenerSpec{
+		{
+			Name:                   "https",
+			Protocol:               "TERMINATED_HTTPS",
+			ProtocolPort:           443,
+			AdminStateUp:           true,
+			ConnLimit:              1000,
+			DefaultTlsContainerRef: "https://barbican/v1/containers/1",
+			Whitelist: &loadbalancers.WhitelistSpec{
+				EnableWhitelist: true,
+				Whitelist:       "192.168.11.1,192.168.0.1/24",
+			},
+			DefaultPool: &loadbalancers.PoolSpec{
+				Name:         "web",
+				Protocol:     "HTTP",
+				LBMethod:     "ROUND_ROBIN",
+				AdminStateUp: true,
+				Persistence:  &pools.SessionPersistence{Type: "HTTP_COOKIE"},
+				Members: []loadbalancers.MemberSpec{
+					{
+						Address:      "10.0.2.10",
+						ProtocolPort: 80,
+						Weight:       golangsdk.IntToPointer(5),
+						SubnetID:     "1981f108-3c48-48d2-b908-30f7d28532c9",
+						AdminStateUp: true,
+					},
+				},
+				Monitor: &loadbalancers.MonitorSpec{
+					Type:          "HTTP",
+					Delay:         5,
+					Timeout:       3,
+					MaxRetries:    2,
+					URLPath:       "/health",
+					HTTPMethod:    "GET",
+					ExpectedCodes: "200",
+					AdminStateUp:  true,
+				},
+			},
+		},
+	},
+	Pools: []loadbalancers.PoolSpec{
+		{
+			Name:     "spare",
+			Protocol: "TCP",
+			LBMethod: "LEAST_CONNECTIONS",
+		},
+	},
+}
+
+// HandleLoadbalancerApplySuccessfully sets up the test server to respond to
+// the requests sent when applying ReconcileChangeSet to the db_lb
+// loadbalancer, which stays ACTIVE.
+func HandleLoadbalancerApplySuccessfully(t *testing.T) {
+	HandleLoadbalancerChildrenListSuccessfully(t)
+
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		fmt.Fprintf(w, strings.Replace(SingleLoadbalancerBody, "PENDING_CREATE", "ACTIVE", 1))
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/listeners/db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{
+			"listener": {
+				"name": "https",
+				"connection_limit": 500,
+				"http2_enable": false,
+				"default_tls_container_ref": "https://barbican/v1/containers/1",
+				"admin_state_up": true
+			}
+		}`)
+		fmt.Fprintf(w, `{"listener": {"id": "db6fd5ca-7a4f-4b1e-8d27-d6e1c9b7a5f1"}}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestJSONRequest(t, r, `{
+			"pool": {
+				"name": "web",
+				"lb_algorithm": "ROUND_ROBIN",
+				"admin_state_up": true,
+				"session_persistence": null
+			}
+		}`)
+		fmt.Fprintf(w, `{"pool": {"id": "8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4"}}`)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/8eec7b4c-e6ea-4e83-98b6-0ea5e0d4b5a4/members/2a280670-c202-4b0b-a562-34077415aabf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ReconcileChangeSet is the change set turning the exported db_lb
+// loadbalancer into its desired spec: a lower connection limit, no session
+// persistence, and a member replaced by another one.
+var ReconcileChangeSet = loadbalancers.ChangeSet{
+	Listeners: []loadbalancers.ListenerChange{
+		{
+			Action: loadbalancers.ChangeUpdate,
+			Spec: loadbalancers.ListenerSpec{
+				Name:                   "https",
+				Protocol:               "TERMINATED_HTTPS",
+				ProtocolPort:           443,
+				AdminStateUp:           true,
+				ConnLimit:              500,
+				DefaultTlsContainerRef: "https://barbican/v1/containers/1",
+			},
+		},
+	},
+	Pools: []loadbalancers.PoolChange{
+		{
+			Action: loadbalancers.ChangeUpdate,
+			Pool:   loadbalancers.PoolRef{ListenerPort: 443},
+			Spec: loadbalancers.PoolSpec{
+				Name:         "web",
+				Protocol:     "HTTP",
+				LBMethod:     "ROUND_ROBIN",
+				AdminStateUp: true,
+			},
+		},
+	},
+	Members: []loadbalancers.MemberChange{
+		{
+			Action: loadbalancers.ChangeDelete,
+			Pool:   loadbalancers.PoolRef{ListenerPort: 443},
+			Spec: loadbalancers.MemberSpec{
+				Address:      "10.0.2.10",
+				ProtocolPort: 80,
+				Weight:       golangsdk.IntToPointer(5),
+				SubnetID:     "1981f108-3c48-48d2-b908-30f7d28532c9",
+				AdminStateUp: true,
+			},
+		},
+	},
+}
+
+// LoadbalancerHealthStatusesTree is the status tree of the db_lb load
+// balancer, whose pool is shared by two listeners.
+const LoadbalancerHealthStatusesTree = `
+{
+	"statuses": {
+		"loadbalancer": {
+			"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+			"name": "db_lb",
+			"operating_status": "DEGRADED",
+			"listeners": [
+				{
+					"id": "db902c0c-d5ff-4753-b465-668ad9656918",
+					"pools": [{
+						"id": "fad389a3-9a4a-4762-a365-8c7038508b5d",
+						"members": [
+							{"id": "2a280670-c202-4b0b-a562-34077415aabf", "name": "db1", "address": "10.0.2.11", "protocol_port": 5432, "operating_status": "ONLINE"},
+							{"id": "7d19ad6c-d549-453e-a5cd-05382c6be96a", "name": "db2", "address": "10.0.2.12", "protocol_port": 5432, "operating_status": "ERROR"},
+							{"id": "9a1c7f0e-3a64-4b57-9a7d-61f4fb1c0e2d", "name": "db3", "address": "10.0.2.13", "protocol_port": 5432, "operating_status": "DRAINING"}
+						]
+					}]
+				},
+				{
+					"id": "5a3d8f2e-bb5a-4c53-8f4c-6c2b7ad0f5b4",
+					"pools": [{
+						"id": "fad389a3-9a4a-4762-a365-8c7038508b5d",
+						"members": [
+							{"id": "7d19ad6c-d549-453e-a5cd-05382c6be96a", "name": "db2", "address": "10.0.2.12", "protocol_port": 5432, "operating_status": "ERROR"}
+						]
+					}]
+				}
+			]
+		}
+	}
+}
+`
+
+// HandleLoadbalancerHealthReport sets up the test server to respond to the
+// status tree requests of a health report. The status tree of the web_lb load
+// balancer fails to be retrieved.
+func HandleLoadbalancerHealthReport(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab/statuses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, LoadbalancerHealthStatusesTree)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/c331058c-6a40-4144-948e-b9fb1df9db4b/statuses", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+}
+
+// RollMembersListBody is the canned body of the members of the pool replaced
+// by RollMembers.
+const RollMembersListBody = `
+{
+	"members": [
+		{"id": "2a280670-c202-4b0b-a562-34077415aabf", "name": "web1", "address": "10.0.2.10", "protocol_port": 80, "weight": 10, "admin_state_up": true},
+		{"id": "fad389a3-9a4a-4762-a365-8c7038508b5d", "name": "web2", "address": "10.0.2.11", "protocol_port": 80, "weight": 10, "admin_state_up": true}
+	]
+}
+`
+
+// RolledMemberBody is the canned body of a member created by RollMembers,
+// formatted with its ID, name and address.
+const RolledMemberBody = `
+{
+	"member": {
+		"id": "%s",
+		"name": "%s",
+		"address": "%s",
+		"protocol_port": 80,
+		"weight": 10,
+		"admin_state_up": true,
+		"provisioning_status": "ACTIVE",
+		"operating_status": "ONLINE"
+	}
+}
+`
+
+// HandleLoadbalancerRollMembersSuccessfully sets up the test server to respond
+// to the requests of RollMembers replacing the members 10.0.2.10 and
+// 10.0.2.11 of a pool of the db_lb loadbalancer by 10.0.2.12 and 10.0.2.13.
+// It returns the requests received, in order.
+func HandleLoadbalancerRollMembersSuccessfully(t *testing.T) *[]string {
+	var requests []string
+	rolled := map[string][]string{
+		"10.0.2.12": {"b6bfc5ab-6c65-4a47-9a6c-ef3a9c2e4c7d", "web3"},
+		"10.0.2.13": {"c81a3ce5-8f0e-4c1b-a4a4-3f2b1d6e9a21", "web4"},
+	}
+
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		requests = append(requests, "GET loadbalancer")
+		fmt.Fprintf(w, strings.Replace(SingleLoadbalancerBody, "PENDING_CREATE", "ACTIVE", 1))
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/332abe93-f488-41ba-870b-2ac66be7f853/members", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, RollMembersListBody)
+		case "POST":
+			var body struct {
+				Member pools.CreateMemberOpts `json:"member"`
+			}
+			th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+			member, ok := rolled[body.Member.Address]
+			if !ok {
+				t.Fatalf("Unexpected member %s", body.Member.Address)
+			}
+			requests = append(requests, "POST "+body.Member.Address)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, RolledMemberBody, member[0], member[1], body.Member.Address)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/332abe93-f488-41ba-870b-2ac66be7f853/members/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		id := strings.TrimPrefix(r.URL.Path, "/v2.0/lbaas/pools/332abe93-f488-41ba-870b-2ac66be7f853/members/")
+		requests = append(requests, r.Method+" "+id)
+		w.Header().Add("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			for address, member := range rolled {
+				if member[0] == id {
+					fmt.Fprintf(w, RolledMemberBody, member[0], member[1], address)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"member": {"admin_state_up": true, "weight": 0}}`)
+			fmt.Fprintf(w, `{"member": {"id": "%s", "weight": 0, "admin_state_up": true}}`, id)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	return &requests
+}
@@ -1,11 +1,18 @@
 package testing
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/huaweicloud/golangsdk"
 	fake "github.com/huaweicloud/golangsdk/openstack/networking/v2/common"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
 	"github.com/huaweicloud/golangsdk/pagination"
 	th "github.com/huaweicloud/golangsdk/testhelper"
 )
@@ -53,6 +60,59 @@ func TestListAllLoadbalancers(t *testing.T) {
 	th.CheckDeepEquals(t, LoadbalancerDb, actual[1])
 }
 
+func TestEachLB(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerEachSuccessfully(t)
+
+	var actual []loadbalancers.LoadBalancer
+	err := loadbalancers.EachLB(fake.ServiceClient(), loadbalancers.ListOpts{}, func(lb loadbalancers.LoadBalancer) error {
+		actual = append(actual, lb)
+		return nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []loadbalancers.LoadBalancer{LoadbalancerWeb, LoadbalancerDb}, actual)
+}
+
+func TestEachLBStops(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerEachSuccessfully(t)
+
+	stop := errors.New("stop")
+	var names []string
+	err := loadbalancers.EachLB(fake.ServiceClient(), loadbalancers.ListOpts{}, func(lb loadbalancers.LoadBalancer) error {
+		names = append(names, lb.Name)
+		return stop
+	})
+	th.AssertEquals(t, stop, err)
+	th.CheckDeepEquals(t, []string{LoadbalancerWeb.Name}, names)
+}
+
+func TestExtractLoadbalancersInto(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerListSuccessfully(t)
+
+	type VendorExt struct {
+		VendorTag string `json:"vendor_tag"`
+	}
+	type extendedLoadBalancer struct {
+		loadbalancers.LoadBalancer
+		VendorExt
+	}
+
+	allPages, err := loadbalancers.List(fake.ServiceClient(), loadbalancers.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+
+	var actual []extendedLoadBalancer
+	err = loadbalancers.ExtractLoadBalancersInto(allPages, &actual)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(actual))
+	th.CheckDeepEquals(t, LoadbalancerWeb, actual[0].LoadBalancer)
+	th.CheckDeepEquals(t, LoadbalancerDb, actual[1].LoadBalancer)
+}
+
 func TestCreateLoadbalancer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -90,6 +150,23 @@ func TestRequiredCreateOpts(t *testing.T) {
 	}
 }
 
+func TestCreateOptsVipNetwork(t *testing.T) {
+	opts := loadbalancers.CreateOpts{
+		Name:         "db_lb",
+		VipNetworkID: "f88c8fe4-b2e4-4f63-b1e2-6bbcbd8d9a4b",
+	}
+	expected := map[string]interface{}{
+		"loadbalancer": map[string]interface{}{
+			"name":           "db_lb",
+			"vip_network_id": "f88c8fe4-b2e4-4f63-b1e2-6bbcbd8d9a4b",
+		},
+	}
+
+	actual, err := opts.ToLoadBalancerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
 func TestGetLoadbalancer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -118,6 +195,20 @@ func TestGetLoadbalancerStatusesTree(t *testing.T) {
 	th.CheckDeepEquals(t, LoadbalancerStatusesTree, *(actual.Loadbalancer))
 }
 
+func TestGetLoadbalancerInto(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerGetSuccessfully(t)
+
+	var actual struct {
+		loadbalancers.LoadBalancer
+		Extra string `json:"extra"`
+	}
+	err := loadbalancers.Get(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab").ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LoadbalancerDb, actual.LoadBalancer)
+}
+
 func TestDeleteLoadbalancer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -159,3 +250,275 @@ func TestCascadingDeleteLoadbalancer(t *testing.T) {
 	err = loadbalancers.CascadingDelete(sc, "36e08a3e-a78f-4b40-a229-1e7e23eee1ab").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateLoadbalancerOperation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerCreationSuccessfully(t, SingleLoadbalancerBody)
+	HandleLoadbalancerGetActive(t)
+
+	op := loadbalancers.Create(fake.ServiceClient(), loadbalancers.CreateOpts{
+		Name:         "db_lb",
+		AdminStateUp: golangsdk.Enabled,
+		VipSubnetID:  "9cedb85d-0759-4898-8a4b-fa5a5ea10086",
+		VipAddress:   "10.30.176.48",
+		Flavor:       "medium",
+		Provider:     "haproxy",
+	}).Operation(fake.ServiceClient())
+	th.AssertEquals(t, false, op.Done())
+
+	op.Interval = time.Millisecond
+	actual, err := op.Wait(context.Background())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, op.Done())
+	th.AssertEquals(t, "ACTIVE", actual.ProvisioningStatus)
+}
+
+func TestSuspendPendingLoadbalancer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerGetSuccessfully(t)
+
+	err := loadbalancers.Suspend(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab").Err
+	if _, ok := err.(loadbalancers.ErrNotTransitionable); !ok {
+		t.Fatalf("Expected ErrNotTransitionable, got %v", err)
+	}
+}
+
+func TestExportLoadbalancer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerExportSuccessfully(t)
+
+	spec, err := loadbalancers.Export(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExportedLoadbalancerSpec, *spec)
+}
+
+func TestExportLoadbalancerSharedPool(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerSharedPoolExportSuccessfully(t)
+
+	spec, err := loadbalancers.Export(fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab")
+	th.AssertNoErr(t, err)
+
+	pool := &loadbalancers.PoolSpec{
+		Name:         "web",
+		Protocol:     "HTTP",
+		LBMethod:     "ROUND_ROBIN",
+		AdminStateUp: true,
+	}
+	th.AssertEquals(t, 2, len(spec.Listeners))
+	th.CheckDeepEquals(t, pool, spec.Listeners[0].DefaultPool)
+	th.CheckDeepEquals(t, pool, spec.Listeners[1].DefaultPool)
+	th.AssertEquals(t, 0, len(spec.Pools))
+}
+
+func TestDiffLoadbalancerSpec(t *testing.T) {
+	actual := ExportedLoadbalancerSpec
+	th.AssertEquals(t, true, loadbalancers.Diff(actual, actual).IsEmpty())
+
+	desired := ExportedLoadbalancerSpec
+	listener := desired.Listeners[0]
+	listener.ConnLimit = 500
+	listener.Whitelist = nil
+	pool := *listener.DefaultPool
+	pool.Persistence = nil
+	pool.Members = nil
+	pool.Monitor = nil
+	listener.DefaultPool = &pool
+	desired.Listeners = []loadbalancers.ListenerSpec{listener}
+
+	cs := loadbalancers.Diff(desired, actual)
+	expected := ReconcileChangeSet
+	expected.Listeners = []loadbalancers.ListenerChange{ReconcileChangeSet.Listeners[0]}
+	expected.Listeners[0].Spec.DefaultPool = &pool
+	th.CheckDeepEquals(t, expected, cs)
+}
+
+func TestDiffMemberWeight(t *testing.T) {
+	actual := ExportedLoadbalancerSpec
+	withMember := func(member loadbalancers.MemberSpec) loadbalancers.LoadBalancerSpec {
+		desired := actual
+		listener := desired.Listeners[0]
+		pool := *listener.DefaultPool
+		pool.Members = []loadbalancers.MemberSpec{member}
+		listener.DefaultPool = &pool
+		desired.Listeners = []loadbalancers.ListenerSpec{listener}
+		return desired
+	}
+	member := actual.Listeners[0].DefaultPool.Members[0]
+
+	member.Weight = nil
+	th.AssertEquals(t, true, loadbalancers.Diff(withMember(member), actual).IsEmpty())
+
+	member.Weight = golangsdk.IntToPointer(10)
+	cs := loadbalancers.Diff(withMember(member), actual)
+	th.AssertEquals(t, 1, len(cs.Members))
+	th.AssertEquals(t, loadbalancers.ChangeUpdate, cs.Members[0].Action)
+}
+
+func TestApplyLoadbalancerChangeSet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerApplySuccessfully(t)
+
+	err := loadbalancers.Apply(context.Background(), fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", ReconcileChangeSet)
+	th.AssertNoErr(t, err)
+}
+
+func TestWatchLoadbalancers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	bodies := []string{
+		`{"loadbalancers": [{"id": "a", "name": "web"}, {"id": "b", "name": "db"}]}`,
+		`{"loadbalancers": [{"id": "a", "name": "web2"}, {"id": "c", "name": "cache"}]}`,
+	}
+	calls := 0
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		body := bodies[len(bodies)-1]
+		if calls < len(bodies) {
+			body = bodies[calls]
+		}
+		calls++
+		fmt.Fprintf(w, body)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var events []string
+	for event := range loadbalancers.Watch(ctx, fake.ServiceClient(), nil, 10*time.Millisecond) {
+		th.AssertNoErr(t, event.Err)
+		events = append(events, fmt.Sprintf("%s %s %s", event.Type, event.LoadBalancer.ID, event.LoadBalancer.Name))
+		if len(events) == 5 {
+			cancel()
+		}
+	}
+
+	sort.Strings(events[:2])
+	sort.Strings(events[2:])
+	th.CheckDeepEquals(t, []string{
+		"ADDED a web",
+		"ADDED b db",
+		"ADDED c cache",
+		"DELETED b db",
+		"MODIFIED a web2",
+	}, events)
+}
+
+func TestLoadbalancerHealthReport(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLoadbalancerListSuccessfully(t)
+	HandleLoadbalancerHealthReport(t)
+
+	report, err := loadbalancers.HealthReport(context.Background(), fake.ServiceClient(), loadbalancers.HealthReportOpts{
+		ListOpts:    loadbalancers.ListOpts{},
+		Parallelism: 2,
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(report.LoadBalancers))
+
+	web := report.LoadBalancers[0]
+	th.AssertEquals(t, "c331058c-6a40-4144-948e-b9fb1df9db4b", web.ID)
+	if web.Err == nil {
+		t.Fatalf("Expected an error for the web_lb status tree")
+	}
+
+	db := report.LoadBalancers[1]
+	th.AssertNoErr(t, db.Err)
+	th.AssertEquals(t, "DEGRADED", db.OperatingStatus)
+	th.AssertEquals(t, 3, db.Members)
+	th.CheckDeepEquals(t, []loadbalancers.NodeHealth{{
+		ListenerID:      "db902c0c-d5ff-4753-b465-668ad9656918",
+		PoolID:          "fad389a3-9a4a-4762-a365-8c7038508b5d",
+		MemberID:        "7d19ad6c-d549-453e-a5cd-05382c6be96a",
+		Name:            "db2",
+		Address:         "10.0.2.12",
+		ProtocolPort:    5432,
+		OperatingStatus: "ERROR",
+	}}, db.Offline)
+	th.AssertEquals(t, 1, len(db.Draining))
+	th.AssertEquals(t, "db3", db.Draining[0].Name)
+
+	th.AssertEquals(t, 2, len(report.Unhealthy()))
+}
+
+func TestProvisioningStatus(t *testing.T) {
+	pending := loadbalancers.ProvisioningStatus("PENDING_UPDATE")
+	th.AssertEquals(t, true, pending.IsTransient())
+	th.AssertEquals(t, false, pending.IsTerminal())
+	th.AssertEquals(t, false, pending.CanUpdate())
+
+	th.AssertEquals(t, true, loadbalancers.StatusActive.CanUpdate())
+	th.AssertEquals(t, true, loadbalancers.StatusError.IsTerminal())
+	th.AssertEquals(t, false, loadbalancers.StatusError.CanUpdate())
+
+	unknown := loadbalancers.ProvisioningStatus("DEGRADED")
+	th.AssertEquals(t, false, unknown.IsTransient())
+	th.AssertEquals(t, false, unknown.IsTerminal())
+
+	th.AssertEquals(t, true, loadbalancers.StatusPendingCreate.CanTransitionTo(loadbalancers.StatusActive))
+	th.AssertEquals(t, true, loadbalancers.StatusError.CanTransitionTo(loadbalancers.StatusPendingDelete))
+	th.AssertEquals(t, true, loadbalancers.StatusActive.CanTransitionTo(loadbalancers.StatusActive))
+	th.AssertEquals(t, false, loadbalancers.StatusActive.CanTransitionTo(loadbalancers.StatusDeleted))
+	th.AssertEquals(t, false, loadbalancers.StatusDeleted.CanTransitionTo(loadbalancers.StatusActive))
+}
+
+func TestRollMembers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	requests := HandleLoadbalancerRollMembersSuccessfully(t)
+
+	newMembers := []pools.CreateMemberOpts{
+		{Name: "web3", Address: "10.0.2.12", ProtocolPort: 80, Weight: golangsdk.IntToPointer(10)},
+		{Name: "web4", Address: "10.0.2.13", ProtocolPort: 80, Weight: golangsdk.IntToPointer(10)},
+	}
+	var events []string
+	err := loadbalancers.RollMembers(context.Background(), fake.ServiceClient(),
+		"36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "332abe93-f488-41ba-870b-2ac66be7f853", newMembers, loadbalancers.RollStrategy{
+			BatchSize:      1,
+			HealthInterval: time.Millisecond,
+			ActiveConnections: func(ctx context.Context, members []pools.Member) (int, error) {
+				th.AssertEquals(t, 1, len(members))
+				return 0, nil
+			},
+			Observer: func(e loadbalancers.RollEvent) {
+				events = append(events, fmt.Sprintf("%d/%d %s %s", e.Batch, e.Batches, e.Phase, e.Member.Address))
+			},
+		})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{
+		"0/2 ADDED 10.0.2.12",
+		"0/2 HEALTHY 10.0.2.12",
+		"0/2 DRAINING 10.0.2.10",
+		"0/2 REMOVED 10.0.2.10",
+		"1/2 ADDED 10.0.2.13",
+		"1/2 HEALTHY 10.0.2.13",
+		"1/2 DRAINING 10.0.2.11",
+		"1/2 REMOVED 10.0.2.11",
+	}, events)
+
+	// Each change of a member is followed by a wait for the load balancer.
+	th.CheckDeepEquals(t, []string{
+		"POST 10.0.2.12",
+		"GET loadbalancer",
+		"GET b6bfc5ab-6c65-4a47-9a6c-ef3a9c2e4c7d",
+		"PUT 2a280670-c202-4b0b-a562-34077415aabf",
+		"GET loadbalancer",
+		"DELETE 2a280670-c202-4b0b-a562-34077415aabf",
+		"GET loadbalancer",
+		"POST 10.0.2.13",
+		"GET loadbalancer",
+		"GET c81a3ce5-8f0e-4c1b-a4a4-3f2b1d6e9a21",
+		"PUT fad389a3-9a4a-4762-a365-8c7038508b5d",
+		"GET loadbalancer",
+		"DELETE fad389a3-9a4a-4762-a365-8c7038508b5d",
+		"GET loadbalancer",
+	}, *requests)
+}
@@ -0,0 +1,112 @@
+package loadbalancers
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// WatchEventType is the kind of change reported by Watch.
+type WatchEventType string
+
+const (
+	WatchAdded    WatchEventType = "ADDED"
+	WatchModified WatchEventType = "MODIFIED"
+	WatchDeleted  WatchEventType = "DELETED"
+)
+
+// WatchEvent is a change of a load balancer, or a listing error when Err is
+// set.
+type WatchEvent struct {
+	Type         WatchEventType
+	LoadBalancer LoadBalancer
+	Err          error
+}
+
+// Watch lists the load balancers every interval and sends an event on the
+// returned channel for each load balancer added, modified or deleted since
+// the previous listing. The first listing reports every load balancer as
+// added. The channel is closed once ctx is done.
+//
+// The LBaaS v2 API has no changes-since filter, so each poll lists the whole
+// collection and compares it with the previous one. Listing errors are sent
+// as events, and the next poll compares with the last successful listing.
+func Watch(ctx context.Context, c *golangsdk.ServiceClient, opts ListOptsBuilder, interval time.Duration) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	go func() {
+		defer close(events)
+
+		send := func(event WatchEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var known map[string]LoadBalancer
+		for {
+			current, err := listByID(c, opts)
+			if err != nil {
+				if !send(WatchEvent{Err: err}) {
+					return
+				}
+			} else {
+				for _, event := range diffSnapshots(known, current) {
+					if !send(event) {
+						return
+					}
+				}
+				known = current
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return events
+}
+
+func listByID(c *golangsdk.ServiceClient, opts ListOptsBuilder) (map[string]LoadBalancer, error) {
+	allPages, err := List(c, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	lbs, err := ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]LoadBalancer, len(lbs))
+	for _, lb := range lbs {
+		byID[lb.ID] = lb
+	}
+	return byID, nil
+}
+
+func diffSnapshots(previous, current map[string]LoadBalancer) []WatchEvent {
+	var events []WatchEvent
+	for id, lb := range current {
+		old, ok := previous[id]
+		switch {
+		case !ok:
+			events = append(events, WatchEvent{Type: WatchAdded, LoadBalancer: lb})
+		case !reflect.DeepEqual(old, lb):
+			events = append(events, WatchEvent{Type: WatchModified, LoadBalancer: lb})
+		}
+	}
+	for id, lb := range previous {
+		if _, ok := current[id]; !ok {
+			events = append(events, WatchEvent{Type: WatchDeleted, LoadBalancer: lb})
+		}
+	}
+	return events
+}
@@ -49,6 +49,29 @@ Example to Update a Pool
 		panic(err)
 	}
 
+Example to Set the Session Persistence of a Pool
+
+	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
+
+	persistence := pools.SessionPersistence{
+		Type:       pools.PersistenceAppCookie,
+		CookieName: "JSESSIONID",
+	}
+
+	pool, err := pools.EnablePersistence(networkClient, poolID, persistence).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove the Session Persistence of a Pool
+
+	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
+
+	pool, err := pools.DisablePersistence(networkClient, poolID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Pool
 
 	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
@@ -88,7 +111,7 @@ Example to Create a Member
 		SubnetID:     "1981f108-3c48-48d2-b908-30f7d28532c9",
 		Address:      "10.0.2.11",
 		ProtocolPort: 80,
-		Weight:       10,
+		Weight:       golangsdk.IntToPointer(10),
 	}
 
 	member, err := pools.CreateMember(networkClient, poolID, createOpts).Extract()
@@ -103,7 +126,7 @@ Example to Update a Member
 
 	updateOpts := pools.UpdateMemberOpts{
 		Name:   "new-name",
-		Weight: 4,
+		Weight: golangsdk.IntToPointer(4),
 	}
 
 	member, err := pools.UpdateMember(networkClient, poolID, memberID, updateOpts).Extract()
@@ -111,6 +134,30 @@ Example to Update a Member
 		panic(err)
 	}
 
+Example to Drain a Member
+
+	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	memberID := "64dba99f-8af8-4200-8882-e32a0660f23e"
+
+	member, err := pools.Drain(networkClient, poolID, memberID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Drain a Member and Remove It Once Its Connections Are Closed
+
+	lbID := "79e05663-7f03-45d2-a092-8b94062f22ab"
+	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
+	memberID := "64dba99f-8af8-4200-8882-e32a0660f23e"
+
+	err := pools.DrainAndWait(context.Background(), networkClient, lbID, poolID, memberID, pools.DrainOpts{
+		Timeout: 2 * time.Minute,
+		Then:    pools.DrainRemove,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Member
 
 	poolID := "d67d56a6-4a86-4688-a282-f46444705c64"
@@ -0,0 +1,152 @@
+package pools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Default settings of DrainAndWait.
+const (
+	DefaultDrainTimeout  = 5 * time.Minute
+	DefaultDrainInterval = 10 * time.Second
+)
+
+// DrainAction is what DrainAndWait does with a member once it is drained.
+type DrainAction string
+
+const (
+	// DrainKeep leaves the member draining.
+	DrainKeep DrainAction = ""
+
+	// DrainDisable takes the member out of the pool, see
+	// MemberConditionDisabled.
+	DrainDisable DrainAction = "DISABLE"
+
+	// DrainRemove deletes the member.
+	DrainRemove DrainAction = "REMOVE"
+)
+
+// DrainOpts are the options of DrainAndWait.
+type DrainOpts struct {
+	// Timeout is the longest time to wait for the connections of the member
+	// to close. It defaults to DefaultDrainTimeout.
+	Timeout time.Duration
+
+	// ActiveConnections, if set, returns the number of connections still
+	// open on the member, e.g. from the monitoring of the backend, and is
+	// polled every Interval until it returns 0. The API doesn't report the
+	// connections of a member, so without it DrainAndWait waits for the whole
+	// Timeout.
+	ActiveConnections func(ctx context.Context) (int, error)
+
+	// Interval is the time between two calls of ActiveConnections, and
+	// between two polls of the load balancer before Then is applied. It
+	// defaults to DefaultDrainInterval.
+	Interval time.Duration
+
+	// Then is the action applied to the member once it is drained, or once
+	// the Timeout has expired.
+	Then DrainAction
+
+	// Observer, if set, is called after each call of ActiveConnections.
+	Observer golangsdk.WaitObserver
+}
+
+// DrainAndWait drains a member, waits for its connections to close, and then
+// disables or deletes it as set by opts.Then. This allows to take a backend
+// out of a pool without cutting the requests in progress, e.g. during a
+// deployment.
+//
+// The API refuses to change a member while its load balancer lbID is in a
+// PENDING_* provisioning status, so DrainAndWait waits for the load balancer
+// to be ACTIVE again before applying opts.Then.
+//
+// Reaching opts.Timeout isn't an error: the member is considered drained.
+// DrainAndWait returns the error of ctx if it is done before.
+func DrainAndWait(ctx context.Context, c *golangsdk.ServiceClient, lbID, poolID, memberID string, opts DrainOpts) error {
+	if _, err := Drain(c, poolID, memberID).Extract(); err != nil {
+		return err
+	}
+
+	if err := WaitDrained(ctx, opts); err != nil {
+		return err
+	}
+
+	if opts.Then == DrainKeep {
+		return nil
+	}
+	if err := waitForLoadBalancer(ctx, c, lbID, opts.Interval); err != nil {
+		return err
+	}
+
+	var err error
+	switch opts.Then {
+	case DrainDisable:
+		_, err = UpdateMember(c, poolID, memberID, UpdateMemberOpts{Condition: MemberConditionDisabled}).Extract()
+	case DrainRemove:
+		err = DeleteMember(c, poolID, memberID).ExtractErr()
+	}
+	return err
+}
+
+// WaitDrained waits for opts.ActiveConnections to return 0, or for
+// opts.Timeout to expire, without changing any member. Its Then field is
+// ignored. Like DrainAndWait, it returns the error of ctx if it is done
+// before.
+func WaitDrained(ctx context.Context, opts DrainOpts) error {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var err error
+	if opts.ActiveConnections == nil {
+		<-drainCtx.Done()
+		err = drainCtx.Err()
+	} else {
+		interval := opts.Interval
+		if interval <= 0 {
+			interval = DefaultDrainInterval
+		}
+		backoff := golangsdk.Backoff{Initial: interval, Max: interval, Multiplier: 1}
+		err = golangsdk.WaitForBackoff(drainCtx, backoff, func() (bool, string, error) {
+			n, err := opts.ActiveConnections(drainCtx)
+			return n == 0, fmt.Sprintf("%d active connections", n), err
+		}, opts.Observer)
+	}
+	if err == context.DeadlineExceeded && ctx.Err() == nil {
+		err = nil
+	}
+	return err
+}
+
+// waitForLoadBalancer waits for the load balancer lbID to leave its PENDING_*
+// provisioning status, or for ctx to be done. The load balancer is read
+// directly, as this package can't depend on the loadbalancers one.
+func waitForLoadBalancer(ctx context.Context, c *golangsdk.ServiceClient, lbID string, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultDrainInterval
+	}
+	backoff := golangsdk.Backoff{Initial: interval, Max: interval, Multiplier: 1}
+	return golangsdk.WaitForBackoff(ctx, backoff, func() (bool, string, error) {
+		var s struct {
+			LoadBalancer struct {
+				ProvisioningStatus string `json:"provisioning_status"`
+			} `json:"loadbalancer"`
+		}
+		if _, err := c.Get(loadBalancerURL(c, lbID), &s, nil); err != nil {
+			return false, "", err
+		}
+		status := s.LoadBalancer.ProvisioningStatus
+		if status == "ERROR" {
+			return false, status, fmt.Errorf("Load balancer [%s] went into ERROR provisioning status", lbID)
+		}
+		return !strings.HasPrefix(status, "PENDING_"), status, nil
+	}, nil)
+}
@@ -0,0 +1,65 @@
+package pools
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// PersistenceType is the type of the session persistence of a pool.
+type PersistenceType string
+
+// Supported session persistence types, see SessionPersistence.
+const (
+	PersistenceSourceIP   PersistenceType = "SOURCE_IP"
+	PersistenceHTTPCookie PersistenceType = "HTTP_COOKIE"
+	PersistenceAppCookie  PersistenceType = "APP_COOKIE"
+)
+
+func (p SessionPersistence) validate() error {
+	switch p.Type {
+	case PersistenceSourceIP, PersistenceHTTPCookie:
+		if p.CookieName != "" {
+			err := golangsdk.ErrInvalidInput{}
+			err.Argument = "pools.SessionPersistence.CookieName"
+			err.Value = p.CookieName
+			err.Info = "CookieName is only allowed with " + string(PersistenceAppCookie)
+			return err
+		}
+	case PersistenceAppCookie:
+		if p.CookieName == "" {
+			err := golangsdk.ErrMissingInput{}
+			err.Argument = "pools.SessionPersistence.CookieName"
+			return err
+		}
+	default:
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "pools.SessionPersistence.Type"
+		err.Value = p.Type
+		err.Info = "Type must be SOURCE_IP, HTTP_COOKIE or APP_COOKIE"
+		return err
+	}
+	return nil
+}
+
+// EnablePersistence sets the session persistence of a pool.
+func EnablePersistence(c *golangsdk.ServiceClient, id string, persistence SessionPersistence) (r UpdateResult) {
+	return Update(c, id, UpdateOpts{Persistence: &persistence})
+}
+
+// DisablePersistence removes the session persistence of a pool.
+func DisablePersistence(c *golangsdk.ServiceClient, id string) (r UpdateResult) {
+	return Update(c, id, UpdateOpts{ClearPersistence: true})
+}
+
+// GetPersistence returns the session persistence of a pool, or nil if it has
+// none. The result can be given back to EnablePersistence.
+func GetPersistence(c *golangsdk.ServiceClient, id string) (*SessionPersistence, error) {
+	pool, err := Get(c, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if pool.Persistence.Type == "" {
+		return nil, nil
+	}
+	persistence := pool.Persistence
+	return &persistence, nil
+}
@@ -1,6 +1,8 @@
 package pools
 
 import (
+	"fmt"
+
 	"github.com/huaweicloud/golangsdk"
 	"github.com/huaweicloud/golangsdk/pagination"
 )
@@ -123,6 +125,11 @@ type CreateOpts struct {
 
 // ToPoolCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToPoolCreateMap() (map[string]interface{}, error) {
+	if opts.Persistence != nil {
+		if err := opts.Persistence.validate(); err != nil {
+			return nil, err
+		}
+	}
 	return golangsdk.BuildRequestBody(opts, "pool")
 }
 
@@ -134,13 +141,15 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular pool based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -167,11 +176,37 @@ type UpdateOpts struct {
 	// The administrative state of the Pool. A valid value is true (UP)
 	// or false (DOWN).
 	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+
+	// Persistence is the session persistence of the pool.
+	Persistence *SessionPersistence `json:"session_persistence,omitempty"`
+
+	// ClearPersistence removes the session persistence of the pool. It can't
+	// be set together with Persistence.
+	ClearPersistence bool `json:"-"`
 }
 
 // ToPoolUpdateMap builds a request body from UpdateOpts.
 func (opts UpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
-	return golangsdk.BuildRequestBody(opts, "pool")
+	if opts.Persistence != nil {
+		if opts.ClearPersistence {
+			err := golangsdk.ErrInvalidInput{}
+			err.Argument = "pools.UpdateOpts.ClearPersistence"
+			err.Value = opts.ClearPersistence
+			err.Info = "ClearPersistence and Persistence are mutually exclusive"
+			return nil, err
+		}
+		if err := opts.Persistence.validate(); err != nil {
+			return nil, err
+		}
+	}
+	b, err := golangsdk.BuildRequestBody(opts, "pool")
+	if err != nil {
+		return nil, err
+	}
+	if opts.ClearPersistence {
+		b["pool"].(map[string]interface{})["session_persistence"] = nil
+	}
+	return b, nil
 }
 
 // Update allows pools to be updated.
@@ -181,15 +216,17 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular pool based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -273,8 +310,8 @@ type CreateMemberOpts struct {
 	// A positive integer value that indicates the relative portion of traffic
 	// that  this member should receive from the pool. For example, a member with
 	// a weight  of 10 receives five times as much traffic as a member with a
-	// weight of 2.
-	Weight int `json:"weight,omitempty"`
+	// weight of 2. A member with a weight of 0 receives no new connections.
+	Weight *int `json:"weight,omitempty"`
 
 	// If you omit this parameter, LBaaS uses the vip_subnet_id parameter value
 	// for the subnet UUID.
@@ -297,13 +334,15 @@ func CreateMember(c *golangsdk.ServiceClient, poolID string, opts CreateMemberOp
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(memberRootURL(c, poolID), b, &r.Body, nil)
+	resp, err := c.Post(memberRootURL(c, poolID), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // GetMember retrieves a particular Pool Member based on its unique ID.
 func GetMember(c *golangsdk.ServiceClient, poolID string, memberID string) (r GetMemberResult) {
-	_, r.Err = c.Get(memberResourceURL(c, poolID, memberID), &r.Body, nil)
+	resp, err := c.Get(memberResourceURL(c, poolID, memberID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -313,6 +352,34 @@ type UpdateMemberOptsBuilder interface {
 	ToMemberUpdateMap() (map[string]interface{}, error)
 }
 
+// MemberCondition is the traffic condition of a Member.
+type MemberCondition string
+
+// MemberType is the role of a Member in its Pool.
+type MemberType string
+
+const (
+	// MemberConditionEnabled makes the member receive traffic.
+	MemberConditionEnabled MemberCondition = "ENABLED"
+
+	// MemberConditionDisabled takes the member out of the pool.
+	MemberConditionDisabled MemberCondition = "DISABLED"
+
+	// MemberConditionDraining keeps the member up for its established
+	// connections, but stops sending it new ones by setting its weight to 0.
+	MemberConditionDraining MemberCondition = "DRAINING"
+
+	// MemberTypePrimary is a regular member of the pool.
+	MemberTypePrimary MemberType = "PRIMARY"
+
+	// MemberTypeSecondary is a backup member, which only receives traffic when
+	// all the primary members are down.
+	MemberTypeSecondary MemberType = "SECONDARY"
+
+	// MaxMemberWeight is the highest weight accepted for a Member.
+	MaxMemberWeight = 256
+)
+
 // UpdateMemberOpts is the common options struct used in this package's Update
 // operation.
 type UpdateMemberOpts struct {
@@ -322,17 +389,85 @@ type UpdateMemberOpts struct {
 	// A positive integer value that indicates the relative portion of traffic
 	// that this member should receive from the pool. For example, a member with
 	// a weight of 10 receives five times as much traffic as a member with a
-	// weight of 2.
-	Weight int `json:"weight,omitempty"`
+	// weight of 2. A member with a weight of 0 receives no new connections.
+	Weight *int `json:"weight,omitempty"`
 
 	// The administrative state of the Pool. A valid value is true (UP)
 	// or false (DOWN).
 	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+
+	// Condition sets the administrative state of the member, or drains it. It
+	// cannot be combined with AdminStateUp, nor with a Weight when draining.
+	Condition MemberCondition `json:"-"`
+
+	// Type makes the member a primary or a backup member.
+	Type MemberType `json:"-"`
 }
 
 // ToMemberUpdateMap builds a request body from UpdateMemberOpts.
 func (opts UpdateMemberOpts) ToMemberUpdateMap() (map[string]interface{}, error) {
-	return golangsdk.BuildRequestBody(opts, "member")
+	if opts.Weight != nil && (*opts.Weight < 0 || *opts.Weight > MaxMemberWeight) {
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "pools.UpdateMemberOpts.Weight"
+		err.Value = *opts.Weight
+		err.Info = fmt.Sprintf("Weight must be between 0 and %d", MaxMemberWeight)
+		return nil, err
+	}
+
+	b, err := golangsdk.BuildRequestBody(opts, "member")
+	if err != nil {
+		return nil, err
+	}
+	member := b["member"].(map[string]interface{})
+
+	if opts.Condition != "" {
+		if opts.AdminStateUp != nil {
+			err := golangsdk.ErrInvalidInput{}
+			err.Argument = "pools.UpdateMemberOpts.Condition"
+			err.Value = opts.Condition
+			err.Info = "Condition and AdminStateUp are mutually exclusive"
+			return nil, err
+		}
+
+		switch opts.Condition {
+		case MemberConditionEnabled:
+			member["admin_state_up"] = true
+		case MemberConditionDisabled:
+			member["admin_state_up"] = false
+		case MemberConditionDraining:
+			if opts.Weight != nil && *opts.Weight != 0 {
+				err := golangsdk.ErrInvalidInput{}
+				err.Argument = "pools.UpdateMemberOpts.Weight"
+				err.Value = *opts.Weight
+				err.Info = "A draining member must not be given a weight"
+				return nil, err
+			}
+			member["admin_state_up"] = true
+			member["weight"] = 0
+		default:
+			err := golangsdk.ErrInvalidInput{}
+			err.Argument = "pools.UpdateMemberOpts.Condition"
+			err.Value = opts.Condition
+			err.Info = "Condition must be ENABLED, DISABLED or DRAINING"
+			return nil, err
+		}
+	}
+
+	switch opts.Type {
+	case "":
+	case MemberTypePrimary:
+		member["backup"] = false
+	case MemberTypeSecondary:
+		member["backup"] = true
+	default:
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "pools.UpdateMemberOpts.Type"
+		err.Value = opts.Type
+		err.Info = "Type must be PRIMARY or SECONDARY"
+		return nil, err
+	}
+
+	return b, nil
 }
 
 // Update allows Member to be updated.
@@ -342,15 +477,24 @@ func UpdateMember(c *golangsdk.ServiceClient, poolID string, memberID string, op
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(memberResourceURL(c, poolID, memberID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(memberResourceURL(c, poolID, memberID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
+// Drain stops sending new connections to a Member, while keeping it up for
+// the established ones. It is a shortcut for an UpdateMember with the
+// DRAINING condition.
+func Drain(c *golangsdk.ServiceClient, poolID string, memberID string) (r UpdateMemberResult) {
+	return UpdateMember(c, poolID, memberID, UpdateMemberOpts{Condition: MemberConditionDraining})
+}
+
 // DisassociateMember will remove and disassociate a Member from a particular
 // Pool.
 func DeleteMember(c *golangsdk.ServiceClient, poolID string, memberID string) (r DeleteMemberResult) {
-	_, r.Err = c.Delete(memberResourceURL(c, poolID, memberID), nil)
+	resp, err := c.Delete(memberResourceURL(c, poolID, memberID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -9,7 +9,7 @@ import (
 // SessionPersistence represents the session persistence feature of the load
 // balancing service. It attempts to force connections or requests in the same
 // session to be processed by the same member as long as it is ative. Three
-// types of persistence are supported:
+// types of persistence are supported, see PersistenceType:
 //
 // SOURCE_IP:   With this mode, all connections originating from the same source
 //              IP address, will be handled by the same Member of the Pool.
@@ -23,9 +23,10 @@ import (
 //              same Member of the Pool.
 type SessionPersistence struct {
 	// The type of persistence mode.
-	Type string `json:"type"`
+	Type PersistenceType `json:"type"`
 
-	// Name of cookie if persistence mode is set appropriately.
+	// Name of cookie, only allowed with PersistenceAppCookie where it is
+	// required.
 	CookieName string `json:"cookie_name,omitempty"`
 }
 
@@ -204,6 +205,34 @@ type Member struct {
 	// The provisioning status of the member.
 	// This value is ACTIVE, PENDING_* or ERROR.
 	ProvisioningStatus string `json:"provisioning_status"`
+
+	// The operating status of the member, as reported by its health monitor.
+	// This value is ONLINE, DRAINING, OFFLINE, ERROR or NO_MONITOR.
+	OperatingStatus string `json:"operating_status"`
+
+	// Backup is true for a member which only receives traffic when all the
+	// other members are down.
+	Backup bool `json:"backup"`
+}
+
+// Condition returns the traffic condition of the Member, as set by
+// UpdateMemberOpts.Condition.
+func (m Member) Condition() MemberCondition {
+	switch {
+	case !m.AdminStateUp:
+		return MemberConditionDisabled
+	case m.Weight == 0:
+		return MemberConditionDraining
+	}
+	return MemberConditionEnabled
+}
+
+// Type returns the role of the Member in its Pool.
+func (m Member) Type() MemberType {
+	if m.Backup {
+		return MemberTypeSecondary
+	}
+	return MemberTypePrimary
 }
 
 // MemberPage is the page returned by a pager when traversing over a
@@ -386,3 +386,106 @@ func HandleMemberUpdateSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, PostUpdateMemberBody)
 	})
 }
+
+// HandleMemberDrainSuccessfully sets up the test server to respond to a member
+// Update request draining the member.
+func HandleMemberDrainSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/332abe93-f488-41ba-870b-2ac66be7f853/members/2a280670-c202-4b0b-a562-34077415aabf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"member": {
+				"admin_state_up": true,
+				"weight": 0
+			}
+		}`)
+
+		fmt.Fprintf(w, PostUpdateMemberBody)
+	})
+}
+
+// HandleMemberDrainAndRemoveSuccessfully sets up the test server to respond
+// to a member Update request draining the member, followed by its deletion.
+// The load balancer of the pool stays in PENDING_UPDATE for the given number
+// of Get requests after the drain, and the deletion fails with a 409 until
+// then, as with the real API.
+func HandleMemberDrainAndRemoveSuccessfully(t *testing.T, pending int) {
+	th.Mux.HandleFunc("/v2.0/lbaas/loadbalancers/36e08a3e-a78f-4b40-a229-1e7e23eee1ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := "ACTIVE"
+		if pending > 0 {
+			status = "PENDING_UPDATE"
+			pending--
+		}
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "36e08a3e-a78f-4b40-a229-1e7e23eee1ab", "provisioning_status": %q}}`, status)
+	})
+
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/332abe93-f488-41ba-870b-2ac66be7f853/members/2a280670-c202-4b0b-a562-34077415aabf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			th.TestJSONRequest(t, r, `{
+				"member": {
+					"admin_state_up": true,
+					"weight": 0
+				}
+			}`)
+			fmt.Fprintf(w, PostUpdateMemberBody)
+		case "DELETE":
+			if pending > 0 {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+// PersistentPoolBody is the canned body of a Get request on a pool with
+// session persistence.
+const PersistentPoolBody = `
+{
+	"pool": {
+		"id": "72741b06-df4d-4715-b142-276b6bce75ab",
+		"name": "app",
+		"lb_algorithm": "ROUND_ROBIN",
+		"protocol": "HTTP",
+		"admin_state_up": true,
+		"session_persistence": {
+			"type": "APP_COOKIE",
+			"cookie_name": "JSESSIONID"
+		}
+	}
+}
+`
+
+// HandlePoolPersistenceSuccessfully sets up the test server to respond to a
+// pool Get request, and to an Update request setting the same session
+// persistence.
+func HandlePoolPersistenceSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/pools/72741b06-df4d-4715-b142-276b6bce75ab", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		switch r.Method {
+		case "GET":
+		case "PUT":
+			th.TestJSONRequest(t, r, `{
+				"pool": {
+					"session_persistence": {
+						"type": "APP_COOKIE",
+						"cookie_name": "JSESSIONID"
+					}
+				}
+			}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprintf(w, PersistentPoolBody)
+	})
+}
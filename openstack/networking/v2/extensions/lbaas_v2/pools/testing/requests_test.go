@@ -1,8 +1,11 @@
 package testing
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/huaweicloud/golangsdk"
 	fake "github.com/huaweicloud/golangsdk/openstack/networking/v2/common"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/pools"
 	"github.com/huaweicloud/golangsdk/pagination"
@@ -195,7 +198,7 @@ func TestCreateMember(t *testing.T) {
 		TenantID:     "2ffc6e22aae24e4795f87155d24c896f",
 		Address:      "10.0.2.11",
 		ProtocolPort: 80,
-		Weight:       10,
+		Weight:       golangsdk.IntToPointer(10),
 	}).Extract()
 	th.AssertNoErr(t, err)
 
@@ -252,7 +255,7 @@ func TestUpdateMember(t *testing.T) {
 	client := fake.ServiceClient()
 	actual, err := pools.UpdateMember(client, "332abe93-f488-41ba-870b-2ac66be7f853", "2a280670-c202-4b0b-a562-34077415aabf", pools.UpdateMemberOpts{
 		Name:   "newMemberName",
-		Weight: 4,
+		Weight: golangsdk.IntToPointer(4),
 	}).Extract()
 	if err != nil {
 		t.Fatalf("Unexpected Update error: %v", err)
@@ -260,3 +263,151 @@ func TestUpdateMember(t *testing.T) {
 
 	th.CheckDeepEquals(t, MemberUpdated, *actual)
 }
+
+func TestDrainMember(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMemberDrainSuccessfully(t)
+
+	client := fake.ServiceClient()
+	actual, err := pools.Drain(client, "332abe93-f488-41ba-870b-2ac66be7f853", "2a280670-c202-4b0b-a562-34077415aabf").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, MemberUpdated, *actual)
+}
+
+func TestUpdateMemberOptsConditionAndType(t *testing.T) {
+	b, err := pools.UpdateMemberOpts{
+		Weight:    golangsdk.IntToPointer(3),
+		Condition: pools.MemberConditionDisabled,
+		Type:      pools.MemberTypeSecondary,
+	}.ToMemberUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{
+		"member": {
+			"weight": 3,
+			"admin_state_up": false,
+			"backup": true
+		}
+	}`, b)
+
+	invalid := []pools.UpdateMemberOpts{
+		{Weight: golangsdk.IntToPointer(-1)},
+		{Weight: golangsdk.IntToPointer(pools.MaxMemberWeight + 1)},
+		{Condition: "PAUSED"},
+		{Condition: pools.MemberConditionDraining, Weight: golangsdk.IntToPointer(2)},
+		{Condition: pools.MemberConditionEnabled, AdminStateUp: new(bool)},
+		{Type: "TERTIARY"},
+	}
+	for _, opts := range invalid {
+		_, err := opts.ToMemberUpdateMap()
+		if _, ok := err.(golangsdk.ErrInvalidInput); !ok {
+			t.Errorf("Expected ErrInvalidInput for %+v, got %v", opts, err)
+		}
+	}
+}
+
+func TestMemberOptsZeroWeight(t *testing.T) {
+	b, err := pools.CreateMemberOpts{
+		Address:      "10.0.2.11",
+		ProtocolPort: 80,
+		Weight:       golangsdk.IntToPointer(0),
+	}.ToMemberCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{
+		"member": {
+			"address": "10.0.2.11",
+			"protocol_port": 80,
+			"weight": 0
+		}
+	}`, b)
+
+	b, err = pools.UpdateMemberOpts{Name: "web"}.ToMemberUpdateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, `{"member": {"name": "web"}}`, b)
+}
+
+func TestDrainAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMemberDrainAndRemoveSuccessfully(t, 0)
+
+	connections := []int{2, 1, 0}
+	var statuses []string
+	err := pools.DrainAndWait(context.Background(), fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		"332abe93-f488-41ba-870b-2ac66be7f853", "2a280670-c202-4b0b-a562-34077415aabf", pools.DrainOpts{
+			ActiveConnections: func(ctx context.Context) (int, error) {
+				n := connections[0]
+				connections = connections[1:]
+				return n, nil
+			},
+			Interval: time.Millisecond,
+			Then:     pools.DrainRemove,
+			Observer: func(e golangsdk.WaitEvent) {
+				statuses = append(statuses, e.Status)
+			},
+		})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"2 active connections", "1 active connections", "0 active connections"}, statuses)
+}
+
+func TestDrainAndWaitPendingUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleMemberDrainAndRemoveSuccessfully(t, 2)
+
+	err := pools.DrainAndWait(context.Background(), fake.ServiceClient(), "36e08a3e-a78f-4b40-a229-1e7e23eee1ab",
+		"332abe93-f488-41ba-870b-2ac66be7f853", "2a280670-c202-4b0b-a562-34077415aabf", pools.DrainOpts{
+			ActiveConnections: func(ctx context.Context) (int, error) {
+				return 0, nil
+			},
+			Interval: time.Millisecond,
+			Then:     pools.DrainRemove,
+		})
+	th.AssertNoErr(t, err)
+}
+
+func TestPersistenceRoundTrip(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePoolPersistenceSuccessfully(t)
+
+	persistence, err := pools.GetPersistence(fake.ServiceClient(), "72741b06-df4d-4715-b142-276b6bce75ab")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &pools.SessionPersistence{Type: pools.PersistenceAppCookie, CookieName: "JSESSIONID"}, persistence)
+
+	pool, err := pools.EnablePersistence(fake.ServiceClient(), "72741b06-df4d-4715-b142-276b6bce75ab", *persistence).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, pools.PersistenceAppCookie, pool.Persistence.Type)
+}
+
+func TestPersistenceOpts(t *testing.T) {
+	b, err := pools.UpdateOpts{ClearPersistence: true}.ToPoolUpdateMap()
+	th.AssertNoErr(t, err)
+	th.AssertJSONEquals(t, `{"pool": {"session_persistence": null}}`, b)
+
+	invalid := []pools.SessionPersistence{
+		{Type: "COOKIE"},
+		{Type: pools.PersistenceAppCookie},
+		{Type: pools.PersistenceHTTPCookie, CookieName: "JSESSIONID"},
+	}
+	for _, persistence := range invalid {
+		persistence := persistence
+		_, err := pools.CreateOpts{
+			LBMethod:       pools.LBMethodRoundRobin,
+			Protocol:       pools.ProtocolHTTP,
+			LoadbalancerID: "79e05663-7f03-45d2-a092-8b94062f22ab",
+			Persistence:    &persistence,
+		}.ToPoolCreateMap()
+		if err == nil {
+			t.Errorf("Expected an error for %+v", persistence)
+		}
+	}
+
+	_, err = pools.UpdateOpts{
+		Persistence:      &pools.SessionPersistence{Type: pools.PersistenceSourceIP},
+		ClearPersistence: true,
+	}.ToPoolUpdateMap()
+	if err == nil {
+		t.Error("Expected an error when setting and clearing the persistence")
+	}
+}
@@ -6,6 +6,7 @@ const (
 	rootPath     = "lbaas"
 	resourcePath = "pools"
 	memberPath   = "members"
+	lbPath       = "loadbalancers"
 )
 
 func rootURL(c *golangsdk.ServiceClient) string {
@@ -23,3 +24,7 @@ func memberRootURL(c *golangsdk.ServiceClient, poolId string) string {
 func memberResourceURL(c *golangsdk.ServiceClient, poolID string, memeberID string) string {
 	return c.ServiceURL(rootPath, resourcePath, poolID, memberPath, memeberID)
 }
+
+func loadBalancerURL(c *golangsdk.ServiceClient, lbID string) string {
+	return c.ServiceURL(rootPath, lbPath, lbID)
+}
@@ -0,0 +1,16 @@
+/*
+Package quotas retrieves the absolute limits of the load balancing resources
+of a project.
+
+Example to Retrieve the Quotas
+
+	quota, err := quotas.Get(networkClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if quota.LoadBalancer != quotas.Unlimited {
+		fmt.Printf("Up to %d load balancers\n", quota.LoadBalancer)
+	}
+*/
+package quotas
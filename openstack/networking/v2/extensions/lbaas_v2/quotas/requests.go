@@ -0,0 +1,13 @@
+package quotas
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Get retrieves the load balancing quotas of the project the client is
+// scoped to.
+func Get(c *golangsdk.ServiceClient) (r GetResult) {
+	resp, err := c.Get(rootURL(c), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
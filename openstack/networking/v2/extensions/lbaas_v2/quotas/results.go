@@ -0,0 +1,51 @@
+package quotas
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// Unlimited is the value of a quota without limit.
+const Unlimited = -1
+
+// Quota is the number of load balancing resources a project may create. A
+// value of Unlimited means that there is no limit.
+type Quota struct {
+	// The UUID of the project.
+	TenantID string `json:"tenant_id"`
+
+	// The maximum number of load balancers.
+	LoadBalancer int `json:"loadbalancer"`
+
+	// The maximum number of listeners.
+	Listener int `json:"listener"`
+
+	// The maximum number of pools.
+	Pool int `json:"pool"`
+
+	// The maximum number of backend members.
+	Member int `json:"member"`
+
+	// The maximum number of health monitors.
+	HealthMonitor int `json:"healthmonitor"`
+
+	// The maximum number of L7 policies.
+	L7Policy int `json:"l7policy"`
+
+	// The maximum number of certificates.
+	Certificate int `json:"certificate"`
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Quota.
+type GetResult struct {
+	golangsdk.Result
+}
+
+// Extract is a function that accepts a result and extracts a Quota.
+func (r GetResult) Extract() (*Quota, error) {
+	var s struct {
+		Quota *Quota `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/quotas"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+// GetResponse is a sample response to a Get call.
+const GetResponse = `
+{
+    "quota": {
+        "tenant_id": "1867112d054b427e808cc6096d8193a1",
+        "loadbalancer": 10,
+        "listener": 50,
+        "pool": 50,
+        "member": 500,
+        "healthmonitor": -1,
+        "l7policy": 500,
+        "certificate": 120
+    }
+}
+`
+
+// ProjectQuota is the Quota of GetResponse.
+var ProjectQuota = quotas.Quota{
+	TenantID:      "1867112d054b427e808cc6096d8193a1",
+	LoadBalancer:  10,
+	Listener:      50,
+	Pool:          50,
+	Member:        500,
+	HealthMonitor: quotas.Unlimited,
+	L7Policy:      500,
+	Certificate:   120,
+}
+
+// HandleQuotaGetSuccessfully sets up the test server to respond to a quota Get
+// request.
+func HandleQuotaGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/lbaas/quotas", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, GetResponse)
+	})
+}
@@ -0,0 +1,19 @@
+package testing
+
+import (
+	"testing"
+
+	fake "github.com/huaweicloud/golangsdk/openstack/networking/v2/common"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/quotas"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleQuotaGetSuccessfully(t)
+
+	actual, err := quotas.Get(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ProjectQuota, actual)
+}
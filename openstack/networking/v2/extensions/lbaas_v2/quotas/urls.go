@@ -0,0 +1,12 @@
+package quotas
+
+import "github.com/huaweicloud/golangsdk"
+
+const (
+	rootPath     = "lbaas"
+	resourcePath = "quotas"
+)
+
+func rootURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
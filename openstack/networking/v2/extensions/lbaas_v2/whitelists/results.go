@@ -2,6 +2,7 @@ package whitelists
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
 )
 
 type Whitelist struct {
@@ -12,6 +13,26 @@ type Whitelist struct {
 	Whitelist       string `json:"whitelist"`
 }
 
+// WhitelistPage is the page returned by a pager when traversing over a
+// collection of whitelists.
+type WhitelistPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a WhitelistPage struct is empty.
+func (r WhitelistPage) IsEmpty() (bool, error) {
+	is, err := ExtractWhitelists(r)
+	return len(is) == 0, err
+}
+
+// ExtractWhitelists accepts a Page struct, specifically a WhitelistPage
+// struct, and extracts the elements into a slice of Whitelist structs.
+func ExtractWhitelists(r pagination.Page) ([]Whitelist, error) {
+	var s []Whitelist
+	err := r.(WhitelistPage).Result.ExtractIntoSlicePtr(&s, "whitelists")
+	return s, err
+}
+
 type commonResult struct {
 	golangsdk.Result
 }
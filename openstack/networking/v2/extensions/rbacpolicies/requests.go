@@ -55,7 +55,8 @@ func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 
 // Get retrieves a specific rbac policy based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	resp, err := c.Get(getURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -101,13 +102,15 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	resp, err := c.Post(createURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete accepts a unique ID and deletes the rbac-policy associated with it.
 func Delete(c *golangsdk.ServiceClient, rbacPolicyID string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, rbacPolicyID), nil)
+	resp, err := c.Delete(deleteURL(c, rbacPolicyID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -135,8 +138,9 @@ func Update(c *golangsdk.ServiceClient, rbacPolicyID string, opts UpdateOptsBuil
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateURL(c, rbacPolicyID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(updateURL(c, rbacPolicyID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
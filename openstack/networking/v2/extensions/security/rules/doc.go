@@ -39,6 +39,26 @@ Example to Create a Security Group Rule
 		panic(err)
 	}
 
+Example to Create a Security Group Rule from a secrules.RuleOpts
+
+	ruleOpts := secrules.RuleOpts{
+		Direction:     secrules.DirectionEgress,
+		Protocol:      secrules.ProtocolTCP,
+		PortRangeMin:  5432,
+		PortRangeMax:  5432,
+		RemoteGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}
+
+	createOpts, err := rules.NewCreateOpts("a7734e61-b545-452d-a3cd-0189cbd9747a", ruleOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	rule, err := rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Security Group Rule
 
 	ruleID := "37d94f8a-d136-465c-ae46-144f0d8ef141"
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/common/secrules"
 	"github.com/huaweicloud/golangsdk/pagination"
 )
 
@@ -127,6 +128,26 @@ type CreateOpts struct {
 	TenantID string `json:"tenant_id,omitempty"`
 }
 
+// NewCreateOpts returns the CreateOpts adding a rule described by a
+// secrules.RuleOpts to the security group secGroupID.
+func NewCreateOpts(secGroupID string, rule secrules.RuleOpts) (CreateOpts, error) {
+	rule, err := rule.Normalize()
+	if err != nil {
+		return CreateOpts{}, err
+	}
+	return CreateOpts{
+		Direction:      RuleDirection(rule.Direction),
+		Description:    rule.Description,
+		EtherType:      RuleEtherType(rule.EtherType),
+		SecGroupID:     secGroupID,
+		PortRangeMax:   rule.PortRangeMax,
+		PortRangeMin:   rule.PortRangeMin,
+		Protocol:       RuleProtocol(rule.Protocol),
+		RemoteGroupID:  rule.RemoteGroupID,
+		RemoteIPPrefix: rule.RemoteCIDR,
+	}, nil
+}
+
 // ToSecGroupRuleCreateMap builds a request body from CreateOpts.
 func (opts CreateOpts) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
 	return golangsdk.BuildRequestBody(opts, "security_group_rule")
@@ -140,19 +161,22 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	resp, err := c.Post(rootURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular security group rule based on its unique ID.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular security group rule based on its
 // unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/huaweicloud/golangsdk/openstack/common/secrules"
 	fake "github.com/huaweicloud/golangsdk/openstack/networking/v2/common"
 	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/security/rules"
 	"github.com/huaweicloud/golangsdk/pagination"
@@ -234,3 +235,22 @@ func TestDelete(t *testing.T) {
 	res := rules.Delete(fake.ServiceClient(), "4ec89087-d057-4e2c-911f-60a3b47ee304")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestNewCreateOpts(t *testing.T) {
+	opts, err := rules.NewCreateOpts("a7734e61-b545-452d-a3cd-0189cbd9747a", secrules.RuleOpts{
+		Protocol:      secrules.ProtocolTCP,
+		PortRangeMin:  80,
+		PortRangeMax:  80,
+		RemoteGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, rules.CreateOpts{
+		Direction:     rules.DirIngress,
+		EtherType:     rules.EtherType4,
+		SecGroupID:    "a7734e61-b545-452d-a3cd-0189cbd9747a",
+		PortRangeMax:  80,
+		PortRangeMin:  80,
+		Protocol:      rules.ProtocolTCP,
+		RemoteGroupID: "85cc3048-abc3-43cc-89b3-377341426ac5",
+	}, opts)
+}
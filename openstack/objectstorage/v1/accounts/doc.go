@@ -25,5 +25,14 @@ Example to Update an Account
 	updateResult, err := accounts.Update(objectStorageClient, updateOpts).Extract()
 	fmt.Printf("%+v\n", updateResult)
 
+Example to Remove Account Metadata
+
+	updateOpts := accounts.UpdateOpts{
+		RemoveMetadata: []string{"some"},
+	}
+
+	updateResult, err := accounts.Update(objectStorageClient, updateOpts).Extract()
+	fmt.Printf("%+v\n", updateResult)
+
 */
 package accounts
@@ -56,10 +56,15 @@ type UpdateOptsBuilder interface {
 // deleting an account's metadata.
 type UpdateOpts struct {
 	Metadata          map[string]string
+	RemoveMetadata    []string
 	ContentType       string `h:"Content-Type"`
 	DetectContentType bool   `h:"X-Detect-Content-Type"`
 	TempURLKey        string `h:"X-Account-Meta-Temp-URL-Key"`
 	TempURLKey2       string `h:"X-Account-Meta-Temp-URL-Key-2"`
+
+	// QuotaBytes is the maximum size of the account, in bytes. It can only be
+	// set by a reseller admin. Remove the "Quota-Bytes" metadata to unset it.
+	QuotaBytes int64 `h:"X-Account-Meta-Quota-Bytes"`
 }
 
 // ToAccountUpdateMap formats an UpdateOpts into a map[string]string of headers.
@@ -71,6 +76,9 @@ func (opts UpdateOpts) ToAccountUpdateMap() (map[string]string, error) {
 	for k, v := range opts.Metadata {
 		headers["X-Account-Meta-"+k] = v
 	}
+	for _, k := range opts.RemoveMetadata {
+		headers["X-Remove-Account-Meta-"+k] = "remove"
+	}
 	return headers, err
 }
 
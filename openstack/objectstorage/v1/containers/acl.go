@@ -0,0 +1,54 @@
+package containers
+
+import "strings"
+
+// ACLElement is an element of a container ACL, as set in the ContainerRead
+// and ContainerWrite options.
+type ACLElement string
+
+const (
+	// ACLAnyReferrer grants read access to the objects of the container to
+	// any request, without authentication. Only valid in a read ACL.
+	ACLAnyReferrer ACLElement = ".r:*"
+
+	// ACLListings grants the listing of the objects of the container to the
+	// requests granted by the referrer elements. Only valid in a read ACL.
+	ACLListings ACLElement = ".rlistings"
+)
+
+// ACLReferrer grants read access to the requests whose Referer header
+// matches host, e.g. ".example.com" for all its subdomains. A host prefixed
+// with "-" denies access instead. Only valid in a read ACL.
+func ACLReferrer(host string) ACLElement {
+	return ACLElement(".r:" + host)
+}
+
+// ACLProject grants access to a user of a project, or to all the users of
+// the project when userID is empty.
+func ACLProject(projectID, userID string) ACLElement {
+	if userID == "" {
+		userID = "*"
+	}
+	return ACLElement(projectID + ":" + userID)
+}
+
+// ACL formats elements as the value of the X-Container-Read or
+// X-Container-Write header.
+func ACL(elements ...ACLElement) string {
+	s := make([]string, len(elements))
+	for i, e := range elements {
+		s[i] = string(e)
+	}
+	return strings.Join(s, ",")
+}
+
+// ParseACL parses the elements of the Read or Write ACL returned by Get.
+func ParseACL(acl []string) []ACLElement {
+	var elements []ACLElement
+	for _, e := range acl {
+		if e = strings.TrimSpace(e); e != "" {
+			elements = append(elements, ACLElement(e))
+		}
+	}
+	return elements
+}
@@ -80,6 +80,60 @@ Example to Update a Container
 		panic(err)
 	}
 
+Example to Set the Quotas and ACLs of a Container
+
+	containerName := "my_container"
+
+	updateOpts := containers.UpdateOpts{
+		QuotaBytes:     10 * 1024 * 1024 * 1024,
+		QuotaCount:     100000,
+		ContainerRead:  containers.ACL(containers.ACLReferrer(".example.com"), containers.ACLListings),
+		ContainerWrite: containers.ACL(containers.ACLProject("d7bd8fa4d4fd4c4ab3bd4fc1bdd3a7cc", "")),
+	}
+
+	container, err := containers.Update(objectStorageClient, containerName, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	header, err := containers.Get(objectStorageClient, containerName, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("quota: %d bytes, read ACL: %v\n", *header.QuotaBytes, containers.ParseACL(header.Read))
+
+Example to Remove the Quotas and Read ACL of a Container
+
+	updateOpts := containers.UpdateOpts{
+		RemoveMetadata:      []string{"Quota-Bytes", "Quota-Count"},
+		RemoveContainerRead: true,
+	}
+
+	container, err := containers.Update(objectStorageClient, containerName, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Keep the History of the Objects of a Container
+
+	// With HistoryLocation, the current version of an object is also
+	// archived when it is deleted, unlike with VersionsLocation.
+	createOpts := containers.CreateOpts{}
+	_, err := containers.Create(objectStorageClient, "my_container_versions", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateOpts := containers.UpdateOpts{
+		HistoryLocation: "my_container_versions",
+	}
+
+	container, err := containers.Update(objectStorageClient, "my_container", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Container
 
 	containerName := "my_container"
@@ -74,6 +74,12 @@ type CreateOpts struct {
 	DetectContentType bool   `h:"X-Detect-Content-Type"`
 	IfNoneMatch       string `h:"If-None-Match"`
 	VersionsLocation  string `h:"X-Versions-Location"`
+	HistoryLocation   string `h:"X-History-Location"`
+
+	// QuotaBytes and QuotaCount limit the size of the container, in bytes,
+	// and its number of objects.
+	QuotaBytes int64 `h:"X-Container-Meta-Quota-Bytes"`
+	QuotaCount int64 `h:"X-Container-Meta-Quota-Count"`
 }
 
 // ToContainerCreateMap formats a CreateOpts into a map of headers.
@@ -115,7 +121,8 @@ func Create(c *golangsdk.ServiceClient, containerName string, opts CreateOptsBui
 
 // Delete is a function that deletes a container.
 func Delete(c *golangsdk.ServiceClient, containerName string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, containerName), nil)
+	resp, err := c.Delete(deleteURL(c, containerName), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -129,14 +136,25 @@ type UpdateOptsBuilder interface {
 // deleting a container's metadata.
 type UpdateOpts struct {
 	Metadata               map[string]string
+	RemoveMetadata         []string
 	ContainerRead          string `h:"X-Container-Read"`
+	RemoveContainerRead    bool   `h:"X-Remove-Container-Read"`
 	ContainerSyncTo        string `h:"X-Container-Sync-To"`
 	ContainerSyncKey       string `h:"X-Container-Sync-Key"`
 	ContainerWrite         string `h:"X-Container-Write"`
+	RemoveContainerWrite   bool   `h:"X-Remove-Container-Write"`
 	ContentType            string `h:"Content-Type"`
 	DetectContentType      bool   `h:"X-Detect-Content-Type"`
 	RemoveVersionsLocation string `h:"X-Remove-Versions-Location"`
 	VersionsLocation       string `h:"X-Versions-Location"`
+	RemoveHistoryLocation  string `h:"X-Remove-History-Location"`
+	HistoryLocation        string `h:"X-History-Location"`
+
+	// QuotaBytes and QuotaCount limit the size of the container, in bytes,
+	// and its number of objects. Remove the "Quota-Bytes" or "Quota-Count"
+	// metadata to unset them.
+	QuotaBytes int64 `h:"X-Container-Meta-Quota-Bytes"`
+	QuotaCount int64 `h:"X-Container-Meta-Quota-Count"`
 }
 
 // ToContainerUpdateMap formats a UpdateOpts into a map of headers.
@@ -148,6 +166,9 @@ func (opts UpdateOpts) ToContainerUpdateMap() (map[string]string, error) {
 	for k, v := range opts.Metadata {
 		h["X-Container-Meta-"+k] = v
 	}
+	for _, k := range opts.RemoveMetadata {
+		h["X-Remove-Container-Meta-"+k] = "remove"
+	}
 	return h, nil
 }
 
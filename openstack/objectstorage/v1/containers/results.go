@@ -100,8 +100,11 @@ type GetHeader struct {
 	Read             []string  `json:"-"`
 	TransID          string    `json:"X-Trans-Id"`
 	VersionsLocation string    `json:"X-Versions-Location"`
+	HistoryLocation  string    `json:"X-History-Location"`
 	Write            []string  `json:"-"`
 	StoragePolicy    string    `json:"X-Storage-Policy"`
+	QuotaBytes       *int64    `json:"-"`
+	QuotaCount       *int64    `json:"-"`
 }
 
 func (r *GetHeader) UnmarshalJSON(b []byte) error {
@@ -113,6 +116,8 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 		ObjectCount   string                `json:"X-Container-Object-Count"`
 		Write         string                `json:"X-Container-Write"`
 		Read          string                `json:"X-Container-Read"`
+		QuotaBytes    string                `json:"X-Container-Meta-Quota-Bytes"`
+		QuotaCount    string                `json:"X-Container-Meta-Quota-Count"`
 		Date          golangsdk.JSONRFC1123 `json:"Date"`
 	}
 	err := json.Unmarshal(b, &s)
@@ -152,6 +157,22 @@ func (r *GetHeader) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if s.QuotaBytes != "" {
+		v, err := strconv.ParseInt(s.QuotaBytes, 10, 64)
+		if err != nil {
+			return err
+		}
+		r.QuotaBytes = &v
+	}
+
+	if s.QuotaCount != "" {
+		v, err := strconv.ParseInt(s.QuotaCount, 10, 64)
+		if err != nil {
+			return err
+		}
+		r.QuotaCount = &v
+	}
+
 	r.Read = strings.Split(s.Read, ",")
 	r.Write = strings.Split(s.Write, ",")
 
@@ -0,0 +1,111 @@
+package objects
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// BulkDelete deletes objects of a container in a single request. Swift
+// accepts up to 10000 objects per request by default, so larger lists must be
+// split by the caller. The request succeeds even if some objects could not be
+// deleted: check the Errors of the extracted response.
+func BulkDelete(c *golangsdk.ServiceClient, container string, objectNames []string) (r BulkDeleteResult) {
+	lines := make([]string, len(objectNames))
+	for i, name := range objectNames {
+		lines[i] = url.PathEscape(container) + "/" + url.PathEscape(name)
+	}
+
+	resp, err := c.Request("POST", bulkDeleteURL(c), &golangsdk.RequestOpts{
+		RawBody: strings.NewReader(strings.Join(lines, "\n")),
+		MoreHeaders: map[string]string{
+			"Accept":       "application/json",
+			"Content-Type": "text/plain",
+		},
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
+
+// ArchiveFormat is the format of an archive uploaded with ExtractArchive.
+type ArchiveFormat string
+
+const (
+	ArchiveTar    ArchiveFormat = "tar"
+	ArchiveTarGz  ArchiveFormat = "tar.gz"
+	ArchiveTarBz2 ArchiveFormat = "tar.bz2"
+)
+
+// ExtractArchiveOptsBuilder allows extensions to add additional parameters to
+// the ExtractArchive request.
+type ExtractArchiveOptsBuilder interface {
+	ToObjectExtractArchiveParams() (io.Reader, map[string]string, ArchiveFormat, error)
+}
+
+// ExtractArchiveOpts is a structure that holds parameters for uploading an
+// archive with ExtractArchive.
+type ExtractArchiveOpts struct {
+	// Content is the archive, streamed to the service.
+	Content io.Reader
+
+	// Format is the format of the archive.
+	Format ArchiveFormat
+
+	// Metadata is set on each of the objects created.
+	Metadata map[string]string
+}
+
+// ToObjectExtractArchiveParams formats an ExtractArchiveOpts into the body,
+// headers and format of the request.
+func (opts ExtractArchiveOpts) ToObjectExtractArchiveParams() (io.Reader, map[string]string, ArchiveFormat, error) {
+	if opts.Content == nil {
+		return nil, nil, "", golangsdk.ErrMissingInput{Argument: "objects.ExtractArchiveOpts.Content"}
+	}
+	switch opts.Format {
+	case ArchiveTar, ArchiveTarGz, ArchiveTarBz2:
+	default:
+		err := golangsdk.ErrInvalidInput{}
+		err.Argument = "objects.ExtractArchiveOpts.Format"
+		err.Value = opts.Format
+		return nil, nil, "", err
+	}
+
+	h := map[string]string{"Accept": "application/json"}
+	for k, v := range opts.Metadata {
+		h["X-Object-Meta-"+k] = v
+	}
+	return opts.Content, h, opts.Format, nil
+}
+
+// ExtractArchive uploads an archive, whose files are created as objects in a
+// single request. uploadPath is where the files are extracted: a container,
+// optionally followed by an object name prefix, e.g. "my_container/backups",
+// or empty to create a container for each top-level directory of the archive.
+// As with BulkDelete, check the Errors of the extracted response for the
+// files which could not be created.
+func ExtractArchive(c *golangsdk.ServiceClient, uploadPath string, opts ExtractArchiveOptsBuilder) (r ExtractArchiveResult) {
+	content, h, format, err := opts.ToObjectExtractArchiveParams()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := c.Request("PUT", extractArchiveURL(c, uploadPath, format), &golangsdk.RequestOpts{
+		RawBody:      content,
+		MoreHeaders:  h,
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200, 201},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
@@ -106,5 +106,148 @@ Example to Download an Object's Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Check Whether a Cached Object Changed
+
+	getOpts := objects.GetOpts{
+		IfNoneMatch: cachedETag,
+	}
+
+	object, err := objects.Get(objectStorageClient, containerName, objectName, getOpts).Extract()
+	if _, ok := err.(golangsdk.ErrNotModified); ok {
+		// The cached copy is still up to date.
+	} else if err != nil {
+		panic(err)
+	} else {
+		cachedETag = object.ETag
+	}
+
+Example to Upload a Large Object
+
+	f, err := os.Open("backup.tar")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	uploadOpts := objects.UploadLargeObjectOpts{
+		Content:     f,
+		SegmentSize: 1 << 30,
+		ContentType: "application/x-tar",
+		Concurrency: 4,
+	}
+
+	_, err = objects.UploadLargeObject(objectStorageClient, "my_container", "backup.tar", uploadOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create an Object Expiring in a Week
+
+	createOpts := objects.CreateOpts{
+		Content:     strings.NewReader("temporary content"),
+		DeleteAfter: 7 * 24 * 60 * 60,
+	}
+
+	_, err := objects.Create(objectStorageClient, "my_container", "my_object", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Keep an Object From Expiring
+
+	updateOpts := objects.UpdateOpts{
+		RemoveDeleteAt: true,
+	}
+
+	_, err := objects.Update(objectStorageClient, "my_container", "my_object", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the Previous Versions of an Object
+
+	// Versioning is enabled by setting the VersionsLocation or
+	// HistoryLocation of the container, see containers.UpdateOpts.
+	listOpts := objects.ListOpts{
+		Full: true,
+	}
+
+	allPages, err := objects.ListVersions(objectStorageClient, "my_container_versions", "my_object", listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	versions, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, version := range versions {
+		fmt.Printf("%s archived, last modified on %s\n", version.Name, version.LastModified)
+	}
+
+Example to Delete Many Objects at Once
+
+	objectNames := []string{"logs/2019-01-01.gz", "logs/2019-01-02.gz"}
+
+	resp, err := objects.BulkDelete(objectStorageClient, "my_container", objectNames).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, e := range resp.Errors {
+		fmt.Printf("%s could not be deleted: %s\n", e.Path, e.Status)
+	}
+
+Example to Upload the Files of an Archive
+
+	f, err := os.Open("site.tar.gz")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	extractOpts := objects.ExtractArchiveOpts{
+		Content: f,
+		Format:  objects.ArchiveTarGz,
+	}
+
+	resp, err := objects.ExtractArchive(objectStorageClient, "my_container/site", extractOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d files created\n", resp.NumberFilesCreated)
+
+Example to Let a Third Party Upload an Object for an Hour
+
+	tempURLOpts := objects.CreateTempURLOpts{
+		Method: objects.PUT,
+		TTL:    60 * 60,
+	}
+
+	url, err := objects.CreateTempURL(objectStorageClient, "my_container", "report.pdf", tempURLOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Sign a Browser Upload Form
+
+	formPostOpts := objects.CreateFormPostOpts{
+		TTL:          15 * 60,
+		MaxFileSize:  100 << 20,
+		MaxFileCount: 10,
+		Prefix:       "uploads/",
+		RedirectURL:  "https://example.com/uploaded",
+	}
+
+	form, err := objects.CreateFormPost(objectStorageClient, "my_container", formPostOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	// Render form.Fields as hidden inputs of a form posting to form.URL.
+	fmt.Printf("%s %v\n", form.URL, form.Fields)
 */
 package objects
@@ -0,0 +1,97 @@
+package objects
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// CreateFormPostOpts are options for creating the signature of an HTML form
+// uploading objects to a container.
+type CreateFormPostOpts struct {
+	// (REQUIRED) TTL is the number of seconds the form should be usable.
+	TTL int
+
+	// (REQUIRED) MaxFileSize is the maximum size in bytes of each file.
+	MaxFileSize int64
+
+	// (REQUIRED) MaxFileCount is the maximum number of files uploaded.
+	MaxFileCount int
+
+	// (Optional) Prefix is prepended to the name of the uploaded files.
+	Prefix string
+
+	// (Optional) RedirectURL is where the browser is redirected after the
+	// upload. The status of the upload is added to its query.
+	RedirectURL string
+
+	// (Optional) Split is the string on which to split the container URL,
+	// see CreateTempURLOpts.
+	Split string
+
+	// (Optional) Key is the temp URL key of the account. If empty, it is
+	// retrieved from the account metadata.
+	Key string
+
+	// (Optional) Timestamp is the time from which the TTL is counted. If
+	// empty, the current time is used.
+	Timestamp time.Time
+}
+
+// FormPost contains what is needed to build an HTML form uploading objects
+// with a multipart/form-data POST request.
+type FormPost struct {
+	// URL is the action of the form.
+	URL string
+
+	// Fields are the hidden fields of the form, which must come before the
+	// file fields.
+	Fields map[string]string
+}
+
+// CreateFormPost is a function for signing an HTML form which lets browsers
+// upload objects to a container for a limited amount of time, without
+// credentials.
+func CreateFormPost(c *golangsdk.ServiceClient, containerName string, opts CreateFormPostOpts) (*FormPost, error) {
+	if opts.TTL <= 0 {
+		return nil, golangsdk.ErrMissingInput{Argument: "objects.CreateFormPostOpts.TTL"}
+	}
+	if opts.MaxFileSize <= 0 {
+		return nil, golangsdk.ErrMissingInput{Argument: "objects.CreateFormPostOpts.MaxFileSize"}
+	}
+	if opts.MaxFileCount <= 0 {
+		return nil, golangsdk.ErrMissingInput{Argument: "objects.CreateFormPostOpts.MaxFileCount"}
+	}
+	if opts.Split == "" {
+		opts.Split = "/v1/"
+	}
+	if opts.Timestamp.IsZero() {
+		opts.Timestamp = time.Now()
+	}
+	expiry := opts.Timestamp.Add(time.Duration(opts.TTL) * time.Second).Unix()
+	secretKey, err := tempURLKey(c, opts.Key)
+	if err != nil {
+		return nil, err
+	}
+	baseURL, path, err := splitTempURL(c.ServiceURL(containerName, opts.Prefix), opts.Split)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		"redirect":       opts.RedirectURL,
+		"max_file_size":  strconv.FormatInt(opts.MaxFileSize, 10),
+		"max_file_count": strconv.Itoa(opts.MaxFileCount),
+		"expires":        strconv.FormatInt(expiry, 10),
+	}
+	body := strings.Join([]string{path, fields["redirect"], fields["max_file_size"], fields["max_file_count"], fields["expires"]}, "\n")
+	fields["signature"] = tempURLSignature(secretKey, body)
+
+	return &FormPost{
+		URL:    fmt.Sprintf("%s%s", baseURL, path),
+		Fields: fields,
+	}, nil
+}
@@ -5,10 +5,12 @@ import (
 	"crypto/hmac"
 	"crypto/md5"
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/huaweicloud/golangsdk"
@@ -346,9 +348,13 @@ type GetOptsBuilder interface {
 // GetOpts is a structure that holds parameters for getting an object's
 // metadata.
 type GetOpts struct {
-	Newest    bool   `h:"X-Newest"`
-	Expires   string `q:"expires"`
-	Signature string `q:"signature"`
+	IfMatch           string    `h:"If-Match"`
+	IfModifiedSince   time.Time `h:"If-Modified-Since"`
+	IfNoneMatch       string    `h:"If-None-Match"`
+	IfUnmodifiedSince time.Time `h:"If-Unmodified-Since"`
+	Newest            bool      `h:"X-Newest"`
+	Expires           string    `q:"expires"`
+	Signature         string    `q:"signature"`
 }
 
 // ToObjectGetParams formats a GetOpts into a query string and a map of headers.
@@ -408,6 +414,7 @@ type UpdateOpts struct {
 	ContentType        string `h:"Content-Type"`
 	DeleteAfter        int    `h:"X-Delete-After"`
 	DeleteAt           int    `h:"X-Delete-At"`
+	RemoveDeleteAt     bool   `h:"X-Remove-Delete-At"`
 	DetectContentType  bool   `h:"X-Detect-Content-Type"`
 }
 
@@ -455,14 +462,23 @@ var (
 	// GET represents an HTTP "GET" method.
 	GET HTTPMethod = "GET"
 
+	// HEAD represents an HTTP "HEAD" method.
+	HEAD HTTPMethod = "HEAD"
+
+	// PUT represents an HTTP "PUT" method.
+	PUT HTTPMethod = "PUT"
+
 	// POST represents an HTTP "POST" method.
 	POST HTTPMethod = "POST"
+
+	// DELETE represents an HTTP "DELETE" method.
+	DELETE HTTPMethod = "DELETE"
 )
 
 // CreateTempURLOpts are options for creating a temporary URL for an object.
 type CreateTempURLOpts struct {
 	// (REQUIRED) Method is the HTTP method to allow for users of the temp URL.
-	// Valid values are "GET" and "POST".
+	// Valid values are "GET", "HEAD", "PUT", "POST" and "DELETE".
 	Method HTTPMethod
 
 	// (REQUIRED) TTL is the number of seconds the temp URL should be active.
@@ -472,29 +488,274 @@ type CreateTempURLOpts struct {
 	// the object path is used in the hash, the object URL needs to be parsed. If
 	// empty, the default OpenStack URL split point will be used ("/v1/").
 	Split string
+
+	// (Optional) Key is the temp URL key of the account. If empty, it is
+	// retrieved from the account metadata.
+	Key string
+
+	// (Optional) Timestamp is the time from which the TTL is counted. If
+	// empty, the current time is used.
+	Timestamp time.Time
 }
 
 // CreateTempURL is a function for creating a temporary URL for an object. It
-// allows users to have "GET" or "POST" access to a particular tenant's object
-// for a limited amount of time.
+// allows users to have access to a particular tenant's object with the
+// given method for a limited amount of time, without credentials.
 func CreateTempURL(c *golangsdk.ServiceClient, containerName, objectName string, opts CreateTempURLOpts) (string, error) {
 	if opts.Split == "" {
 		opts.Split = "/v1/"
 	}
+	if opts.Timestamp.IsZero() {
+		opts.Timestamp = time.Now()
+	}
 	duration := time.Duration(opts.TTL) * time.Second
-	expiry := time.Now().Add(duration).Unix()
-	getHeader, err := accounts.Get(c, nil).Extract()
+	expiry := opts.Timestamp.Add(duration).Unix()
+	secretKey, err := tempURLKey(c, opts.Key)
+	if err != nil {
+		return "", err
+	}
+	baseURL, objectPath, err := splitTempURL(getURL(c, containerName, objectName), opts.Split)
 	if err != nil {
 		return "", err
 	}
-	secretKey := []byte(getHeader.TempURLKey)
-	url := getURL(c, containerName, objectName)
-	splitPath := strings.Split(url, opts.Split)
-	baseURL, objectPath := splitPath[0], splitPath[1]
-	objectPath = opts.Split + objectPath
 	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
-	hash := hmac.New(sha1.New, secretKey)
-	hash.Write([]byte(body))
-	hexsum := fmt.Sprintf("%x", hash.Sum(nil))
+	hexsum := tempURLSignature(secretKey, body)
 	return fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, hexsum, expiry), nil
 }
+
+// tempURLKey returns key, or the temp URL key of the account if it's empty.
+func tempURLKey(c *golangsdk.ServiceClient, key string) (string, error) {
+	if key != "" {
+		return key, nil
+	}
+	getHeader, err := accounts.Get(c, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if getHeader.TempURLKey == "" {
+		return "", fmt.Errorf("The account has no temp URL key, set it with accounts.UpdateOpts.TempURLKey")
+	}
+	return getHeader.TempURLKey, nil
+}
+
+// splitTempURL splits url into the base URL and the path which is signed,
+// starting at split.
+func splitTempURL(url, split string) (string, string, error) {
+	i := strings.Index(url, split)
+	if i < 0 {
+		return "", "", fmt.Errorf("The URL %s does not contain %q", url, split)
+	}
+	return url[:i], url[i:], nil
+}
+
+// tempURLSignature returns the hex-encoded HMAC-SHA1 of body with key.
+func tempURLSignature(key, body string) string {
+	hash := hmac.New(sha1.New, []byte(key))
+	hash.Write([]byte(body))
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// SLOSegment describes a segment of a static large object (SLO).
+type SLOSegment struct {
+	// Path is the "container/object" path of the segment.
+	Path string `json:"path" required:"true"`
+
+	// ETag is the MD5 checksum of the segment. It is validated when set.
+	ETag string `json:"etag,omitempty"`
+
+	// SizeBytes is the size of the segment. It is validated when set.
+	SizeBytes int64 `json:"size_bytes,omitempty"`
+
+	// Range is an optional byte range of the segment, e.g. "0-1023".
+	Range string `json:"range,omitempty"`
+}
+
+// CreateSLOManifest creates a static large object manifest from a list of
+// previously uploaded segments. Downloading the object returns the
+// concatenated content of the segments.
+func CreateSLOManifest(c *golangsdk.ServiceClient, containerName, objectName string, segments []SLOSegment) (r CreateResult) {
+	return createSLOManifest(c, containerName, objectName, segments, CreateOpts{})
+}
+
+func createSLOManifest(c *golangsdk.ServiceClient, containerName, objectName string, segments []SLOSegment, opts CreateOpts) (r CreateResult) {
+	b, err := json.Marshal(segments)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	opts.Content = bytes.NewReader(b)
+	opts.NoETag = true
+	opts.MultipartManifest = "put"
+	return Create(c, containerName, objectName, opts)
+}
+
+// CreateDLOManifest creates a dynamic large object manifest. Downloading the
+// object returns the concatenated content of all the objects of
+// segmentContainer whose name starts with prefix, in lexical order.
+func CreateDLOManifest(c *golangsdk.ServiceClient, containerName, objectName, segmentContainer, prefix string) (r CreateResult) {
+	return Create(c, containerName, objectName, CreateOpts{
+		Content:        bytes.NewReader(nil),
+		NoETag:         true,
+		ObjectManifest: segmentContainer + "/" + prefix,
+	})
+}
+
+// UploadLargeObjectOpts holds the parameters for uploading a large object.
+type UploadLargeObjectOpts struct {
+	// Content is the content of the object.
+	Content io.Reader `required:"true"`
+
+	// SegmentSize is the maximum size of a segment, in bytes.
+	SegmentSize int64 `required:"true"`
+
+	// SegmentContainer is the container in which segments are stored. It
+	// defaults to "<container>_segments".
+	SegmentContainer string
+
+	// Dynamic creates a dynamic large object (DLO) manifest instead of a
+	// static large object (SLO) manifest.
+	Dynamic bool
+
+	// Metadata is set on the manifest object.
+	Metadata map[string]string
+
+	// ContentType is the content type of the manifest object.
+	ContentType string
+
+	// Concurrency is the number of segments uploaded at once, and thus the
+	// number of segments held in memory. It defaults to 1, which uploads the
+	// segments one after another.
+	Concurrency int
+}
+
+// UploadLargeObject splits Content into segments of SegmentSize bytes,
+// uploads them to the segment container and creates a manifest object which
+// references them. Segments are named "<object>/<index>" so that they can
+// be listed and deleted together. With opts.Concurrency, the segments are
+// uploaded in parallel, and the manifest still lists them in order.
+func UploadLargeObject(c *golangsdk.ServiceClient, containerName, objectName string, opts UploadLargeObjectOpts) (r CreateResult) {
+	if opts.Content == nil {
+		r.Err = golangsdk.ErrMissingInput{Argument: "Content"}
+		return
+	}
+	if opts.SegmentSize <= 0 {
+		r.Err = golangsdk.ErrInvalidInput{
+			ErrMissingInput: golangsdk.ErrMissingInput{Argument: "SegmentSize"},
+			Value:           opts.SegmentSize,
+		}
+		return
+	}
+
+	segmentContainer := opts.SegmentContainer
+	if segmentContainer == "" {
+		segmentContainer = containerName + "_segments"
+	}
+	prefix := objectName + "/"
+
+	segments, err := uploadSegments(c, segmentContainer, prefix, opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	if len(segments) == 0 {
+		return Create(c, containerName, objectName, CreateOpts{
+			Content:     bytes.NewReader(nil),
+			ContentType: opts.ContentType,
+			Metadata:    opts.Metadata,
+		})
+	}
+
+	if opts.Dynamic {
+		return Create(c, containerName, objectName, CreateOpts{
+			Content:        bytes.NewReader(nil),
+			NoETag:         true,
+			ContentType:    opts.ContentType,
+			Metadata:       opts.Metadata,
+			ObjectManifest: segmentContainer + "/" + prefix,
+		})
+	}
+
+	return createSLOManifest(c, containerName, objectName, segments, CreateOpts{
+		ContentType: opts.ContentType,
+		Metadata:    opts.Metadata,
+	})
+}
+
+// uploadSegments uploads the segments of opts.Content, at most
+// opts.Concurrency at once, and returns them in order.
+func uploadSegments(c *golangsdk.ServiceClient, segmentContainer, prefix string, opts UploadLargeObjectOpts) ([]SLOSegment, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	var (
+		segments  []*SLOSegment
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		uploadErr error
+	)
+	failed := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return uploadErr
+	}
+
+	// A slot is taken before a segment is read and released once it is
+	// uploaded, which bounds the number of segments in memory.
+	slots := make(chan struct{}, concurrency)
+	for i := 0; failed() == nil; i++ {
+		slots <- struct{}{}
+		data, err := ioutil.ReadAll(io.LimitReader(opts.Content, opts.SegmentSize))
+		if err != nil {
+			wg.Wait()
+			return nil, err
+		}
+		if len(data) == 0 {
+			<-slots
+			break
+		}
+
+		segmentName := fmt.Sprintf("%s%08d", prefix, i)
+		segment := &SLOSegment{
+			Path:      segmentContainer + "/" + segmentName,
+			SizeBytes: int64(len(data)),
+		}
+		segments = append(segments, segment)
+
+		wg.Add(1)
+		go func(segmentName string, data []byte) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			header, err := Create(c, segmentContainer, segmentName, CreateOpts{
+				Content: bytes.NewReader(data),
+			}).Extract()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if uploadErr == nil {
+					uploadErr = err
+				}
+				return
+			}
+			segment.ETag = strings.Trim(header.ETag, `"`)
+		}(segmentName, data)
+
+		if int64(len(data)) < opts.SegmentSize {
+			break
+		}
+	}
+	wg.Wait()
+	if uploadErr != nil {
+		return nil, uploadErr
+	}
+
+	result := make([]SLOSegment, len(segments))
+	for i, segment := range segments {
+		result[i] = *segment
+	}
+	return result, nil
+}
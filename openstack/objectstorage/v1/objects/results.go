@@ -577,3 +577,71 @@ func extractLastMarker(r pagination.Page) (string, error) {
 		return "", fmt.Errorf("Cannot extract names from response with content-type: [%s]", ct)
 	}
 }
+
+// BulkError is a path which could not be processed by a bulk operation,
+// along with its status, e.g. "404 Not Found".
+type BulkError struct {
+	Path   string
+	Status string
+}
+
+// bulkErrors converts the [path, status] pairs returned by bulk operations.
+func bulkErrors(errors [][]string) []BulkError {
+	var s []BulkError
+	for _, e := range errors {
+		if len(e) == 2 {
+			s = append(s, BulkError{Path: e[0], Status: e[1]})
+		}
+	}
+	return s
+}
+
+// BulkDeleteResponse represents the response of a BulkDelete request.
+type BulkDeleteResponse struct {
+	NumberDeleted  int         `json:"Number Deleted"`
+	NumberNotFound int         `json:"Number Not Found"`
+	ResponseStatus string      `json:"Response Status"`
+	ResponseBody   string      `json:"Response Body"`
+	Errors         []BulkError `json:"-"`
+}
+
+// BulkDeleteResult represents the result of a BulkDelete request.
+type BulkDeleteResult struct {
+	golangsdk.Result
+}
+
+// Extract will return the response of a BulkDelete request.
+func (r BulkDeleteResult) Extract() (*BulkDeleteResponse, error) {
+	var s struct {
+		BulkDeleteResponse
+		Errors [][]string `json:"Errors"`
+	}
+	err := r.ExtractInto(&s)
+	s.BulkDeleteResponse.Errors = bulkErrors(s.Errors)
+	return &s.BulkDeleteResponse, err
+}
+
+// ExtractArchiveResponse represents the response of an ExtractArchive
+// request.
+type ExtractArchiveResponse struct {
+	NumberFilesCreated int         `json:"Number Files Created"`
+	ResponseStatus     string      `json:"Response Status"`
+	ResponseBody       string      `json:"Response Body"`
+	Errors             []BulkError `json:"-"`
+}
+
+// ExtractArchiveResult represents the result of an ExtractArchive request.
+type ExtractArchiveResult struct {
+	golangsdk.Result
+}
+
+// Extract will return the response of an ExtractArchive request.
+func (r ExtractArchiveResult) Extract() (*ExtractArchiveResponse, error) {
+	var s struct {
+		ExtractArchiveResponse
+		Errors [][]string `json:"Errors"`
+	}
+	err := r.ExtractInto(&s)
+	s.ExtractArchiveResponse.Errors = bulkErrors(s.Errors)
+	return &s.ExtractArchiveResponse, err
+}
@@ -0,0 +1,85 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+// HandleCreateSLOManifestSuccessfully sets up the test server to respond to
+// the creation of a static large object manifest.
+func HandleCreateSLOManifestSuccessfully(t *testing.T, body string) {
+	th.Mux.HandleFunc("/testContainer/large.txt", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"multipart-manifest": "put"})
+		th.TestJSONRequest(t, r, body)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// HandleCreateDLOManifestSuccessfully sets up the test server to respond to
+// the creation of a dynamic large object manifest.
+func HandleCreateDLOManifestSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/large.txt", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-Object-Manifest", "testContainer_segments/large.txt/")
+		th.TestBody(t, r, "")
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// LargeObjectManifest is the expected manifest of the large object uploaded
+// by HandleUploadLargeObjectSuccessfully, whose content is "abcdefghij" split
+// into segments of 4 bytes.
+const LargeObjectManifest = `
+[
+	{"path": "testContainer_segments/large.txt/00000000", "etag": "e2fc714c4727ee9395f324cd2e7f331f", "size_bytes": 4},
+	{"path": "testContainer_segments/large.txt/00000001", "etag": "1f7690ebdd9b4caf8fab49ca1757bf27", "size_bytes": 4},
+	{"path": "testContainer_segments/large.txt/00000002", "etag": "7bed657a775c37c2570786d0cbeefd88", "size_bytes": 2}
+]
+`
+
+// HandleUploadLargeObjectSuccessfully sets up the test server to respond to
+// the upload of the segments of a large object, then of its manifest. The
+// first segment is only acknowledged once the last one is received, so the
+// upload only completes when the segments are uploaded in parallel.
+func HandleUploadLargeObjectSuccessfully(t *testing.T) {
+	etags := map[string]string{
+		"00000000": "e2fc714c4727ee9395f324cd2e7f331f",
+		"00000001": "1f7690ebdd9b4caf8fab49ca1757bf27",
+		"00000002": "7bed657a775c37c2570786d0cbeefd88",
+	}
+	last := make(chan struct{})
+	var once sync.Once
+
+	th.Mux.HandleFunc("/testContainer_segments/large.txt/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		segment := r.URL.Path[len("/testContainer_segments/large.txt/"):]
+		th.TestHeader(t, r, "ETag", etags[segment])
+		switch segment {
+		case "00000000":
+			select {
+			case <-last:
+			case <-time.After(5 * time.Second):
+				t.Errorf("The segments were not uploaded in parallel")
+			}
+		case "00000002":
+			once.Do(func() { close(last) })
+		}
+		w.Header().Set("ETag", fmt.Sprintf("%q", etags[segment]))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	HandleCreateSLOManifestSuccessfully(t, LargeObjectManifest)
+}
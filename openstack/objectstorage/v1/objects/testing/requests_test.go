@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/objectstorage/v1/objects"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreateSLOManifest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSLOManifestSuccessfully(t, `
+		[
+			{"path": "testContainer_segments/part1", "etag": "0cc175b9c0f1b6a831c399e269772661", "size_bytes": 1},
+			{"path": "testContainer_segments/part2"}
+		]
+	`)
+
+	segments := []objects.SLOSegment{
+		{Path: "testContainer_segments/part1", ETag: "0cc175b9c0f1b6a831c399e269772661", SizeBytes: 1},
+		{Path: "testContainer_segments/part2"},
+	}
+	res := objects.CreateSLOManifest(fake.ServiceClient(), "testContainer", "large.txt", segments)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestCreateDLOManifest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateDLOManifestSuccessfully(t)
+
+	res := objects.CreateDLOManifest(fake.ServiceClient(), "testContainer", "large.txt", "testContainer_segments", "large.txt/")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUploadLargeObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUploadLargeObjectSuccessfully(t)
+
+	res := objects.UploadLargeObject(fake.ServiceClient(), "testContainer", "large.txt", objects.UploadLargeObjectOpts{
+		Content:     strings.NewReader("abcdefghij"),
+		SegmentSize: 4,
+		Concurrency: 3,
+	})
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUploadLargeObjectRequiresSegmentSize(t *testing.T) {
+	res := objects.UploadLargeObject(fake.ServiceClient(), "testContainer", "large.txt", objects.UploadLargeObjectOpts{
+		Content: strings.NewReader("abcdefghij"),
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a missing segment size")
+	}
+}
@@ -31,3 +31,11 @@ func downloadURL(c *golangsdk.ServiceClient, container, object string) string {
 func updateURL(c *golangsdk.ServiceClient, container, object string) string {
 	return copyURL(c, container, object)
 }
+
+func bulkDeleteURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL() + "?bulk-delete=true"
+}
+
+func extractArchiveURL(c *golangsdk.ServiceClient, uploadPath string, format ArchiveFormat) string {
+	return c.ServiceURL(uploadPath) + "?extract-archive=" + string(format)
+}
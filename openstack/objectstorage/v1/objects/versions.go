@@ -0,0 +1,24 @@
+package objects
+
+import (
+	"fmt"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/pagination"
+)
+
+// VersionsPrefix returns the prefix of the names under which the previous
+// versions of an object are archived in the container set as the
+// X-Versions-Location or X-History-Location of its container: the length of
+// the object name as three hexadecimal digits, the object name and a slash.
+func VersionsPrefix(objectName string) string {
+	return fmt.Sprintf("%03x%s/", len(objectName), objectName)
+}
+
+// ListVersions lists the previous versions of an object archived in
+// versionsContainer, oldest first. The names of the versions end with the
+// timestamp of their archival. opts.Prefix is overridden.
+func ListVersions(c *golangsdk.ServiceClient, versionsContainer, objectName string, opts ListOpts) pagination.Pager {
+	opts.Prefix = VersionsPrefix(objectName)
+	return List(c, versionsContainer, opts)
+}
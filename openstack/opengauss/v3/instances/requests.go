@@ -78,10 +78,11 @@ func Create(client *golangsdk.ServiceClient, opts CreateGaussDBBuilder) (r Creat
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -104,10 +105,11 @@ func UpdateVolume(client *golangsdk.ServiceClient, opts UpdateVolumeOptsBuilder,
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -139,20 +141,22 @@ func UpdateCluster(client *golangsdk.ServiceClient, opts UpdateClusterOptsBuilde
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteResult) {
 	url := deleteURL(client, instanceId)
 
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -267,10 +271,11 @@ func Rename(client *golangsdk.ServiceClient, opts RenameOptsBuilder, id string)
 		return
 	}
 
-	_, r.Err = client.Put(updateURL(client, id, "name"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, id, "name"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -293,9 +298,10 @@ func RestorePassword(client *golangsdk.ServiceClient, opts RestorePasswordOptsBu
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id, "password"), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id, "password"), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
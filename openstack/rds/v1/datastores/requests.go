@@ -11,9 +11,10 @@ var RequestOpts golangsdk.RequestOpts = golangsdk.RequestOpts{
 //list the version informations about a specified type of database
 func List(client *golangsdk.ServiceClient, dataStoreName string) (r ListResult) {
 
-	_, r.Err = client.Get(listURL(client, dataStoreName), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL(client, dataStoreName), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -11,9 +11,10 @@ var RequestOpts golangsdk.RequestOpts = golangsdk.RequestOpts{
 //list the flavors informations about a specified id of database
 func List(client *golangsdk.ServiceClient, dataStoreID string, region string) (r ListResult) {
 
-	_, r.Err = client.Get(listURL(client, dataStoreID, region), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL(client, dataStoreID, region), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -122,10 +122,11 @@ func Create(client *golangsdk.ServiceClient, ops CreateOpsBuilder) (r CreateResu
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -137,10 +138,11 @@ func UpdateVolumeSize(client *golangsdk.ServiceClient, ops UpdateOpsBuilder, id
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -151,10 +153,11 @@ func UpdatePolicy(client *golangsdk.ServiceClient, ops UpdatePolicyOpsBuilder, i
 		return
 	}
 
-	_, r.Err = client.Put(updatePolicyURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(updatePolicyURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -165,10 +168,11 @@ func UpdateFlavorRef(client *golangsdk.ServiceClient, ops UpdateFlavorOpsBuilder
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -177,29 +181,32 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 	RequestOpts.OkCodes = []int{202}
 	RequestOpts.JSONBody = nil
 	JSONBody := make(map[string]interface{})
-	_, r.Err = client.Delete(deleteURL(client, id), &golangsdk.RequestOpts{
+	resp, err := client.Delete(deleteURL(client, id), &golangsdk.RequestOpts{
 		OkCodes:     []int{202},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: JSONBody,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //get a instance with detailed information by id
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
 
-	_, r.Err = client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(getURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //list all the instances
 func List(client *golangsdk.ServiceClient) (r ListResult) {
 
-	_, r.Err = client.Get(listURL(client), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL(client), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
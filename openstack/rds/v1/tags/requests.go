@@ -48,10 +48,11 @@ func Create(client *golangsdk.ServiceClient, id string, opts CreateOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(resourceURL(client, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -62,18 +63,20 @@ func Delete(client *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder)
 		r.Err = err
 		return
 	}
-	_, r.Err = client.DeleteWithBody(resourceURL(client, id), b, &golangsdk.RequestOpts{
+	resp, err := client.DeleteWithBody(resourceURL(client, id), b, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get implements tag get request.
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(resourceURL(client, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
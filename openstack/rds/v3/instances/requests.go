@@ -86,9 +86,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateRdsBuilder) (r CreateRes
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -107,9 +108,10 @@ func CreateReplica(client *golangsdk.ServiceClient, opts CreateRdsBuilder) (r Cr
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -134,10 +136,11 @@ func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteResult)
 
 	url := deleteURL(client, instanceId)
 
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		JSONResponse: &r.Body,
 		MoreHeaders:  map[string]string{"Content-Type": "application/json"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -211,9 +214,10 @@ func Restart(client *golangsdk.ServiceClient, opts ActionInstanceBuilder, instan
 		return
 	}
 
-	_, r.Err = client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -231,9 +235,10 @@ func Rename(client *golangsdk.ServiceClient, opts ActionInstanceBuilder, instanc
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(updateURL(client, instanceId, "name"), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Put(updateURL(client, instanceId, "name"), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -256,9 +261,10 @@ func SingleToHa(client *golangsdk.ServiceClient, opts ActionInstanceBuilder, ins
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -281,9 +287,10 @@ func Resize(client *golangsdk.ServiceClient, opts ActionInstanceBuilder, instanc
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -306,9 +313,10 @@ func EnlargeVolume(client *golangsdk.ServiceClient, opts ActionInstanceBuilder,
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(updateURL(client, instanceId, "action"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -419,8 +427,9 @@ func GetRDSJob(client *golangsdk.ServiceClient, opts RDSJobBuilder) (r RDSJobRes
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: map[string]string{"Content-Type": "application/json", "X-Language": "en-us"},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
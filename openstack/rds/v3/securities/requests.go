@@ -32,9 +32,10 @@ func UpdateSSL(client *golangsdk.ServiceClient, instanceId string, opts SSLOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(rootURL(client, instanceId, "ssl"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(rootURL(client, instanceId, "ssl"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -68,9 +69,10 @@ func UpdatePort(client *golangsdk.ServiceClient, instanceId string, opts PortOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(rootURL(client, instanceId, "port"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(rootURL(client, instanceId, "port"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -102,9 +104,10 @@ func UpdateSecGroup(client *golangsdk.ServiceClient, instanceId string, opts Sec
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Put(rootURL(client, instanceId, "security-group"), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(rootURL(client, instanceId, "security-group"), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -0,0 +1,57 @@
+/*
+Package resourcetypes provides operations for listing the resource types
+supported by the orchestration service, and retrieving their schemas, so that
+templates can be checked before creating stacks.
+
+Example to List Resource Types
+
+	listOpts := resourcetypes.ListOpts{
+		SupportStatus:   resourcetypes.SupportStatusSupported,
+		WithDescription: true,
+	}
+
+	types, err := resourcetypes.List(orchestrationClient, listOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, t := range types {
+		fmt.Printf("%s: %s\n", t.ResourceType, t.Description)
+	}
+
+Example to Get the Schema of a Resource Type
+
+	schema, err := resourcetypes.GetSchema(orchestrationClient, "OS::Nova::Server").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for name, property := range schema.Properties {
+		fmt.Printf("%s (%s, required: %t)\n", name, property.Type, property.Required)
+	}
+
+Example to Generate a Template for a Resource Type
+
+	opts := resourcetypes.GenerateTemplateOpts{
+		TemplateType: resourcetypes.TemplateTypeHOT,
+	}
+
+	template, err := resourcetypes.GenerateTemplate(orchestrationClient, "OS::Nova::Server", opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Validate the Resource Types of a Template Before Creating a Stack
+
+	template := new(stacks.Template)
+	template.Bin = []byte(templateData)
+	if err := template.Parse(); err != nil {
+		panic(err)
+	}
+
+	err := resourcetypes.ValidateTemplate(orchestrationClient, template.Parsed)
+	if err != nil {
+		panic(err)
+	}
+*/
+package resourcetypes
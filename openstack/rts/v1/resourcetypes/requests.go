@@ -0,0 +1,111 @@
+package resourcetypes
+
+import (
+	"github.com/huaweicloud/golangsdk"
+)
+
+// SupportStatus is the support status of a resource type.
+type SupportStatus string
+
+const (
+	SupportStatusUnknown     SupportStatus = "UNKNOWN"
+	SupportStatusSupported   SupportStatus = "SUPPORTED"
+	SupportStatusDeprecated  SupportStatus = "DEPRECATED"
+	SupportStatusUnsupported SupportStatus = "UNSUPPORTED"
+	SupportStatusHidden      SupportStatus = "HIDDEN"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToResourceTypeListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the resource types returned by List.
+type ListOpts struct {
+	// NameRegex filters the resource types by a regular expression matched
+	// against their names.
+	NameRegex string `q:"name"`
+
+	// SupportStatus filters the resource types by their support status.
+	SupportStatus SupportStatus `q:"support_status"`
+
+	// WithDescription returns the description of each resource type along
+	// with its name.
+	WithDescription bool `q:"with_description"`
+}
+
+// ToResourceTypeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToResourceTypeListQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List retrieves the resource types supported by the orchestration service.
+func List(c *golangsdk.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToResourceTypeListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := c.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GetSchema retrieves the schema of the attributes and properties of a
+// resource type.
+func GetSchema(c *golangsdk.ServiceClient, resourceType string) (r GetSchemaResult) {
+	resp, err := c.Get(getSchemaURL(c, resourceType), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// GenerateTemplateOptsBuilder allows extensions to add additional parameters
+// to the GenerateTemplate request.
+type GenerateTemplateOptsBuilder interface {
+	ToGenerateTemplateQuery() (string, error)
+}
+
+// TemplateType is the format of a generated template.
+type TemplateType string
+
+const (
+	TemplateTypeHOT TemplateType = "hot"
+	TemplateTypeCFn TemplateType = "cfn"
+)
+
+// GenerateTemplateOpts specifies the format of the template generated by
+// GenerateTemplate.
+type GenerateTemplateOpts struct {
+	// TemplateType is the format of the template, hot by default.
+	TemplateType TemplateType `q:"template_type"`
+}
+
+// ToGenerateTemplateQuery formats a GenerateTemplateOpts into a query string.
+func (opts GenerateTemplateOpts) ToGenerateTemplateQuery() (string, error) {
+	q, err := golangsdk.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// GenerateTemplate retrieves a template with a single resource of the given
+// type, whose properties are exposed as template parameters and whose
+// attributes are exposed as outputs.
+func GenerateTemplate(c *golangsdk.ServiceClient, resourceType string, opts GenerateTemplateOptsBuilder) (r TemplateResult) {
+	url := generateTemplateURL(c, resourceType)
+	if opts != nil {
+		query, err := opts.ToGenerateTemplateQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := c.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
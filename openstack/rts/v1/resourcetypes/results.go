@@ -0,0 +1,107 @@
+package resourcetypes
+
+import (
+	"encoding/json"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ResourceTypeSummary is a resource type returned by List. Description is
+// only set when ListOpts.WithDescription is true.
+type ResourceTypeSummary struct {
+	ResourceType string `json:"resource_type"`
+	Description  string `json:"description"`
+}
+
+// UnmarshalJSON accepts both the name of a resource type, and an object
+// with its name and description.
+func (r *ResourceTypeSummary) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		*r = ResourceTypeSummary{ResourceType: name}
+		return nil
+	}
+
+	type tmp ResourceTypeSummary
+	var s tmp
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*r = ResourceTypeSummary(s)
+	return nil
+}
+
+// ListResult represents the result of a List operation.
+type ListResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the resource types of a List operation.
+func (r ListResult) Extract() ([]ResourceTypeSummary, error) {
+	var s struct {
+		ResourceTypes []ResourceTypeSummary `json:"resource_types"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ResourceTypes, err
+}
+
+// SupportStatusDetails describes since when a resource type, property or
+// attribute is supported, and its previous status.
+type SupportStatusDetails struct {
+	Status         SupportStatus         `json:"status"`
+	Message        string                `json:"message"`
+	Version        string                `json:"version"`
+	PreviousStatus *SupportStatusDetails `json:"previous_status"`
+}
+
+// AttributeSchema is the schema of an attribute of a resource type.
+type AttributeSchema struct {
+	Description string `json:"description"`
+	Type        string `json:"type"`
+}
+
+// PropertySchema is the schema of a property of a resource type.
+type PropertySchema struct {
+	Type          string                    `json:"type"`
+	Description   string                    `json:"description"`
+	Default       interface{}               `json:"default"`
+	Constraints   []interface{}             `json:"constraints"`
+	Required      bool                      `json:"required"`
+	Immutable     bool                      `json:"immutable"`
+	UpdateAllowed bool                      `json:"update_allowed"`
+	SupportStatus SupportStatusDetails      `json:"support_status"`
+	Schema        map[string]PropertySchema `json:"schema"`
+}
+
+// ResourceSchema is the schema of a resource type returned by GetSchema.
+type ResourceSchema struct {
+	ResourceType  string                     `json:"resource_type"`
+	SupportStatus SupportStatusDetails       `json:"support_status"`
+	Attributes    map[string]AttributeSchema `json:"attributes"`
+	Properties    map[string]PropertySchema  `json:"properties"`
+}
+
+// GetSchemaResult represents the result of a GetSchema operation.
+type GetSchemaResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the schema of a GetSchema operation.
+func (r GetSchemaResult) Extract() (*ResourceSchema, error) {
+	var s ResourceSchema
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// TemplateResult represents the result of a GenerateTemplate operation.
+type TemplateResult struct {
+	golangsdk.Result
+}
+
+// Extract returns the generated template. It can be marshaled to JSON or
+// YAML, which are both valid template formats.
+func (r TemplateResult) Extract() (map[string]interface{}, error) {
+	var s map[string]interface{}
+	err := r.ExtractInto(&s)
+	return s, err
+}
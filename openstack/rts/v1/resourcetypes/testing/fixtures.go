@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const listOutput = `
+{
+	"resource_types": [
+		"OS::Nova::Server",
+		"OS::Neutron::Net",
+		"OS::Heat::SoftwareConfig"
+	]
+}`
+
+const listWithDescriptionOutput = `
+{
+	"resource_types": [
+		{
+			"resource_type": "OS::Nova::Server",
+			"description": "A resource for managing Nova instances."
+		}
+	]
+}`
+
+const getSchemaOutput = `
+{
+	"resource_type": "OS::Heat::SoftwareConfig",
+	"support_status": {
+		"status": "SUPPORTED",
+		"message": null,
+		"version": "2014.1",
+		"previous_status": null
+	},
+	"attributes": {
+		"config": {
+			"description": "The config value of the software config.",
+			"type": "string"
+		}
+	},
+	"properties": {
+		"group": {
+			"type": "string",
+			"description": "Namespace to group this software config by.",
+			"default": "Heat::Ungrouped",
+			"required": false,
+			"update_allowed": false,
+			"immutable": false,
+			"support_status": {
+				"status": "SUPPORTED",
+				"version": "2014.1"
+			}
+		}
+	}
+}`
+
+const generateTemplateOutput = `
+{
+	"heat_template_version": "2016-10-14",
+	"description": "Initial template of SoftwareConfig",
+	"resources": {
+		"SoftwareConfig": {
+			"type": "OS::Heat::SoftwareConfig",
+			"properties": {
+				"group": {"get_param": "group"}
+			}
+		}
+	}
+}`
+
+// HandleListSuccessfully creates an HTTP handler at `/resource_types` on the
+// test handler mux that responds with a list of resource types.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		r.ParseForm()
+		if r.Form.Get("with_description") == "true" {
+			fmt.Fprintf(w, listWithDescriptionOutput)
+			return
+		}
+		fmt.Fprintf(w, listOutput)
+	})
+}
+
+// HandleGetSchemaSuccessfully creates an HTTP handler at
+// `/resource_types/OS::Heat::SoftwareConfig` on the test handler mux that
+// responds with the schema of the resource type.
+func HandleGetSchemaSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource_types/OS::Heat::SoftwareConfig", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, getSchemaOutput)
+	})
+}
+
+// HandleGenerateTemplateSuccessfully creates an HTTP handler at
+// `/resource_types/OS::Heat::SoftwareConfig/template` on the test handler mux
+// that responds with a generated template.
+func HandleGenerateTemplateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/resource_types/OS::Heat::SoftwareConfig/template", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"template_type": "hot"})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, generateTemplateOutput)
+	})
+}
@@ -0,0 +1,102 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/resourcetypes"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestListResourceTypes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	actual, err := resourcetypes.List(fake.ServiceClient(), nil).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []resourcetypes.ResourceTypeSummary{
+		{ResourceType: "OS::Nova::Server"},
+		{ResourceType: "OS::Neutron::Net"},
+		{ResourceType: "OS::Heat::SoftwareConfig"},
+	}, actual)
+
+	actual, err = resourcetypes.List(fake.ServiceClient(), resourcetypes.ListOpts{WithDescription: true}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []resourcetypes.ResourceTypeSummary{
+		{ResourceType: "OS::Nova::Server", Description: "A resource for managing Nova instances."},
+	}, actual)
+}
+
+func TestGetResourceTypeSchema(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSchemaSuccessfully(t)
+
+	actual, err := resourcetypes.GetSchema(fake.ServiceClient(), "OS::Heat::SoftwareConfig").Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &resourcetypes.ResourceSchema{
+		ResourceType: "OS::Heat::SoftwareConfig",
+		SupportStatus: resourcetypes.SupportStatusDetails{
+			Status:  resourcetypes.SupportStatusSupported,
+			Version: "2014.1",
+		},
+		Attributes: map[string]resourcetypes.AttributeSchema{
+			"config": {
+				Description: "The config value of the software config.",
+				Type:        "string",
+			},
+		},
+		Properties: map[string]resourcetypes.PropertySchema{
+			"group": {
+				Type:        "string",
+				Description: "Namespace to group this software config by.",
+				Default:     "Heat::Ungrouped",
+				SupportStatus: resourcetypes.SupportStatusDetails{
+					Status:  resourcetypes.SupportStatusSupported,
+					Version: "2014.1",
+				},
+			},
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGenerateTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGenerateTemplateSuccessfully(t)
+
+	opts := resourcetypes.GenerateTemplateOpts{TemplateType: resourcetypes.TemplateTypeHOT}
+	actual, err := resourcetypes.GenerateTemplate(fake.ServiceClient(), "OS::Heat::SoftwareConfig", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2016-10-14", actual["heat_template_version"])
+}
+
+func TestValidateTemplate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	template := map[string]interface{}{
+		"heat_template_version": "2016-10-14",
+		"resources": map[string]interface{}{
+			"server":  map[string]interface{}{"type": "OS::Nova::Server"},
+			"volume":  map[string]interface{}{"type": "OS::Cinder::Volume"},
+			"nested":  map[string]interface{}{"type": "nested.yaml"},
+			"network": map[string]interface{}{"type": "OS::Neutron::Net"},
+		},
+	}
+
+	err := resourcetypes.ValidateTemplate(fake.ServiceClient(), template)
+	unsupported, ok := err.(resourcetypes.ErrUnsupportedResourceTypes)
+	if !ok {
+		t.Fatalf("Expected ErrUnsupportedResourceTypes, got %v", err)
+	}
+	th.CheckDeepEquals(t, map[string]string{"volume": "OS::Cinder::Volume"}, unsupported.Resources)
+	th.AssertEquals(t, "Unsupported resource types in template: volume (OS::Cinder::Volume)", err.Error())
+
+	delete(template["resources"].(map[string]interface{}), "volume")
+	th.AssertNoErr(t, resourcetypes.ValidateTemplate(fake.ServiceClient(), template))
+}
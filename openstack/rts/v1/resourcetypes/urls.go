@@ -0,0 +1,17 @@
+package resourcetypes
+
+import "github.com/huaweicloud/golangsdk"
+
+const resourcePath = "resource_types"
+
+func listURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func getSchemaURL(c *golangsdk.ServiceClient, resourceType string) string {
+	return c.ServiceURL(resourcePath, resourceType)
+}
+
+func generateTemplateURL(c *golangsdk.ServiceClient, resourceType string) string {
+	return c.ServiceURL(resourcePath, resourceType, "template")
+}
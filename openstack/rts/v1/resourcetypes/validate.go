@@ -0,0 +1,87 @@
+package resourcetypes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// ErrUnsupportedResourceTypes is returned by ValidateTemplate when a template
+// uses resource types which the orchestration service does not support.
+type ErrUnsupportedResourceTypes struct {
+	golangsdk.BaseError
+
+	// Resources maps the names of the resources of the template to their
+	// unsupported types.
+	Resources map[string]string
+}
+
+func (e ErrUnsupportedResourceTypes) Error() string {
+	names := make([]string, 0, len(e.Resources))
+	for name := range e.Resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, len(names))
+	for i, name := range names {
+		msgs[i] = name + " (" + e.Resources[name] + ")"
+	}
+	return "Unsupported resource types in template: " + strings.Join(msgs, ", ")
+}
+
+// ValidateTemplate checks that every resource of a parsed template, such as
+// the Parsed field of a stacks.Template, uses a resource type listed by the
+// orchestration service. Both HOT ("resources" and "type") and CFN
+// ("Resources" and "Type") templates are handled. Provider resources whose
+// type is a nested template file or URL are not checked.
+func ValidateTemplate(c *golangsdk.ServiceClient, template map[string]interface{}) error {
+	types, err := List(c, nil).Extract()
+	if err != nil {
+		return err
+	}
+	supported := make(map[string]bool, len(types))
+	for _, t := range types {
+		supported[t.ResourceType] = true
+	}
+
+	unsupported := make(map[string]string)
+	for _, section := range []string{"resources", "Resources"} {
+		resources, ok := template[section].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for name, resource := range resources {
+			r, ok := resource.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, key := range []string{"type", "Type"} {
+				t, ok := r[key].(string)
+				if !ok || isTemplateResource(t) {
+					continue
+				}
+				if !supported[t] {
+					unsupported[name] = t
+				}
+			}
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return ErrUnsupportedResourceTypes{Resources: unsupported}
+	}
+	return nil
+}
+
+// isTemplateResource reports whether a resource type refers to a nested
+// template rather than a type registered in the service.
+func isTemplateResource(t string) bool {
+	for _, suffix := range []string{".yaml", ".yml", ".template", ".json"} {
+		if strings.HasSuffix(t, suffix) {
+			return true
+		}
+	}
+	return strings.Contains(t, "/")
+}
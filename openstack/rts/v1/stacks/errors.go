@@ -31,3 +31,24 @@ type ErrInvalidTemplateFormatVersion struct {
 func (e ErrInvalidTemplateFormatVersion) Error() string {
 	return fmt.Sprintf("Template format version not found.")
 }
+
+// ErrStackFailed is returned by WaitForStackStatus when a stack reaches a
+// failed status. It holds the first failed resource of the stack, if any.
+type ErrStackFailed struct {
+	golangsdk.BaseError
+	Status       string
+	StatusReason string
+
+	ResourceName         string
+	ResourceType         string
+	ResourceStatus       string
+	ResourceStatusReason string
+}
+
+func (e ErrStackFailed) Error() string {
+	if e.ResourceName == "" {
+		return fmt.Sprintf("Stack is in status %s: %s", e.Status, e.StatusReason)
+	}
+	return fmt.Sprintf("Stack is in status %s: resource %s (%s) is in status %s: %s",
+		e.Status, e.ResourceName, e.ResourceType, e.ResourceStatus, e.ResourceStatusReason)
+}
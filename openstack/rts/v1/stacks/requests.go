@@ -93,7 +93,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	resp, err := c.Post(createURL(c), b, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -207,7 +208,8 @@ func getStructField(v *ListedStack, field string) string {
 }
 
 func Get(c *golangsdk.ServiceClient, stackName string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, stackName), &r.Body, nil)
+	resp, err := c.Get(getURL(c, stackName), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -244,41 +246,50 @@ func (opts UpdateOpts) ToStackUpdateMap() (map[string]interface{}, error) {
 		return nil, err
 	}
 
-	if err := opts.TemplateOpts.Parse(); err != nil {
-		return nil, err
-	}
-
-	if err := opts.TemplateOpts.getFileContents(opts.TemplateOpts.Parsed, ignoreIfTemplate, true); err != nil {
-		return nil, err
-	}
-	opts.TemplateOpts.fixFileRefs()
-	b["template"] = string(opts.TemplateOpts.Bin)
+	return addUpdateFiles(b, opts.TemplateOpts, opts.EnvironmentOpts, opts.Tags)
+}
 
+// addUpdateFiles adds the template, environment and tags of an update to its
+// request body, along with the files they reference. The template is
+// optional for a patch update.
+func addUpdateFiles(b map[string]interface{}, template *Template, environment *Environment, tags []string) (map[string]interface{}, error) {
 	files := make(map[string]string)
-	for k, v := range opts.TemplateOpts.Files {
-		files[k] = v
+
+	if template != nil {
+		if err := template.Parse(); err != nil {
+			return nil, err
+		}
+		if err := template.getFileContents(template.Parsed, ignoreIfTemplate, true); err != nil {
+			return nil, err
+		}
+		template.fixFileRefs()
+		b["template"] = string(template.Bin)
+
+		for k, v := range template.Files {
+			files[k] = v
+		}
 	}
 
-	if opts.EnvironmentOpts != nil {
-		if err := opts.EnvironmentOpts.Parse(); err != nil {
+	if environment != nil {
+		if err := environment.Parse(); err != nil {
 			return nil, err
 		}
-		if err := opts.EnvironmentOpts.getRRFileContents(ignoreIfEnvironment); err != nil {
+		if err := environment.getRRFileContents(ignoreIfEnvironment); err != nil {
 			return nil, err
 		}
-		opts.EnvironmentOpts.fixFileRefs()
-		for k, v := range opts.EnvironmentOpts.Files {
+		environment.fixFileRefs()
+		for k, v := range environment.Files {
 			files[k] = v
 		}
-		b["environment"] = string(opts.EnvironmentOpts.Bin)
+		b["environment"] = string(environment.Bin)
 	}
 
 	if len(files) > 0 {
 		b["files"] = files
 	}
 
-	if opts.Tags != nil {
-		b["tags"] = strings.Join(opts.Tags, ",")
+	if tags != nil {
+		b["tags"] = strings.Join(tags, ",")
 	}
 
 	return b, nil
@@ -292,12 +303,83 @@ func Update(c *golangsdk.ServiceClient, stackName, stackID string, opts UpdateOp
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(updateURL(c, stackName, stackID), b, nil, nil)
+	resp, err := c.Put(updateURL(c, stackName, stackID), b, nil, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete deletes a stack based on the stack name and stack ID.
 func Delete(c *golangsdk.ServiceClient, stackName, stackID string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, stackName, stackID), nil)
+	resp, err := c.Delete(deleteURL(c, stackName, stackID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// UpdatePatchOptsBuilder is the interface options structs have to satisfy in
+// order to be used in the UpdatePatch operation in this package.
+type UpdatePatchOptsBuilder interface {
+	ToStackUpdatePatchMap() (map[string]interface{}, error)
+}
+
+// UpdatePatchOpts contains the options of a patch update. Unlike Update, the
+// template, environment and parameters of the stack which are not set are
+// kept as they are.
+type UpdatePatchOpts struct {
+	// A structure that contains either the template file or url. The
+	// existing template is reused if it is not set.
+	TemplateOpts *Template `json:"-"`
+	// A structure that contains details for the environment of the stack.
+	EnvironmentOpts *Environment `json:"-"`
+	// User-defined parameters to pass to the template. The existing
+	// parameters which are not set here are reused.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// The names of the existing parameters to reset to their default value.
+	ClearParameters []string `json:"clear_parameters,omitempty"`
+	// The timeout for stack update in minutes.
+	Timeout int `json:"timeout_mins,omitempty"`
+	// Enables or disables the rollback of the stack when the update fails.
+	DisableRollback *bool `json:"disable_rollback,omitempty"`
+	// A list of tags to assosciate with the Stack
+	Tags []string `json:"-"`
+}
+
+// ToStackUpdatePatchMap casts an UpdatePatchOpts struct to a map.
+func (opts UpdatePatchOpts) ToStackUpdatePatchMap() (map[string]interface{}, error) {
+	b, err := golangsdk.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return addUpdateFiles(b, opts.TemplateOpts, opts.EnvironmentOpts, opts.Tags)
+}
+
+// UpdatePatch accepts an UpdatePatchOpts struct and updates an existing stack,
+// reusing its existing template, environment and parameters for the values
+// which are not provided.
+func UpdatePatch(c *golangsdk.ServiceClient, stackName, stackID string, opts UpdatePatchOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToStackUpdatePatchMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Patch(updateURL(c, stackName, stackID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{202},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+	return
+}
+
+// CancelUpdate cancels the update of a stack in progress. The resources
+// already updated are rolled back to their previous state if rollback is
+// true, and left as they are otherwise.
+func CancelUpdate(c *golangsdk.ServiceClient, stackName, stackID string, rollback bool) (r ActionResult) {
+	action := "cancel_update"
+	if !rollback {
+		action = "cancel_without_rollback"
+	}
+	b := map[string]interface{}{action: nil}
+	resp, err := c.Post(actionURL(c, stackName, stackID), b, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{200},
+	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -193,6 +193,12 @@ type UpdateResult struct {
 	golangsdk.ErrResult
 }
 
+// ActionResult represents the result of a stack action, such as
+// CancelUpdate.
+type ActionResult struct {
+	golangsdk.ErrResult
+}
+
 // DeleteResult represents the result of a Delete operation.
 type DeleteResult struct {
 	golangsdk.ErrResult
@@ -243,3 +243,75 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleUpdatePatchSuccessfully creates an HTTP handler at
+// `/stacks/golangsdk-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada` on the
+// test handler mux that responds with a patch `Update` response.
+func HandleUpdatePatchSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/golangsdk-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PATCH")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"parameters": {"flavor": "m1.small"},
+			"clear_parameters": ["image"],
+			"disable_rollback": false
+		}`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleCancelUpdateSuccessfully creates an HTTP handler at
+// `/stacks/golangsdk-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada/actions`
+// on the test handler mux that expects the given action.
+func HandleCancelUpdateSuccessfully(t *testing.T, action string) {
+	th.Mux.HandleFunc("/stacks/golangsdk-test-stack-2/db6977b2-27aa-4775-9ae7-6213212d4ada/actions", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, fmt.Sprintf(`{"%s": null}`, action))
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// HandleWaitForFailedStack creates HTTP handlers on the test handler mux
+// that respond with a stack whose update failed, and its resources.
+func HandleWaitForFailedStack(t *testing.T) {
+	th.Mux.HandleFunc("/stacks/golangsdk-test-stack-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"stack": {
+				"id": "db6977b2-27aa-4775-9ae7-6213212d4ada",
+				"stack_name": "golangsdk-test-stack-2",
+				"stack_status": "UPDATE_FAILED",
+				"stack_status_reason": "Resource UPDATE failed"
+			}
+		}`)
+	})
+
+	th.Mux.HandleFunc("/stacks/golangsdk-test-stack-2/resources", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"resources": [
+				{
+					"resource_name": "network",
+					"resource_type": "OS::Neutron::Net",
+					"resource_status": "UPDATE_COMPLETE"
+				},
+				{
+					"resource_name": "server",
+					"resource_type": "OS::Nova::Server",
+					"resource_status": "UPDATE_FAILED",
+					"resource_status_reason": "No valid host was found."
+				}
+			]
+		}`)
+	})
+}
@@ -92,3 +92,43 @@ func TestDeleteStack(t *testing.T) {
 	err := stacks.Delete(fake.ServiceClient(), "golangsdk-test-stack-2", "db6977b2-27aa-4775-9ae7-6213212d4ada").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestUpdatePatchStack(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdatePatchSuccessfully(t)
+
+	disableRollback := false
+	updateOpts := stacks.UpdatePatchOpts{
+		Parameters:      map[string]string{"flavor": "m1.small"},
+		ClearParameters: []string{"image"},
+		DisableRollback: &disableRollback,
+	}
+	err := stacks.UpdatePatch(fake.ServiceClient(), "golangsdk-test-stack-2", "db6977b2-27aa-4775-9ae7-6213212d4ada", updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCancelUpdateStack(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCancelUpdateSuccessfully(t, "cancel_without_rollback")
+
+	err := stacks.CancelUpdate(fake.ServiceClient(), "golangsdk-test-stack-2", "db6977b2-27aa-4775-9ae7-6213212d4ada", false).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStackStatusFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleWaitForFailedStack(t)
+
+	err := stacks.WaitForStackStatus(fake.ServiceClient(), "golangsdk-test-stack-2", "UPDATE_COMPLETE", 5)
+	failed, ok := err.(stacks.ErrStackFailed)
+	if !ok {
+		t.Fatalf("Expected ErrStackFailed, got %v", err)
+	}
+	th.AssertEquals(t, "UPDATE_FAILED", failed.Status)
+	th.AssertEquals(t, "server", failed.ResourceName)
+	th.AssertEquals(t, "No valid host was found.", failed.ResourceStatusReason)
+	th.AssertEquals(t, "Stack is in status UPDATE_FAILED: resource server (OS::Nova::Server) is in status UPDATE_FAILED: No valid host was found.", err.Error())
+}
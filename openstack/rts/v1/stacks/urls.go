@@ -21,3 +21,7 @@ func updateURL(c *golangsdk.ServiceClient, name, id string) string {
 func deleteURL(c *golangsdk.ServiceClient, name, id string) string {
 	return updateURL(c, name, id)
 }
+
+func actionURL(c *golangsdk.ServiceClient, name, id string) string {
+	return c.ServiceURL("stacks", name, id, "actions")
+}
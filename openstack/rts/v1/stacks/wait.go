@@ -0,0 +1,64 @@
+package stacks
+
+import (
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/rts/v1/stackresources"
+)
+
+// WaitForStackStatus will continually poll a stack until it reaches the given
+// status, e.g. UPDATE_COMPLETE. It will do this for at most the number of
+// seconds specified.
+//
+// If the stack reaches a failed status instead, e.g. UPDATE_FAILED or
+// ROLLBACK_COMPLETE after a failed update, an ErrStackFailed is returned with
+// the failed resource and its status reason.
+func WaitForStackStatus(c *golangsdk.ServiceClient, stackName, status string, secs int) error {
+	return golangsdk.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, stackName).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if isFailedStatus(current.Status) {
+			return false, stackFailure(c, current)
+		}
+
+		return false, nil
+	})
+}
+
+// isFailedStatus reports whether a stack status is final and denotes a
+// failure, including a completed rollback.
+func isFailedStatus(status string) bool {
+	return strings.HasSuffix(status, "_FAILED") || status == "ROLLBACK_COMPLETE"
+}
+
+// stackFailure builds the error of a failed stack from its first failed
+// resource.
+func stackFailure(c *golangsdk.ServiceClient, stack *RetrievedStack) error {
+	e := ErrStackFailed{
+		Status:       stack.Status,
+		StatusReason: stack.StatusReason,
+	}
+
+	resources, err := stackresources.List(c, stack.Name, stackresources.ListOpts{})
+	if err != nil {
+		return e
+	}
+	for _, resource := range resources {
+		if strings.HasSuffix(resource.Status, "_FAILED") {
+			e.ResourceName = resource.Name
+			e.ResourceType = resource.Type
+			e.ResourceStatus = resource.Status
+			e.ResourceStatusReason = resource.StatusReason
+			break
+		}
+	}
+	return e
+}
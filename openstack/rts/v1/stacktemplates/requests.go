@@ -4,6 +4,7 @@ import "github.com/huaweicloud/golangsdk"
 
 // Get retreives data for the given stack template.
 func Get(c *golangsdk.ServiceClient, stackName, stackID string) (r GetResult) {
-	_, r.Err = c.Get(getURL(c, stackName, stackID), &r.Body, nil)
+	resp, err := c.Get(getURL(c, stackName, stackID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -42,10 +42,11 @@ func Import(c *golangsdk.ServiceClient, opts ImportOptsBuilder) (r ImportResult)
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Post(importURL(c), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(importURL(c), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 201},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -74,35 +75,39 @@ func Push(c *golangsdk.ServiceClient, id string, opts PushOptsBuilder) (r PushRe
 		return
 	}
 
-	_, r.Err = c.Post(pushURL(c, id), b, nil, &golangsdk.RequestOpts{
+	resp, err := c.Post(pushURL(c, id), b, nil, &golangsdk.RequestOpts{
 		OkCodes:     []int{204},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Obtain information about the imported certificate by ID.
 // Contain no certificate key or private key.
 func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(resourceURL(c, id), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get the certification key、private key and certification chain by id.
 func Export(c *golangsdk.ServiceClient, id string) (r ExportResult) {
 	body := map[string]interface{}{}
-	_, r.Err = c.Post(exportURL(c, id), body, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Post(exportURL(c, id), body, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 201},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete the imported certificate based on its unique ID.
 func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	resp, err := c.Delete(resourceURL(c, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
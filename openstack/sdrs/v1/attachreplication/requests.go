@@ -35,9 +35,10 @@ func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsB
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client, instanceID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -46,6 +47,7 @@ func Create(client *golangsdk.ServiceClient, instanceID string, opts CreateOptsB
 func Delete(client *golangsdk.ServiceClient, instanceID, replicationID string) (r JobResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = client.DeleteWithResponse(deleteURL(client, instanceID, replicationID), &r.Body, reqOpt)
+	resp, err := client.DeleteWithResponse(deleteURL(client, instanceID, replicationID), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
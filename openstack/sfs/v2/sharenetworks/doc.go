@@ -0,0 +1,70 @@
+/*
+Package sharenetworks enables management and retrieval of the share networks
+of the shared file system service. A share network holds the network
+information used by the share servers of the shares created in it.
+
+Example to List Share Networks
+
+	listOpts := sharenetworks.ListOpts{
+		NeutronNetID: "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+	}
+
+	allPages, err := sharenetworks.List(client, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allNetworks, err := sharenetworks.ExtractShareNetworks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, network := range allNetworks {
+		fmt.Printf("%+v\n", network)
+	}
+
+Example to Create a Share Network
+
+	createOpts := sharenetworks.CreateOpts{
+		Name:            "my_network",
+		NeutronNetID:    "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+		NeutronSubnetID: "53482b62-2c84-4a53-b6ab-30d9d9800d06",
+	}
+
+	network, err := sharenetworks.Create(client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	shareOpts := shares.CreateOpts{
+		Name:           "my_share",
+		ShareProto:     "NFS",
+		Size:           1,
+		ShareNetworkID: network.ID,
+	}
+
+	share, err := shares.Create(client, shareOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Share Network
+
+	name := "new_network_name"
+	updateOpts := sharenetworks.UpdateOpts{
+		Name: &name,
+	}
+
+	network, err := sharenetworks.Update(client, "713df749-aac0-4a54-af52-10f6c991e80c", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Share Network
+
+	err := sharenetworks.Delete(client, "713df749-aac0-4a54-af52-10f6c991e80c").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package sharenetworks
@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+const (
+	shareNetworkEndpoint = "/share-networks"
+	shareNetworkID       = "7f950b52-6141-4a08-bbb5-bb7ffa3ea5fd"
+)
+
+var createRequest = `{
+		"share_network": {
+			"name": "my_network",
+			"description": "This is my share network",
+			"neutron_net_id": "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+			"neutron_subnet_id": "53482b62-2c84-4a53-b6ab-30d9d9800d06"
+		}
+	}`
+
+var shareNetworkResponse = `{
+		"share_network": {
+			"name": "my_network",
+			"segmentation_id": null,
+			"created_at": "2015-09-07T14:37:00.583656",
+			"neutron_subnet_id": "53482b62-2c84-4a53-b6ab-30d9d9800d06",
+			"updated_at": null,
+			"id": "7f950b52-6141-4a08-bbb5-bb7ffa3ea5fd",
+			"neutron_net_id": "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+			"ip_version": null,
+			"nova_net_id": null,
+			"cidr": null,
+			"project_id": "16e1ab15c35a457e9c2b2aa189f544e1",
+			"network_type": null,
+			"description": "This is my share network"
+		}
+	}`
+
+var listResponse = `{
+		"share_networks": [
+			{
+				"name": "net_my",
+				"segmentation_id": 96,
+				"created_at": "2015-09-07T14:37:00.000000",
+				"neutron_subnet_id": "53482b62-2c84-4a53-b6ab-30d9d9800d06",
+				"updated_at": "2015-09-07T14:41:00.000000",
+				"id": "7f950b52-6141-4a08-bbb5-bb7ffa3ea5fd",
+				"neutron_net_id": "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+				"ip_version": 4,
+				"nova_net_id": null,
+				"cidr": "10.0.0.0/24",
+				"project_id": "16e1ab15c35a457e9c2b2aa189f544e1",
+				"network_type": "vlan",
+				"description": "descr"
+			}
+		]
+	}`
+
+// MockCreateResponse creates a mock response
+func MockCreateResponse(t *testing.T) {
+	th.Mux.HandleFunc(shareNetworkEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, createRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, shareNetworkResponse)
+	})
+}
+
+// MockListResponse creates a mock list response
+func MockListResponse(t *testing.T) {
+	th.Mux.HandleFunc(shareNetworkEndpoint+"/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"neutron_net_id": "998b42ee-2cee-4d36-8b95-67b5ca1f2109"})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, listResponse)
+	})
+}
+
+// MockGetResponse creates a mock get response
+func MockGetResponse(t *testing.T) {
+	th.Mux.HandleFunc(shareNetworkEndpoint+"/"+shareNetworkID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, shareNetworkResponse)
+	})
+}
+
+// MockDeleteResponse creates a mock delete response
+func MockDeleteResponse(t *testing.T) {
+	th.Mux.HandleFunc(shareNetworkEndpoint+"/"+shareNetworkID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk/openstack/sfs/v2/sharenetworks"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockCreateResponse(t)
+
+	options := sharenetworks.CreateOpts{
+		Name:            "my_network",
+		Description:     "This is my share network",
+		NeutronNetID:    "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+		NeutronSubnetID: "53482b62-2c84-4a53-b6ab-30d9d9800d06",
+	}
+	n, err := sharenetworks.Create(fake.ServiceClient(), options).Extract()
+
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, shareNetworkID, n.ID)
+	th.AssertEquals(t, "my_network", n.Name)
+	th.AssertEquals(t, time.Date(2015, 9, 7, 14, 37, 0, 583656000, time.UTC), n.CreatedAt)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockListResponse(t)
+
+	opts := sharenetworks.ListOpts{NeutronNetID: "998b42ee-2cee-4d36-8b95-67b5ca1f2109"}
+	allPages, err := sharenetworks.List(fake.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := sharenetworks.ExtractShareNetworks(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []sharenetworks.ShareNetwork{
+		{
+			ID:              shareNetworkID,
+			Name:            "net_my",
+			Description:     "descr",
+			ProjectID:       "16e1ab15c35a457e9c2b2aa189f544e1",
+			NeutronNetID:    "998b42ee-2cee-4d36-8b95-67b5ca1f2109",
+			NeutronSubnetID: "53482b62-2c84-4a53-b6ab-30d9d9800d06",
+			NetworkType:     "vlan",
+			SegmentationID:  96,
+			CIDR:            "10.0.0.0/24",
+			IPVersion:       4,
+			CreatedAt:       time.Date(2015, 9, 7, 14, 37, 0, 0, time.UTC),
+			UpdatedAt:       time.Date(2015, 9, 7, 14, 41, 0, 0, time.UTC),
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetResponse(t)
+
+	n, err := sharenetworks.Get(fake.ServiceClient(), shareNetworkID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "998b42ee-2cee-4d36-8b95-67b5ca1f2109", n.NeutronNetID)
+	th.AssertEquals(t, true, n.UpdatedAt.IsZero())
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockDeleteResponse(t)
+
+	err := sharenetworks.Delete(fake.ServiceClient(), shareNetworkID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,15 @@
+package sharenetworks
+
+import "github.com/huaweicloud/golangsdk"
+
+func createURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("share-networks")
+}
+
+func listDetailURL(c *golangsdk.ServiceClient) string {
+	return c.ServiceURL("share-networks", "detail")
+}
+
+func resourceURL(c *golangsdk.ServiceClient, id string) string {
+	return c.ServiceURL("share-networks", id)
+}
@@ -181,9 +181,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200, 201},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -215,22 +216,25 @@ func Update(c *golangsdk.ServiceClient, id string, opts UpdateOptsBuilder) (r Up
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get will get a single share with given UUID
 func Get(client *golangsdk.ServiceClient, id string) (r GetResult) {
-	_, r.Err = client.Get(resourceURL(client, id), &r.Body, nil)
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
 
 // Delete will delete an existing Share with the given UUID.
 func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
-	_, r.Err = client.Delete(resourceURL(client, id), nil)
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -239,9 +243,10 @@ func Delete(client *golangsdk.ServiceClient, id string) (r DeleteResult) {
 // Client must have Microversion set; minimum supported microversion for ListAccessRights is 2.7.
 func ListAccessRights(client *golangsdk.ServiceClient, share_id string) (r AccessRightsResult) {
 	requestBody := map[string]interface{}{"os-access_list": nil}
-	_, r.Err = client.Post(rootURL(client, share_id), requestBody, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, share_id), requestBody, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -278,9 +283,10 @@ func GrantAccess(client *golangsdk.ServiceClient, share_id string, opts GrantAcc
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, share_id), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, share_id), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -305,9 +311,10 @@ func DeleteAccess(client *golangsdk.ServiceClient, share_id string, opts DeleteA
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(rootURL(client, share_id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(rootURL(client, share_id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -315,7 +322,8 @@ func DeleteAccess(client *golangsdk.ServiceClient, share_id string, opts DeleteA
 func GetExportLocations(client *golangsdk.ServiceClient, id string) (r GetExportLocationsResult) {
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders}
-	_, r.Err = client.Get(getMountLocationsURL(client, id), &r.Body, reqOpt)
+	resp, err := client.Get(getMountLocationsURL(client, id), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -352,9 +360,10 @@ func Expand(client *golangsdk.ServiceClient, share_id string, opts ExpandOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(grantAccessURL(client, share_id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(grantAccessURL(client, share_id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -391,8 +400,9 @@ func Shrink(client *golangsdk.ServiceClient, share_id string, opts ShrinkOptsBui
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(grantAccessURL(client, share_id), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(grantAccessURL(client, share_id), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{202},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -9,9 +9,10 @@ var RequestOpts golangsdk.RequestOpts = golangsdk.RequestOpts{
 }
 
 func List(client *golangsdk.ServiceClient) (r ListResult) {
-	_, r.Err = client.Get(listURL(client), &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(listURL(client), &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders, JSONBody: nil,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -73,10 +73,11 @@ func Create(client *golangsdk.ServiceClient, opts CreateTaurusDBBuilder) (r Crea
 		return
 	}
 
-	_, r.Err = client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(createURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201, 202},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -105,10 +106,11 @@ func CreateReplica(client *golangsdk.ServiceClient, instanceId string, opts Crea
 		return
 	}
 
-	_, r.Err = client.Post(enlargeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(enlargeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201, 202},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -116,10 +118,11 @@ func CreateReplica(client *golangsdk.ServiceClient, instanceId string, opts Crea
 func DeleteReplica(client *golangsdk.ServiceClient, instanceId, nodeId string) (r JobResult) {
 	url := deleteReplicaURL(client, instanceId, nodeId)
 
-	_, r.Err = client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 202},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -127,10 +130,11 @@ func DeleteReplica(client *golangsdk.ServiceClient, instanceId, nodeId string) (
 func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteResult) {
 	url := deleteURL(client, instanceId)
 
-	_, r.Err = client.Delete(url, &golangsdk.RequestOpts{
+	resp, err := client.Delete(url, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 202},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -138,9 +142,10 @@ func Delete(client *golangsdk.ServiceClient, instanceId string) (r DeleteResult)
 func Get(client *golangsdk.ServiceClient, instanceId string) (r GetResult) {
 	url := getURL(client, instanceId)
 
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -235,10 +240,11 @@ func UpdateName(client *golangsdk.ServiceClient, instanceId string, opts UpdateN
 		return
 	}
 
-	_, r.Err = client.Put(nameURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Put(nameURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -266,10 +272,11 @@ func UpdatePass(client *golangsdk.ServiceClient, instanceId string, opts UpdateP
 		return
 	}
 
-	_, r.Err = client.Post(passwordURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(passwordURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -298,10 +305,11 @@ func ExtendVolume(client *golangsdk.ServiceClient, instanceId string, opts Exten
 		return
 	}
 
-	_, r.Err = client.Post(volumeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(volumeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -334,10 +342,11 @@ func Resize(client *golangsdk.ServiceClient, instanceId string, opts ResizeBuild
 		return
 	}
 
-	_, r.Err = client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -366,10 +375,11 @@ func EnableProxy(client *golangsdk.ServiceClient, instanceId string, opts ProxyB
 		return
 	}
 
-	_, r.Err = client.Post(proxyURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(proxyURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -397,10 +407,11 @@ func EnlargeProxy(client *golangsdk.ServiceClient, instanceId string, opts Enlar
 		return
 	}
 
-	_, r.Err = client.Post(proxyEnlargeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(proxyEnlargeURL(client, instanceId), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{201},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -408,10 +419,11 @@ func EnlargeProxy(client *golangsdk.ServiceClient, instanceId string, opts Enlar
 func DeleteProxy(client *golangsdk.ServiceClient, instanceId string) (r JobResult) {
 	url := proxyURL(client, instanceId)
 
-	_, r.Err = client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.DeleteWithResponse(url, &r.Body, &golangsdk.RequestOpts{
 		OkCodes:     []int{200, 202},
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -419,9 +431,10 @@ func DeleteProxy(client *golangsdk.ServiceClient, instanceId string) (r JobResul
 func GetProxy(client *golangsdk.ServiceClient, instanceId string) (r GetProxyResult) {
 	url := proxyURL(client, instanceId)
 
-	_, r.Err = client.Get(url, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Get(url, &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: requestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 
 	return
 }
@@ -69,6 +69,57 @@ func TestAuthenticatedClientV3(t *testing.T) {
 	th.CheckEquals(t, ID, client.TokenID)
 }
 
+func TestNewProviderFromToken(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", ID)
+		th.TestHeader(t, r, "X-Subject-Token", ID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"token": {
+					"expires_at": "2013-02-02T18:30:59.000000Z",
+					"project": {
+						"id": "project-id",
+						"domain": { "id": "domain-id" }
+					},
+					"catalog": [
+						{
+							"type": "compute",
+							"name": "nova",
+							"endpoints": [
+								{
+									"interface": "public",
+									"region": "RegionOne",
+									"url": "https://compute.example.com/v2.1"
+								}
+							]
+						}
+					]
+				}
+			}
+		`)
+	})
+
+	client, err := openstack.NewProviderFromToken(th.Endpoint()+"v3/", ID, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, ID, client.TokenID)
+	th.CheckEquals(t, "project-id", client.ProjectID)
+	th.CheckEquals(t, "domain-id", client.DomainID)
+
+	url, err := client.EndpointLocator(golangsdk.EndpointOpts{
+		Type:         "compute",
+		Region:       "RegionOne",
+		Availability: golangsdk.AvailabilityPublic,
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "https://compute.example.com/v2.1/", url)
+}
+
 func TestAuthenticatedClientV2(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -323,3 +374,10 @@ func TestAuthenticatedClientV3Fails(t *testing.T) {
 func TestAuthenticatedClientV2Fails(t *testing.T) {
 	testAuthenticatedClientFails(t, "http://bad-address.example.com/v2.0")
 }
+
+func TestNewMessagingV2RequiresClientID(t *testing.T) {
+	_, err := openstack.NewMessagingV2(&golangsdk.ProviderClient{}, "", golangsdk.EndpointOpts{})
+	if _, ok := err.(golangsdk.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack"
+	"github.com/huaweicloud/golangsdk/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/huaweicloud/golangsdk/pagination"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	fake "github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func newRegionalClient(client *golangsdk.ProviderClient, eo golangsdk.EndpointOpts) (*golangsdk.ServiceClient, error) {
+	return &golangsdk.ServiceClient{
+		ProviderClient: client,
+		Endpoint:       th.Endpoint() + eo.Region + "/",
+		ResourceBase:   th.Endpoint() + eo.Region + "/v2.0/",
+	}, nil
+}
+
+func handleRegionalLoadBalancers(t *testing.T) {
+	for _, region := range []string{"region-a", "region-c"} {
+		region := region
+		th.Mux.HandleFunc("/"+region+"/v2.0/lbaas/loadbalancers", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"loadbalancers": [{"id": "lb-%s", "name": "web"}]}`, region)
+		})
+	}
+	th.Mux.HandleFunc("/region-b/v2.0/lbaas/loadbalancers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	th.Mux.HandleFunc("/region-a/v2.0/lbaas/loadbalancers/lb-region-c", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	th.Mux.HandleFunc("/region-b/v2.0/lbaas/loadbalancers/lb-region-c", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	th.Mux.HandleFunc("/region-c/v2.0/lbaas/loadbalancers/lb-region-c", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"loadbalancer": {"id": "lb-region-c", "name": "web"}}`)
+	})
+}
+
+func TestMultiRegionClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleRegionalLoadBalancers(t)
+
+	m, err := openstack.NewMultiRegionClient(fake.ServiceClient().ProviderClient,
+		[]string{"region-a", "region-b", "region-c"}, golangsdk.EndpointOpts{}, newRegionalClient)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"region-a", "region-b", "region-c"}, m.Regions())
+
+	items, err := m.List(func(client *golangsdk.ServiceClient) pagination.Pager {
+		return loadbalancers.List(client, nil)
+	}, loadbalancers.ExtractLoadBalancers)
+	failed, ok := err.(openstack.ErrRegionsFailed)
+	if !ok {
+		t.Fatalf("Expected ErrRegionsFailed, got %v", err)
+	}
+	th.AssertEquals(t, 1, len(failed.Errors))
+	th.AssertEquals(t, 2, len(items))
+	th.AssertEquals(t, "region-a", items[0].Region)
+	th.AssertEquals(t, "lb-region-a", items[0].Item.(loadbalancers.LoadBalancer).ID)
+	th.AssertEquals(t, "region-c", items[1].Region)
+	th.AssertEquals(t, "lb-region-c", items[1].Item.(loadbalancers.LoadBalancer).ID)
+
+	item, err := m.Find(func(client *golangsdk.ServiceClient) (interface{}, error) {
+		return loadbalancers.Get(client, "lb-region-c").Extract()
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "region-c", item.Region)
+	th.AssertEquals(t, "lb-region-c", item.Item.(*loadbalancers.LoadBalancer).ID)
+}
+
+func TestMultiRegionClientListInvalidExtractor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	handleRegionalLoadBalancers(t)
+
+	m, err := openstack.NewMultiRegionClient(fake.ServiceClient().ProviderClient,
+		[]string{"region-a"}, golangsdk.EndpointOpts{}, newRegionalClient)
+	th.AssertNoErr(t, err)
+
+	list := func(client *golangsdk.ServiceClient) pagination.Pager {
+		return loadbalancers.List(client, nil)
+	}
+	extractors := []interface{}{
+		nil,
+		"ExtractLoadBalancers",
+		func(r loadbalancers.GetResult) ([]loadbalancers.LoadBalancer, error) { return nil, nil },
+		func(p pagination.Page) ([]loadbalancers.LoadBalancer, string) { return nil, "" },
+		func(p pagination.Page) (loadbalancers.LoadBalancer, error) { return loadbalancers.LoadBalancer{}, nil },
+	}
+	for _, extract := range extractors {
+		_, err := m.List(list, extract)
+		if err == nil {
+			t.Errorf("Expected an error for extractor %T", extract)
+		}
+	}
+}
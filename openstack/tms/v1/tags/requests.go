@@ -48,14 +48,16 @@ func BatchAction(client *golangsdk.ServiceClient, serverID string, opts BatchOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves all of the tags.
 func Get(client *golangsdk.ServiceClient) (r GetResult) {
-	_, r.Err = client.Get(rootURL(client), &r.Body, nil)
+	resp, err := client.Get(rootURL(client), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// SupportedMicroversions is the range of microversions supported by a service,
+// e.g. 2.1 to 2.79 for a recent compute service. It is empty if the service
+// doesn't support microversions.
+type SupportedMicroversions struct {
+	MinMajor int
+	MinMinor int
+	MaxMajor int
+	MaxMinor int
+}
+
+// Min returns the lowest supported microversion, e.g. "2.1".
+func (s SupportedMicroversions) Min() string {
+	return fmt.Sprintf("%d.%d", s.MinMajor, s.MinMinor)
+}
+
+// Max returns the highest supported microversion, e.g. "2.79".
+func (s SupportedMicroversions) Max() string {
+	return fmt.Sprintf("%d.%d", s.MaxMajor, s.MaxMinor)
+}
+
+// IsSupported returns whether a microversion, e.g. "2.53", is within the range.
+func (s SupportedMicroversions) IsSupported(version string) (bool, error) {
+	major, minor, err := ParseMicroversion(version)
+	if err != nil {
+		return false, err
+	}
+	return compareMicroversions(major, minor, s.MinMajor, s.MinMinor) >= 0 &&
+		compareMicroversions(major, minor, s.MaxMajor, s.MaxMinor) <= 0, nil
+}
+
+// ParseMicroversion splits a microversion, e.g. "2.53", into its major and
+// minor parts.
+func ParseMicroversion(version string) (major, minor int, err error) {
+	parts := strings.Split(version, ".")
+	if len(parts) == 2 {
+		major, err = strconv.Atoi(parts[0])
+		if err == nil {
+			minor, err = strconv.Atoi(parts[1])
+		}
+	}
+	if len(parts) != 2 || err != nil || major < 0 || minor < 0 {
+		e := golangsdk.ErrInvalidInput{}
+		e.Argument = "microversion"
+		e.Value = version
+		e.Info = "a microversion must be of the form MAJOR.MINOR"
+		return 0, 0, e
+	}
+	return major, minor, nil
+}
+
+func compareMicroversions(major1, minor1, major2, minor2 int) int {
+	switch {
+	case major1 != major2:
+		return major1 - major2
+	default:
+		return minor1 - minor2
+	}
+}
+
+// GetSupportedMicroversions returns the range of microversions supported by
+// the version of a service its endpoint points to, e.g. the v2.1 endpoint of
+// the compute service. The resources of the service may be under a project,
+// see ResourceBase, but the version is described at the endpoint itself.
+func GetSupportedMicroversions(client *golangsdk.ServiceClient) (SupportedMicroversions, error) {
+	type valueResp struct {
+		ID         string `json:"id"`
+		Status     string `json:"status"`
+		Version    string `json:"version"`
+		MinVersion string `json:"min_version"`
+	}
+
+	// The endpoint of a version describes it in "version", while the root
+	// endpoint of some services lists all of them in "versions".
+	var resp struct {
+		Version  *valueResp  `json:"version"`
+		Versions []valueResp `json:"versions"`
+	}
+
+	// The current microversion of the client may not be supported.
+	probe := *client
+	probe.Microversion = ""
+
+	var supported SupportedMicroversions
+	_, err := probe.Get(client.Endpoint, &resp, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 300},
+	})
+	if err != nil {
+		return supported, err
+	}
+
+	value := resp.Version
+	for i, v := range resp.Versions {
+		if strings.EqualFold(v.Status, "current") {
+			value = &resp.Versions[i]
+			break
+		}
+	}
+	if value == nil || value.Version == "" {
+		return supported, nil
+	}
+
+	supported.MaxMajor, supported.MaxMinor, err = ParseMicroversion(value.Version)
+	if err != nil {
+		return supported, err
+	}
+	if value.MinVersion == "" {
+		supported.MinMajor, supported.MinMinor = supported.MaxMajor, supported.MaxMinor
+		return supported, nil
+	}
+	supported.MinMajor, supported.MinMinor, err = ParseMicroversion(value.MinVersion)
+	return supported, err
+}
+
+// ErrMicroversionNotSupported is returned when a service doesn't support the
+// microversion required by an operation.
+type ErrMicroversionNotSupported struct {
+	golangsdk.BaseError
+	Required  string
+	Supported SupportedMicroversions
+}
+
+func (e ErrMicroversionNotSupported) Error() string {
+	if e.Supported == (SupportedMicroversions{}) {
+		return fmt.Sprintf("Microversion %s is required, but the service doesn't support microversions", e.Required)
+	}
+	return fmt.Sprintf("Microversion %s is required, but the service only supports %s to %s",
+		e.Required, e.Supported.Min(), e.Supported.Max())
+}
+
+// RequireMicroversion returns ErrMicroversionNotSupported if the service of
+// client doesn't support the required microversion.
+func RequireMicroversion(client *golangsdk.ServiceClient, required string) (SupportedMicroversions, error) {
+	supported, err := GetSupportedMicroversions(client)
+	if err != nil {
+		return supported, err
+	}
+	ok, err := supported.IsSupported(required)
+	if err != nil {
+		return supported, err
+	}
+	if !ok {
+		return supported, ErrMicroversionNotSupported{Required: required, Supported: supported}
+	}
+	return supported, nil
+}
+
+// NegotiateMicroversion sets the Microversion of client to preferred, or to
+// the highest microversion supported by its service if it is lower, so that
+// the newer features are used where available. It fails with
+// ErrMicroversionNotSupported if the service doesn't support minimum.
+func NegotiateMicroversion(client *golangsdk.ServiceClient, minimum, preferred string) (string, error) {
+	minMajor, minMinor, err := ParseMicroversion(minimum)
+	if err != nil {
+		return "", err
+	}
+	major, minor, err := ParseMicroversion(preferred)
+	if err != nil {
+		return "", err
+	}
+	if compareMicroversions(major, minor, minMajor, minMinor) < 0 {
+		e := golangsdk.ErrInvalidInput{}
+		e.Argument = "preferred"
+		e.Value = preferred
+		e.Info = fmt.Sprintf("the preferred microversion is lower than the minimum %s", minimum)
+		return "", e
+	}
+
+	supported, err := RequireMicroversion(client, minimum)
+	if err != nil {
+		return "", err
+	}
+
+	version := preferred
+	if compareMicroversions(major, minor, supported.MaxMajor, supported.MaxMinor) > 0 {
+		version = supported.Max()
+	}
+	client.Microversion = version
+	return version, nil
+}
@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	"github.com/huaweicloud/golangsdk/openstack/utils"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/client"
+)
+
+func setupMicroversionHandler(t *testing.T) {
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-OpenStack-Nova-API-Version", "")
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"version": {
+					"id": "v2.1",
+					"status": "CURRENT",
+					"version": "2.53",
+					"min_version": "2.1"
+				}
+			}
+		`)
+	})
+}
+
+func microversionClient() *golangsdk.ServiceClient {
+	c := client.ServiceClient()
+	c.Endpoint = th.Endpoint() + "v2.1/"
+	c.ResourceBase = c.Endpoint + "project/"
+	c.Type = "compute"
+	return c
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupMicroversionHandler(t)
+
+	supported, err := utils.GetSupportedMicroversions(microversionClient())
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, utils.SupportedMicroversions{MinMajor: 2, MinMinor: 1, MaxMajor: 2, MaxMinor: 53}, supported)
+
+	for version, expected := range map[string]bool{"2.0": false, "2.1": true, "2.10": true, "2.53": true, "2.54": false, "3.1": false} {
+		ok, err := supported.IsSupported(version)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, expected, ok)
+	}
+	_, err = supported.IsSupported("latest")
+	if err == nil {
+		t.Error("Expected an error for an invalid microversion")
+	}
+}
+
+func TestNegotiateMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupMicroversionHandler(t)
+
+	c := microversionClient()
+	c.Microversion = "2.90"
+
+	version, err := utils.NegotiateMicroversion(c, "2.26", "2.79")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.53", version)
+	th.CheckEquals(t, "2.53", c.Microversion)
+
+	version, err = utils.NegotiateMicroversion(c, "2.26", "2.40")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.40", version)
+
+	_, err = utils.RequireMicroversion(c, "2.60")
+	if _, ok := err.(utils.ErrMicroversionNotSupported); !ok {
+		t.Errorf("Expected ErrMicroversionNotSupported, got %v", err)
+	}
+	th.CheckEquals(t, "2.40", c.Microversion)
+}
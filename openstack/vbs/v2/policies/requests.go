@@ -66,9 +66,10 @@ func Create(client *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateRe
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(commonURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(commonURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -120,13 +121,15 @@ func Update(c *golangsdk.ServiceClient, policyID string, opts UpdateOptsBuilder)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(resourceURL(c, policyID), b, &r.Body, reqOpt)
+	resp, err := c.Put(resourceURL(c, policyID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //Delete will delete the specified backup policy.
 func Delete(c *golangsdk.ServiceClient, policyID string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, policyID), nil)
+	resp, err := c.Delete(resourceURL(c, policyID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -248,9 +251,10 @@ func Associate(client *golangsdk.ServiceClient, opts AssociateOpts) (r ResourceR
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(associateURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(associateURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -286,8 +290,9 @@ func Disassociate(client *golangsdk.ServiceClient, policyID string, opts Disasso
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(disassociateURL(client, policyID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(disassociateURL(client, policyID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -39,19 +39,22 @@ func Create(client *golangsdk.ServiceClient, policyID string, opts CreateOptsBui
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{204}}
-	_, r.Err = client.Post(commonURL(client, policyID), b, nil, reqOpt)
+	resp, err := client.Post(commonURL(client, policyID), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 //deletes a tag ,specified in the key for a backup policy.
 func Delete(c *golangsdk.ServiceClient, policyID string, key string) (r DeleteResult) {
-	_, r.Err = c.Delete(deleteURL(c, policyID, key), nil)
+	resp, err := c.Delete(deleteURL(c, policyID, key), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves the tags of a specific backup policy.
 func Get(client *golangsdk.ServiceClient, policyID string) (r GetResult) {
-	_, r.Err = client.Get(commonURL(client, policyID), &r.Body, nil)
+	resp, err := client.Get(commonURL(client, policyID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -100,9 +103,10 @@ func ListResources(client *golangsdk.ServiceClient, opts ListOptsBuilder) (r Que
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := client.Post(listURL(client), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -144,8 +148,9 @@ func BatchAction(client *golangsdk.ServiceClient, policyID string, opts BatchOpt
 		r.Err = err
 		return
 	}
-	_, r.Err = client.Post(actionURL(client, policyID), b, nil, &golangsdk.RequestOpts{
+	resp, err := client.Post(actionURL(client, policyID), b, nil, &golangsdk.RequestOpts{
 		OkCodes: []int{204},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
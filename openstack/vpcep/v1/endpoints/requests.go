@@ -50,19 +50,22 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular VPC endpoint based on its unique ID
 func Get(c *golangsdk.ServiceClient, endpointID string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, endpointID), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, endpointID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular VPC endpoint based on its unique ID
 func Delete(c *golangsdk.ServiceClient, endpointID string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, endpointID), nil)
+	resp, err := c.Delete(resourceURL(c, endpointID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -96,7 +99,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) ([]Endpoint, er
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	if r.Err != nil {
 		return nil, r.Err
 	}
@@ -62,13 +62,15 @@ func Create(c *golangsdk.ServiceClient, opts PostOptsBuilder) (r CreateResult) {
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Get retrieves a particular nodes based on its unique ID and cluster ID.
 func Get(c *golangsdk.ServiceClient, serviceID string) (r GetResult) {
-	_, r.Err = c.Get(resourceURL(c, serviceID), &r.Body, nil)
+	resp, err := c.Get(resourceURL(c, serviceID), &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -104,15 +106,17 @@ func Update(c *golangsdk.ServiceClient, serviceID string, opts UpdateOptsBuilder
 		r.Err = err
 		return
 	}
-	_, r.Err = c.Put(resourceURL(c, serviceID), b, &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Put(resourceURL(c, serviceID), b, &r.Body, &golangsdk.RequestOpts{
 		OkCodes: []int{200},
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // Delete will permanently delete a particular node based on its unique ID and cluster ID.
 func Delete(c *golangsdk.ServiceClient, serviceID string) (r DeleteResult) {
-	_, r.Err = c.Delete(resourceURL(c, serviceID), nil)
+	resp, err := c.Delete(resourceURL(c, serviceID), nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -147,7 +151,8 @@ func List(client *golangsdk.ServiceClient, opts ListOptsBuilder) ([]Service, err
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	if r.Err != nil {
 		return nil, r.Err
 	}
@@ -171,7 +176,8 @@ func ListPublic(client *golangsdk.ServiceClient, opts ListOptsBuilder) ([]Public
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	if r.Err != nil {
 		return nil, r.Err
 	}
@@ -206,7 +212,8 @@ func ConnAction(c *golangsdk.ServiceClient, serviceID string, opts PostOptsBuild
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(connectionsActionURL(c, serviceID), b, &r.Body, reqOpt)
+	resp, err := c.Post(connectionsActionURL(c, serviceID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -235,7 +242,8 @@ func ListConnections(client *golangsdk.ServiceClient, serviceID string, opts Lis
 		}
 		url += query
 	}
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	if r.Err != nil {
 		return nil, r.Err
 	}
@@ -270,7 +278,8 @@ func PermAction(c *golangsdk.ServiceClient, serviceID string, opts PostOptsBuild
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(permissionsActionURL(c, serviceID), b, &r.Body, reqOpt)
+	resp, err := c.Post(permissionsActionURL(c, serviceID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -280,7 +289,8 @@ func ListPermissions(client *golangsdk.ServiceClient, serviceID string) ([]Permi
 	var r ListPermResult
 	url := permissionsURL(client, serviceID)
 
-	_, r.Err = client.Get(url, &r.Body, nil)
+	resp, err := client.Get(url, &r.Body, nil)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	if r.Err != nil {
 		return nil, r.Err
 	}
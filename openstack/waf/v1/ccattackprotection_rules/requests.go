@@ -58,7 +58,8 @@ func Create(c *golangsdk.ServiceClient, policyID string, opts CreateOptsBuilder)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -71,7 +72,8 @@ func Update(c *golangsdk.ServiceClient, policyID, ruleID string, opts CreateOpts
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(resourceURL(c, policyID, ruleID), b, &r.Body, reqOpt)
+	resp, err := c.Put(resourceURL(c, policyID, ruleID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -81,7 +83,8 @@ func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -91,6 +94,7 @@ func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	resp, err := c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -38,7 +38,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -67,7 +68,8 @@ func Update(c *golangsdk.ServiceClient, certID string, opts UpdateOptsBuilder) (
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(resourceURL(c, certID), b, nil, reqOpt)
+	resp, err := c.Put(resourceURL(c, certID), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -114,7 +116,8 @@ func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -124,6 +127,7 @@ func Delete(c *golangsdk.ServiceClient, id string) (r DeleteResult) {
 		OkCodes:     []int{200, 204},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
-	_, r.Err = c.Delete(resourceURL(c, id), reqOpt)
+	resp, err := c.Delete(resourceURL(c, id), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
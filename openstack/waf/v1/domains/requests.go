@@ -55,7 +55,8 @@ func Create(c *golangsdk.ServiceClient, opts CreateOptsBuilder) (r CreateResult)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -92,7 +93,8 @@ func Update(c *golangsdk.ServiceClient, domainID string, opts UpdateOptsBuilder)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(resourceURL(c, domainID), b, nil, reqOpt)
+	resp, err := c.Put(resourceURL(c, domainID), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -102,7 +104,8 @@ func Get(c *golangsdk.ServiceClient, id string) (r GetResult) {
 		OkCodes:     []int{200},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
-	_, r.Err = c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, id), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -141,6 +144,7 @@ func Delete(c *golangsdk.ServiceClient, id string, opts DeleteOptsBuilder) (r De
 		OkCodes:     []int{204},
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
-	_, r.Err = c.Delete(url, reqOpt)
+	resp, err := c.Delete(url, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
@@ -33,7 +33,8 @@ func Create(c *golangsdk.ServiceClient, policyID string, opts CreateOptsBuilder)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -62,7 +63,8 @@ func Update(c *golangsdk.ServiceClient, policyID, ruleID string, opts UpdateOpts
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Put(resourceURL(c, policyID, ruleID), b, nil, reqOpt)
+	resp, err := c.Put(resourceURL(c, policyID, ruleID), b, nil, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -72,15 +74,17 @@ func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
 // List retrieves falsealarmmasking rules.
 func List(c *golangsdk.ServiceClient, policyID string) (r ListResult) {
-	_, r.Err = c.Get(rootURL(c, policyID), &r.Body, &golangsdk.RequestOpts{
+	resp, err := c.Get(rootURL(c, policyID), &r.Body, &golangsdk.RequestOpts{
 		MoreHeaders: RequestOpts.MoreHeaders,
 	})
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -90,6 +94,7 @@ func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	resp, err := c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
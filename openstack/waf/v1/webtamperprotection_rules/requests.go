@@ -33,7 +33,8 @@ func Create(c *golangsdk.ServiceClient, policyID string, opts CreateOptsBuilder)
 		return
 	}
 	reqOpt := &golangsdk.RequestOpts{OkCodes: []int{200}}
-	_, r.Err = c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	resp, err := c.Post(rootURL(c, policyID), b, &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -43,7 +44,8 @@ func Get(c *golangsdk.ServiceClient, policyID, ruleID string) (r GetResult) {
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	resp, err := c.Get(resourceURL(c, policyID, ruleID), &r.Body, reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
 
@@ -53,6 +55,7 @@ func Delete(c *golangsdk.ServiceClient, policyID, ruleID string) (r DeleteResult
 		MoreHeaders: RequestOpts.MoreHeaders,
 	}
 
-	_, r.Err = c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	resp, err := c.Delete(resourceURL(c, policyID, ruleID), reqOpt)
+	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
 	return
 }
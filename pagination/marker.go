@@ -2,6 +2,7 @@ package pagination
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 
 	"github.com/huaweicloud/golangsdk"
@@ -26,18 +27,34 @@ type MarkerPageBase struct {
 
 // NextPageURL generates the URL for the page of results after this one.
 func (current MarkerPageBase) NextPageURL() (string, error) {
-	currentURL := current.URL
-
 	mark, err := current.Owner.LastMarker()
 	if err != nil {
 		return "", err
 	}
 
+	return NextMarkerURL(current.URL, mark), nil
+}
+
+// NextMarkerURL returns currentURL with its "marker" query parameter set to
+// marker. It returns "" to end the pagination when marker is empty or is the
+// marker of the current page, which would fetch the same page again.
+func NextMarkerURL(currentURL url.URL, marker string) string {
 	q := currentURL.Query()
-	q.Set("marker", mark)
+	if marker == "" || marker == q.Get("marker") {
+		return ""
+	}
+
+	q.Set("marker", marker)
 	currentURL.RawQuery = q.Encode()
+	return currentURL.String()
+}
 
-	return currentURL.String(), nil
+// PageInfo is the pagination metadata returned in a "page_info" object by the
+// APIs paginated with a server-provided marker, such as ELB v3.
+type PageInfo struct {
+	NextMarker     string `json:"next_marker"`
+	PreviousMarker string `json:"previous_marker"`
+	CurrentCount   int    `json:"current_count"`
 }
 
 // IsEmpty satisifies the IsEmpty method of the Page interface
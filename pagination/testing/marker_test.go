@@ -3,6 +3,7 @@ package testing
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -125,3 +126,15 @@ func TestAllPagesMarker(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, expected, actual)
 }
+
+func TestNextMarkerURL(t *testing.T) {
+	current, err := url.Parse("http://example.com/pools?limit=2")
+	testhelper.AssertNoErr(t, err)
+	next := pagination.NextMarkerURL(*current, "bbb")
+	testhelper.AssertEquals(t, "http://example.com/pools?limit=2&marker=bbb", next)
+
+	current, err = url.Parse(next)
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "", pagination.NextMarkerURL(*current, "bbb"))
+	testhelper.AssertEquals(t, "", pagination.NextMarkerURL(*current, ""))
+}
@@ -3,6 +3,7 @@ package golangsdk
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -220,6 +221,19 @@ func IntToPointer(i int) *int {
 	return &i
 }
 
+// BoolToPointer is a function for converting booleans into boolean pointers,
+// e.g. to set an optional flag to false. Enabled and Disabled can be used for
+// the fields of the EnabledState type.
+func BoolToPointer(b bool) *bool {
+	return &b
+}
+
+// StringToPointer is a function for converting strings into string pointers,
+// e.g. to clear an optional string by setting it to "".
+func StringToPointer(s string) *string {
+	return &s
+}
+
 /*
 MaybeString is an internal function to be used by request methods in individual
 resource packages.
@@ -419,7 +433,8 @@ will be converted into:
   }
 
 Untagged fields and fields left at their zero values are skipped. Integers,
-booleans and string values are supported.
+booleans and string values are supported, as well as times, which are
+formatted as HTTP dates.
 */
 func BuildHeaders(opts interface{}) (map[string]string, error) {
 	optsValue := reflect.ValueOf(opts)
@@ -454,6 +469,10 @@ func BuildHeaders(opts interface{}) (map[string]string, error) {
 						optsMap[tags[0]] = strconv.FormatInt(v.Int(), 10)
 					case reflect.Bool:
 						optsMap[tags[0]] = strconv.FormatBool(v.Bool())
+					case reflect.Struct:
+						if t, ok := v.Interface().(time.Time); ok {
+							optsMap[tags[0]] = t.UTC().Format(http.TimeFormat)
+						}
 					}
 				} else {
 					// if the field has a 'required' tag, it can't have a zero-value
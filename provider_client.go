@@ -2,6 +2,7 @@ package golangsdk
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -18,11 +20,16 @@ const (
 	DefaultMaxBackoffRetries = 5
 )
 
-// UserAgent represents a User-Agent header.
+// UserAgent represents a User-Agent header. It is made of the product tokens
+// of the tools built on the SDK, around DefaultUserAgent, so that the logs of
+// the cloud can tell which tool sent a request.
 type UserAgent struct {
 	// prepend is the slice of User-Agent strings to prepend to DefaultUserAgent.
 	// All the strings to prepend are accumulated and prepended in the Join method.
 	prepend []string
+
+	// append is the slice of User-Agent strings to append to DefaultUserAgent.
+	append []string
 }
 
 type RetryFunc func(context.Context, *ErrUnexpectedResponseCode, error, uint) error
@@ -33,13 +40,57 @@ func (ua *UserAgent) Prepend(s ...string) {
 	ua.prepend = append(s, ua.prepend...)
 }
 
+// Append appends user-defined strings to the default User-Agent string, after
+// the ones already appended, e.g. to report the plugins of a tool.
+func (ua *UserAgent) Append(s ...string) {
+	ua.append = append(ua.append, s...)
+}
+
 // Join concatenates all the user-defined User-Agend strings with the default
 // Gophercloud User-Agent string.
 func (ua *UserAgent) Join() string {
-	uaSlice := append(ua.prepend, DefaultUserAgent)
+	uaSlice := make([]string, 0, len(ua.prepend)+1+len(ua.append))
+	uaSlice = append(uaSlice, ua.prepend...)
+	uaSlice = append(uaSlice, DefaultUserAgent)
+	uaSlice = append(uaSlice, ua.append...)
 	return strings.Join(uaSlice, " ")
 }
 
+// ProductToken returns the User-Agent product token of a tool, e.g.
+// "terraform-provider/1.2.0 (linux; amd64)", to be given to Prepend or
+// Append. The characters which aren't allowed in a token are replaced by
+// dashes in name and version, and by spaces in comments.
+func ProductToken(name, version string, comments ...string) string {
+	token := userAgentToken(name)
+	if version != "" {
+		token += "/" + userAgentToken(version)
+	}
+	if len(comments) > 0 {
+		cleaned := make([]string, len(comments))
+		for i, c := range comments {
+			cleaned[i] = strings.Map(func(r rune) rune {
+				if r == '(' || r == ')' || r == ';' || r < ' ' || r > '~' {
+					return ' '
+				}
+				return r
+			}, c)
+		}
+		token += " (" + strings.Join(cleaned, "; ") + ")"
+	}
+	return token
+}
+
+// userAgentToken replaces the characters which aren't allowed in an HTTP
+// token, see RFC 7230 section 3.2.6, by dashes.
+func userAgentToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r > ' ' && r <= '~' && !strings.ContainsRune("\"(),/:;<=>?@[\\]{}", r) {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
 // ProviderClient stores details that are required to interact with any
 // services within a specific provider's API.
 //
@@ -63,6 +114,18 @@ type ProviderClient struct {
 	// To safely read or write this value, call `Token` or `SetToken`, respectively
 	TokenID string
 
+	// TokenExpiresAt is the expiry time of the token, or the zero time if unknown.
+	// NOTE: Like TokenID, this field shouldn't be set by an application outside of a custom
+	// ReauthFunc. To safely read or write this value, call `TokenExpiry` or `SetTokenExpiry`.
+	TokenExpiresAt time.Time
+
+	// RefreshBeforeExpiry, if set, reauthenticates ahead of the expiry of the token: a request
+	// sent when the token expires within this duration refreshes it first, so that long running
+	// operations, such as the pagination of large collections, don't start with a token about to
+	// expire. It requires a ReauthFunc and a known TokenExpiresAt, and should be well below the
+	// lifetime of the tokens.
+	RefreshBeforeExpiry time.Duration
+
 	// ProjectID is the ID of project to which User is authorized.
 	ProjectID string
 
@@ -74,6 +137,10 @@ type ProviderClient struct {
 	EndpointLocator EndpointLocator
 
 	// HTTPClient allows users to interject arbitrary http, https, or other transit behaviors.
+	// When its Transport is not set, the requests use http.DefaultTransport, unless one of the
+	// ConnectTimeout, MaxIdleConnsPerHost, IdleConnTimeout and DisableHTTP2 settings is set: they
+	// then use a transport shared by all the clients with the same settings, so that they reuse
+	// each other's connections.
 	HTTPClient http.Client
 
 	// UserAgent represents the User-Agent header in the HTTP request.
@@ -84,6 +151,13 @@ type ProviderClient struct {
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
 
+	// TokenStore, if set, is used to persist the auth token. The token is saved
+	// after each successful authentication. If the client doesn't hold a token
+	// yet, the stored one is loaded once, before its first authenticated
+	// request; a 401 response to it replaces it through ReauthFunc. NewClient
+	// sets a MemoryTokenStore.
+	TokenStore TokenStore
+
 	// AKSKAuthOptions provides the value for AK/SK authentication, it should be nil if you use token authentication,
 	// Otherwise, it must have a value
 	AKSKAuthOptions AKSKAuthOptions
@@ -91,16 +165,55 @@ type ProviderClient struct {
 	// Context is the context passed to the HTTP request.
 	Context context.Context
 
+	// Instrumentation, if set, is notified of the start and the end of every
+	// request.
+	Instrumentation Instrumentation
+
+	// Tracer, if set, creates a span for every request and propagates it in the
+	// request headers.
+	Tracer Tracer
+
 	// Retry backoff func
 	RetryBackoffFunc RetryFunc
 
 	// MaxBackoffRetries set the maximum number of backoffs. When not set, defaults to DefaultMaxBackoffRetries
 	MaxBackoffRetries uint
 
+	// Timeout, if set, is the default deadline of a request, including its reauthentication and
+	// backoff retries, and the reading of its response body. It can be overridden by
+	// RequestOpts.Timeout.
+	Timeout time.Duration
+
+	// ConnectTimeout, if set, limits the time spent establishing a connection.
+	ConnectTimeout time.Duration
+
+	// MaxIdleConnsPerHost is the number of idle connections kept per host, DefaultMaxIdleConnsPerHost
+	// if not set but another transport setting is. IdleConnTimeout is how long they are kept, 90
+	// seconds if not set.
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+
+	// DisableHTTP2 restricts the requests to HTTP/1.1, which is otherwise upgraded to HTTP/2 when
+	// the endpoint supports it.
+	DisableHTTP2 bool
+
+	// AcceptGzip requests gzip compressed responses explicitly and decompresses them. The default
+	// transport already does it transparently; it is meant for the custom transports which don't,
+	// such as the ones with DisableCompression set.
+	AcceptGzip bool
+
+	// MaxResponseSize, if set, is the largest response body accepted, in bytes. A larger body fails
+	// the request with ErrResponseTooLarge, or the reads of a body kept with KeepResponseBody. The
+	// bodies of failed requests are truncated to this size instead.
+	MaxResponseSize int64
+
 	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
 	// and setting the TokenID.
 	mut *sync.RWMutex
 
+	// tokenLoaded is set once the token has been looked up in the TokenStore.
+	tokenLoaded bool
+
 	// reauthmut is a mutex for reauthentication it attempts to ensure that only one reauthentication
 	// attempt happens at one time.
 	reauthmut *reauthlock
@@ -110,6 +223,20 @@ type ProviderClient struct {
 type reauthlock struct {
 	sync.RWMutex
 	reauthing bool
+
+	// ongoing is the reauthentication in progress, if any. Concurrent callers
+	// of Reauthenticate wait for it and share its outcome.
+	ongoing *reauthCall
+
+	// generation is incremented by each successful reauthentication.
+	generation uint64
+}
+
+// reauthCall is a reauthentication in progress. done is closed once it
+// completes, and err is its outcome.
+type reauthCall struct {
+	done chan struct{}
+	err  error
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
@@ -147,6 +274,39 @@ func (client *ProviderClient) Token() string {
 	return client.TokenID
 }
 
+// loadToken sets the token of the client from its TokenStore, if the client
+// doesn't hold one yet and hasn't looked it up before. It is skipped for the
+// requests sending their own token, such as the token creations, and during a
+// reauthentication, so that a stale stored token is never sent along them.
+func (client *ProviderClient) loadToken(options *RequestOpts) {
+	if client.TokenStore == nil || client.AKSKAuthOptions.AccessKey != "" {
+		return
+	}
+	if _, ownToken := options.MoreHeaders["X-Auth-Token"]; ownToken {
+		return
+	}
+	if client.reauthmut != nil {
+		client.reauthmut.RLock()
+		reauthing := client.reauthmut.reauthing
+		client.reauthmut.RUnlock()
+		if reauthing {
+			return
+		}
+	}
+
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	if client.tokenLoaded || client.TokenID != "" {
+		return
+	}
+	client.tokenLoaded = true
+	if t, err := client.TokenStore.LoadToken(); err == nil {
+		client.TokenID = t
+	}
+}
+
 // SetToken safely sets the value of the auth token in the ProviderClient. Applications may
 // use this method in a custom ReauthFunc
 func (client *ProviderClient) SetToken(t string) {
@@ -157,35 +317,139 @@ func (client *ProviderClient) SetToken(t string) {
 	client.TokenID = t
 }
 
+// TokenExpiry safely reads the expiry time of the auth token, which is the zero time if unknown.
+func (client *ProviderClient) TokenExpiry() time.Time {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.TokenExpiresAt
+}
+
+// SetTokenExpiry safely sets the expiry time of the auth token.
+func (client *ProviderClient) SetTokenExpiry(t time.Time) {
+	if client.mut != nil {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+	}
+	client.TokenExpiresAt = t
+}
+
+// TokenExpiresWithin reports whether the auth token expires within d. It returns false if the
+// expiry time is unknown.
+func (client *ProviderClient) TokenExpiresWithin(d time.Duration) bool {
+	expiry := client.TokenExpiry()
+	return !expiry.IsZero() && time.Until(expiry) < d
+}
+
+// tokenNeedsRefresh reports whether the token should be refreshed before sending a request,
+// according to RefreshBeforeExpiry.
+func (client *ProviderClient) tokenNeedsRefresh(options *RequestOpts) bool {
+	if client.RefreshBeforeExpiry <= 0 || client.ReauthFunc == nil || client.AKSKAuthOptions.AccessKey != "" {
+		return false
+	}
+	// The requests of the reauthentication itself send their own token.
+	if _, ownToken := options.MoreHeaders["X-Auth-Token"]; ownToken {
+		return false
+	}
+	if client.reauthmut != nil {
+		client.reauthmut.RLock()
+		reauthing := client.reauthmut.reauthing
+		client.reauthmut.RUnlock()
+		if reauthing {
+			return false
+		}
+	}
+	return client.TokenExpiresWithin(client.RefreshBeforeExpiry)
+}
+
+// SaveToken writes the current auth token to the client's TokenStore. It does
+// nothing if no TokenStore is set.
+func (client *ProviderClient) SaveToken() error {
+	if client.TokenStore == nil {
+		return nil
+	}
+	return client.TokenStore.SaveToken(client.Token())
+}
+
 // Reauthenticate calls client.ReauthFunc in a thread-safe way. If this is
 // called because of a 401 response, the caller may pass the previous token. In
 // this case, the reauthentication can be skipped if another thread has already
 // reauthenticated in the meantime. If no previous token is known, an empty
 // string should be passed instead to force unconditional reauthentication.
-func (client *ProviderClient) Reauthenticate(previousToken string) (err error) {
+//
+// When the token lock is in use, only one reauthentication runs at a time:
+// goroutines calling Reauthenticate while it is in progress wait for it and
+// get its result instead of reauthenticating again.
+func (client *ProviderClient) Reauthenticate(previousToken string) error {
+	return client.reauthenticate(previousToken, nil)
+}
+
+// reauthGeneration returns the number of successful reauthentications, to be
+// passed later to reauthenticate.
+func (client *ProviderClient) reauthGeneration() uint64 {
+	if client.reauthmut == nil {
+		return 0
+	}
+	client.reauthmut.RLock()
+	defer client.reauthmut.RUnlock()
+	return client.reauthmut.generation
+}
+
+// reauthenticate implements Reauthenticate. If generation is not nil, the
+// reauthentication is also skipped when another one has completed since
+// *generation was read from reauthGeneration.
+func (client *ProviderClient) reauthenticate(previousToken string, generation *uint64) (err error) {
 	if client.ReauthFunc == nil {
 		return nil
 	}
 
 	if client.mut == nil {
-		return client.ReauthFunc()
+		if err = client.ReauthFunc(); err != nil {
+			return err
+		}
+		return client.SaveToken()
 	}
 
-	client.mut.Lock()
-	defer client.mut.Unlock()
-
 	client.reauthmut.Lock()
+	if ongoing := client.reauthmut.ongoing; ongoing != nil {
+		client.reauthmut.Unlock()
+		<-ongoing.done
+		return ongoing.err
+	}
+
+	skip := generation != nil && *generation != client.reauthmut.generation
+	if !skip && previousToken != "" {
+		skip = client.Token() != previousToken
+	}
+	if skip {
+		client.reauthmut.Unlock()
+		return nil
+	}
+
+	call := &reauthCall{done: make(chan struct{})}
+	client.reauthmut.ongoing = call
 	client.reauthmut.reauthing = true
 	client.reauthmut.Unlock()
 
-	if previousToken == "" || client.TokenID == previousToken {
-		err = client.ReauthFunc()
+	client.mut.Lock()
+	err = client.ReauthFunc()
+	client.mut.Unlock()
+	if err == nil {
+		err = client.SaveToken()
 	}
 
 	client.reauthmut.Lock()
 	client.reauthmut.reauthing = false
+	client.reauthmut.ongoing = nil
+	if err == nil {
+		client.reauthmut.generation++
+	}
+	call.err = err
 	client.reauthmut.Unlock()
-	return
+	close(call.done)
+
+	return err
 }
 
 // RequestOpts customizes the behavior of the provider.Request() method.
@@ -195,8 +459,15 @@ type RequestOpts struct {
 	// It's an error to specify both a JSONBody and a RawBody.
 	JSONBody interface{}
 	// RawBody contains an io.Reader that will be consumed by the request directly. No content-type
-	// will be set unless one is provided explicitly by MoreHeaders.
+	// will be set unless one is provided explicitly by MoreHeaders. If RawBody is an io.Seeker, it is
+	// rewound before the request is sent again after a reauthentication or a backoff retry; other
+	// readers can only be sent once, so such requests are not retried.
 	RawBody io.Reader
+	// GetBody, if provided, returns a new reader of the body of the HTTP request. It is called for
+	// each attempt, which makes any request safe to retry. No content-type will be set unless one is
+	// provided explicitly by MoreHeaders. It's an error to specify GetBody along with a JSONBody or
+	// a RawBody.
+	GetBody func() (io.Reader, error)
 	// JSONResponse, if provided, will be populated with the contents of the response body parsed as
 	// JSON.
 	JSONResponse interface{}
@@ -213,6 +484,20 @@ type RequestOpts struct {
 	// KeepResponseBody specifies whether to keep the HTTP response body. Usually used, when the HTTP
 	// response body is considered for further use. Valid when JSONResponse is nil.
 	KeepResponseBody bool
+	// IfMatch and IfNoneMatch, if set, make the request conditional on the ETag of the resource. A
+	// request fails with ErrDefault412 if the resource doesn't match IfMatch, and a GET or HEAD
+	// request fails with ErrNotModified if it matches IfNoneMatch.
+	IfMatch     string
+	IfNoneMatch string
+	// GzipMinSize, if set, compresses a JSONBody of at least this many bytes with gzip and sets the
+	// Content-Encoding header of the request. The API must accept gzip encoded requests.
+	GzipMinSize int
+	// Timeout, if set, overrides the Timeout of the ProviderClient for this request. A negative
+	// value disables the deadline.
+	Timeout time.Duration
+	// RequestID, if set, is sent in the RequestIDHeader of the request, instead of the request ID
+	// carried by the Context of the ProviderClient, if any.
+	RequestID string
 }
 
 // requestState contains temporary state for a single ProviderClient.Request() call.
@@ -224,10 +509,75 @@ type requestState struct {
 	hasReauthenticated bool
 	// Retry-After backoff counter, increments during each backoff call
 	retries uint
+	// ctx is the context of the request, bound to its deadline if any.
+	ctx context.Context
+	// requestID is the ID sent by the client in the RequestIDHeader, if any.
+	requestID string
 }
 
 var applicationJSON = "application/json"
 
+// body returns the body of an attempt of the request, or nil if there is none, along with its
+// content encoding if it has been compressed. replay is set when the request has already been sent
+// once.
+func (opts *RequestOpts) body(replay bool) (io.Reader, string, error) {
+	provided := 0
+	for _, set := range []bool{opts.JSONBody != nil, opts.RawBody != nil, opts.GetBody != nil} {
+		if set {
+			provided++
+		}
+	}
+	if provided > 1 {
+		return nil, "", errors.New("Please provide only one of JSONBody, RawBody or GetBody to golangsdk.Request()")
+	}
+
+	switch {
+	case opts.GetBody != nil:
+		body, err := opts.GetBody()
+		return body, "", err
+	case opts.RawBody != nil:
+		if replay {
+			seeker, ok := opts.RawBody.(io.Seeker)
+			if !ok {
+				return nil, "", errors.New("The request body cannot be replayed: RawBody is not an io.Seeker")
+			}
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, "", err
+			}
+		}
+		return opts.RawBody, "", nil
+	case opts.JSONBody != nil:
+		rendered, err := jsonMarshal(opts.JSONBody)
+		if err != nil {
+			return nil, "", err
+		}
+		if opts.GzipMinSize <= 0 || len(rendered) < opts.GzipMinSize {
+			return bytes.NewReader(rendered), "", nil
+		}
+
+		compressed := &bytes.Buffer{}
+		zw := gzip.NewWriter(compressed)
+		if _, err := zw.Write(rendered); err != nil {
+			return nil, "", err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, "", err
+		}
+		return bytes.NewReader(compressed.Bytes()), "gzip", nil
+	}
+	return nil, "", nil
+}
+
+// replayable reports whether the request can be sent again, i.e. whether its body, if any, can be
+// produced once more.
+func (opts *RequestOpts) replayable() bool {
+	if opts.RawBody == nil {
+		return true
+	}
+	_, ok := opts.RawBody.(io.Seeker)
+	return ok
+}
+
 func jsonMarshal(t interface{}) ([]byte, error) {
 	buffer := &bytes.Buffer{}
 	enc := json.NewEncoder(buffer)
@@ -239,28 +589,95 @@ func jsonMarshal(t interface{}) ([]byte, error) {
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
 // header will automatically be provided.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
-	return client.doRequest(method, url, options, &requestState{
+	return client.request("", method, url, options)
+}
+
+// request sends the request on behalf of the given service within its deadline, if any.
+func (client *ProviderClient) request(service, method, url string, options *RequestOpts) (*http.Response, error) {
+	state := &requestState{
 		hasReauthenticated: false,
-	})
+		ctx:                client.Context,
+	}
+	state.requestID = options.clientRequestID(state.ctx)
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = client.Timeout
+	}
+	if timeout <= 0 {
+		return client.send(service, method, url, options, state)
+	}
+
+	ctx := state.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	state.ctx = ctx
+
+	resp, err := client.send(service, method, url, options, state)
+	if err != nil || !options.KeepResponseBody {
+		cancel()
+		return resp, err
+	}
+	// The body is read by the caller, so the deadline is released once it is closed.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
 }
 
-func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
-	var body io.Reader
-	var contentType *string
+// cancelOnClose is a response body which cancels the context of its request when it is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
 
-	// Derive the content body by either encoding an arbitrary object as JSON, or by taking a provided
-	// io.ReadSeeker as-is. Default the content-type to application/json.
-	if options.JSONBody != nil {
-		if options.RawBody != nil {
-			return nil, errors.New("Please provide only one of JSONBody or RawBody to golangsdk.Request()")
-		}
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
 
-		rendered, err := jsonMarshal(options.JSONBody)
-		if err != nil {
-			return nil, err
-		}
+// send sends the request, and reports it to the Instrumentation and the Tracer, if any.
+func (client *ProviderClient) send(service, method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
+	if client.Instrumentation == nil && client.Tracer == nil {
+		return client.doRequest(method, url, options, state)
+	}
+
+	event := RequestEvent{
+		Service:   service,
+		Method:    method,
+		URL:       url,
+		RequestID: state.requestID,
+	}
+	if client.Instrumentation != nil {
+		client.Instrumentation.OnRequestStart(event)
+	}
+	var span Span
+	if client.Tracer != nil {
+		span = client.startSpan(state.ctx, event, options)
+	}
 
-		body = bytes.NewReader(rendered)
+	start := time.Now()
+	resp, err := client.doRequest(method, url, options, state)
+
+	event.Duration = time.Since(start)
+	event.Err = err
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	if span != nil {
+		span.End(event)
+	}
+	if client.Instrumentation != nil {
+		client.Instrumentation.OnRequestEnd(event)
+	}
+
+	return resp, err
+}
+
+func (client *ProviderClient) doRequest(method, url string, options *RequestOpts, state *requestState) (*http.Response, error) {
+	var contentType *string
+	if options.JSONBody != nil {
 		contentType = &applicationJSON
 	}
 
@@ -269,8 +686,9 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		return nil, errors.New("cannot use KeepResponseBody when JSONResponse is not nil")
 	}
 
-	if options.RawBody != nil {
-		body = options.RawBody
+	body, contentEncoding, err := options.body(state.hasReauthenticated || state.retries > 0)
+	if err != nil {
+		return nil, err
 	}
 
 	// Construct the http.Request.
@@ -278,8 +696,18 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	if err != nil {
 		return nil, err
 	}
-	if client.Context != nil {
-		req = req.WithContext(client.Context)
+	if state.ctx != nil {
+		req = req.WithContext(state.ctx)
+	}
+	if options.GetBody != nil {
+		// Let the HTTP client replay the body on redirects as well.
+		req.GetBody = func() (io.ReadCloser, error) {
+			b, err := options.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(b), nil
+		}
 	}
 
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
@@ -288,6 +716,21 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		req.Header.Set("Content-Type", *contentType)
 	}
 	req.Header.Set("Accept", applicationJSON)
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	if client.AcceptGzip {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	if options.IfMatch != "" {
+		req.Header.Set("If-Match", options.IfMatch)
+	}
+	if options.IfNoneMatch != "" {
+		req.Header.Set("If-None-Match", options.IfNoneMatch)
+	}
+	if state.requestID != "" {
+		req.Header.Set(RequestIDHeader, state.requestID)
+	}
 
 	// Set the User-Agent header
 	req.Header.Set("User-Agent", client.UserAgent.Join())
@@ -302,11 +745,28 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 		}
 	}
 
+	if !state.hasReauthenticated && client.tokenNeedsRefresh(options) {
+		// The current token is still valid if the refresh fails, and a 401 response triggers
+		// another attempt once it has expired.
+		_ = client.reauthenticate(client.Token(), nil)
+	}
+
+	client.loadToken(options)
+	generation := client.reauthGeneration()
+
 	// get latest token from client
-	for k, v := range client.AuthenticatedHeaders() {
+	authHeaders := client.AuthenticatedHeaders()
+	for k, v := range authHeaders {
 		req.Header.Set(k, v)
 	}
 
+	// Requests sending their own token instead of the client's one, such as
+	// the token creations of a reauthentication, and signed requests can't be
+	// fixed by reauthenticating. Skipping it also avoids waiting on the
+	// reauthentication which may have sent them.
+	_, ownToken := options.MoreHeaders["X-Auth-Token"]
+	canReauth := !(ownToken && len(authHeaders) == 0) && client.AKSKAuthOptions.AccessKey == ""
+
 	prereqtok := req.Header.Get("X-Auth-Token")
 
 	if client.AKSKAuthOptions.AccessKey != "" {
@@ -326,10 +786,16 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	}
 
 	// Issue the request.
-	resp, err := client.HTTPClient.Do(req)
+	resp, err := client.httpClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
+	if client.AcceptGzip {
+		if err := decompressBody(method, resp); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
 
 	// Allow default OkCodes if none explicitly set
 	okc := options.OkCodes
@@ -347,36 +813,40 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	}
 
 	if !ok {
-		body, _ := ioutil.ReadAll(resp.Body)
+		var errBody io.Reader = resp.Body
+		if client.MaxResponseSize > 0 {
+			errBody = io.LimitReader(resp.Body, client.MaxResponseSize)
+		}
+		body, _ := ioutil.ReadAll(errBody)
 		resp.Body.Close()
 		respErr := ErrUnexpectedResponseCode{
-			URL:      url,
-			Method:   method,
-			Expected: okc,
-			Actual:   resp.StatusCode,
-			Body:     body,
+			URL:             url,
+			Method:          method,
+			Expected:        okc,
+			Actual:          resp.StatusCode,
+			Body:            body,
+			ResponseHeader:  resp.Header,
+			ClientRequestID: state.requestID,
+			APIError:        parseAPIError(body),
 		}
 
 		errType := options.ErrorContext
 		switch resp.StatusCode {
+		case http.StatusNotModified:
+			err = ErrNotModified{respErr}
 		case http.StatusBadRequest:
 			err = ErrDefault400{respErr}
 			if error400er, ok := errType.(Err400er); ok {
 				err = error400er.Error400(respErr)
 			}
 		case http.StatusUnauthorized:
-			if client.ReauthFunc != nil && !state.hasReauthenticated {
-				err = client.Reauthenticate(prereqtok)
+			if client.ReauthFunc != nil && canReauth && !state.hasReauthenticated && options.replayable() {
+				err = client.reauthenticate(prereqtok, &generation)
 				if err != nil {
 					e := &ErrUnableToReauthenticate{}
 					e.ErrOriginal = respErr
 					return nil, e
 				}
-				if options.RawBody != nil {
-					if seeker, ok := options.RawBody.(io.Seeker); ok {
-						seeker.Seek(0, 0)
-					}
-				}
 				state.hasReauthenticated = true
 				resp, err = client.doRequest(method, url, options, state)
 				if err != nil {
@@ -417,6 +887,17 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 			if error408er, ok := errType.(Err408er); ok {
 				err = error408er.Error408(respErr)
 			}
+		case http.StatusPreconditionFailed:
+			err = ErrDefault412{respErr}
+			if error412er, ok := errType.(Err412er); ok {
+				err = error412er.Error412(respErr)
+			}
+		case http.StatusRequestEntityTooLarge:
+			overLimit := ErrOverLimit{respErr, parseRetryAfter(resp.Header, body, time.Now())}
+			err = overLimit
+			if error413er, ok := errType.(Err413er); ok {
+				err = error413er.Error413(overLimit)
+			}
 		case http.StatusTooManyRequests:
 			err = ErrDefault429{respErr}
 			if error429er, ok := errType.(Err429er); ok {
@@ -428,10 +909,10 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 				maxTries = DefaultMaxBackoffRetries
 			}
 
-			if f := client.RetryBackoffFunc; f != nil && state.retries < maxTries {
+			if f := client.RetryBackoffFunc; f != nil && state.retries < maxTries && options.replayable() {
 				var e error
 
-				e = f(client.Context, &respErr, err, state.retries)
+				e = f(state.ctx, &respErr, err, state.retries)
 				if e != nil {
 					return resp, e
 				}
@@ -467,11 +948,17 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 			_, err = io.Copy(ioutil.Discard, resp.Body)
 			return resp, err
 		}
-		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
+		if err := client.decodeJSONResponse(method, url, resp, options.JSONResponse); err != nil {
 			return nil, err
 		}
 	}
 
+	if options.KeepResponseBody && client.MaxResponseSize > 0 {
+		resp.Body = &limitedBody{ReadCloser: resp.Body, n: client.MaxResponseSize, err: ErrResponseTooLarge{
+			URL: url, Method: method, Limit: client.MaxResponseSize,
+		}}
+	}
+
 	// Close unused body to allow the HTTP connection to be reused
 	if !options.KeepResponseBody && options.JSONResponse == nil {
 		defer resp.Body.Close()
@@ -484,6 +971,42 @@ func (client *ProviderClient) doRequest(method, url string, options *RequestOpts
 	return resp, nil
 }
 
+// decompressBody replaces the body of a gzip encoded response with its decompressed content.
+func decompressBody(method string, resp *http.Response) error {
+	if resp.Uncompressed || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") ||
+		method == "HEAD" || resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified {
+		return nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	switch err {
+	case nil:
+		resp.Body = &gzipBody{Reader: zr, body: resp.Body}
+	case io.EOF:
+		// An empty body isn't compressed.
+		resp.Body.Close()
+		resp.Body = http.NoBody
+	default:
+		return err
+	}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipBody is a decompressed response body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
 func defaultOkCodes(method string) []int {
 	switch method {
 	case "GET", "HEAD":
@@ -0,0 +1,125 @@
+package golangsdk
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket limiter which can be attached to one or more
+// ServiceClients to cap the number of requests sent per second. A single
+// RateLimiter may be shared between several clients to apply a global limit.
+//
+// Each request consumes one token, unless a different weight is configured for
+// its HTTP method in MethodWeights. Tokens are refilled continuously at Rate
+// tokens per second, up to Burst tokens.
+type RateLimiter struct {
+	// Rate is the number of tokens added to the bucket every second.
+	Rate float64
+
+	// Burst is the maximum number of tokens the bucket can hold.
+	Burst int
+
+	// MethodWeights overrides the number of tokens consumed by a request with
+	// the given HTTP method (e.g. "POST": 2). Methods not listed consume 1 token.
+	MethodWeights map[string]float64
+
+	// ErrorOnLimit makes Wait return an ErrRateLimited immediately instead of
+	// blocking until enough tokens are available.
+	ErrorOnLimit bool
+
+	mut    sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a blocking RateLimiter which allows rate requests per
+// second with bursts of up to burst requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		Rate:  rate,
+		Burst: burst,
+	}
+}
+
+// weight returns the number of tokens consumed by a request using method.
+func (l *RateLimiter) weight(method string) float64 {
+	if w, ok := l.MethodWeights[method]; ok && w >= 0 {
+		return w
+	}
+	return 1
+}
+
+// reserve refills the bucket and tries to take n tokens from it. If there are
+// not enough tokens, it returns the time to wait before trying again.
+func (l *RateLimiter) reserve(n float64) time.Duration {
+	l.mut.Lock()
+	defer l.mut.Unlock()
+
+	now := time.Now()
+	capacity := math.Max(float64(l.Burst), n)
+	if l.last.IsZero() {
+		l.tokens = float64(l.Burst)
+	} else {
+		l.tokens += now.Sub(l.last).Seconds() * l.Rate
+	}
+	l.tokens = math.Min(l.tokens, capacity)
+	l.last = now
+
+	if l.tokens >= n {
+		l.tokens -= n
+		return 0
+	}
+
+	if l.Rate <= 0 {
+		return -1
+	}
+	missing := n - l.tokens
+	return time.Duration(missing / l.Rate * float64(time.Second))
+}
+
+// Wait consumes the tokens required for a request using the given HTTP method.
+// In blocking mode it sleeps until the tokens are available or ctx is done.
+// When ErrorOnLimit is set, an ErrRateLimited is returned instead of waiting.
+func (l *RateLimiter) Wait(ctx context.Context, method string) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	n := l.weight(method)
+
+	for {
+		delay := l.reserve(n)
+		if delay == 0 {
+			return nil
+		}
+		if l.ErrorOnLimit || delay < 0 {
+			return ErrRateLimited{Method: method, RetryAfter: delay}
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ErrRateLimited is returned by a rate limited ServiceClient when a request
+// would exceed the configured RateLimiter and ErrorOnLimit is set.
+type ErrRateLimited struct {
+	BaseError
+	Method string
+	// RetryAfter is the time after which the request may succeed. It is
+	// negative when the limiter never refills.
+	RetryAfter time.Duration
+}
+
+func (e ErrRateLimited) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Client-side rate limit exceeded for %s request, retry after %s",
+		e.Method, e.RetryAfter)
+	return e.choseErrString()
+}
@@ -0,0 +1,57 @@
+package golangsdk
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestIDHeader is the header in which a client may send its own ID for a
+// request. Services record it as the global request ID of the request, which
+// allows to follow a call across the services it involves, and in the logs of
+// the application which sent it.
+const RequestIDHeader = "X-Openstack-Request-Id"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of ctx carrying the given request ID.
+// Requests sent by a ProviderClient whose Context carries a request ID send it
+// in the RequestIDHeader, unless RequestOpts.RequestID is set.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// WithRequestID returns a copy of the service client which sends the given
+// request ID with every request, e.g. to trace a single call of a package
+// function:
+//
+//	id := "req-" + uuid.New().String()
+//	err := listeners.Delete(client.WithRequestID(id), listenerID).ExtractErr()
+//
+// The ID is reported in the RequestEvent of the Instrumentation and in the
+// ErrUnexpectedResponseCode of a failed request.
+func (client *ServiceClient) WithRequestID(id string) *ServiceClient {
+	return client.WithHeaders(map[string]string{RequestIDHeader: id})
+}
+
+// clientRequestID returns the request ID to send with a request: the one set
+// in its MoreHeaders, or its RequestID, or the one carried by its context.
+func (opts *RequestOpts) clientRequestID(ctx context.Context) string {
+	for k, v := range opts.MoreHeaders {
+		if http.CanonicalHeaderKey(k) == RequestIDHeader {
+			return v
+		}
+	}
+	if opts.RequestID != "" {
+		return opts.RequestID
+	}
+	return RequestIDFromContext(ctx)
+}
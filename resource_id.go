@@ -0,0 +1,94 @@
+package golangsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ResourceID is the ID of a resource. Most services identify resources with
+// strings, usually UUIDs, but some use integers, and some switched from one to
+// the other between API versions. A ResourceID holds either kind, keeps it
+// when marshalled to JSON, and accepts both when unmarshalled, so IDs can be
+// passed between services without conversions.
+type ResourceID struct {
+	value   string
+	numeric bool
+}
+
+// FromInt returns the ResourceID of a resource identified by an integer.
+func FromInt(id int) ResourceID {
+	return ResourceID{value: strconv.Itoa(id), numeric: true}
+}
+
+// FromString returns the ResourceID of a resource identified by a string.
+func FromString(id string) ResourceID {
+	return ResourceID{value: id}
+}
+
+// String returns the ID as it appears in URLs.
+func (id ResourceID) String() string {
+	return id.value
+}
+
+// Int returns the ID as an integer. It fails if the ID is not an integer,
+// whether it was created from an int or from a string.
+func (id ResourceID) Int() (int, error) {
+	i, err := strconv.Atoi(id.value)
+	if err != nil {
+		return 0, fmt.Errorf("Resource ID %q is not an integer", id.value)
+	}
+	return i, nil
+}
+
+// IsInt returns whether the ID was created from an integer, or unmarshalled
+// from a JSON number.
+func (id ResourceID) IsInt() bool {
+	return id.numeric
+}
+
+// IsZero returns whether the ID is empty.
+func (id ResourceID) IsZero() bool {
+	return id.value == ""
+}
+
+// MarshalJSON marshals the ID as a JSON number if it is an integer, as a JSON
+// string otherwise, or as null if it is empty.
+func (id ResourceID) MarshalJSON() ([]byte, error) {
+	if id.IsZero() {
+		return []byte("null"), nil
+	}
+	if id.numeric {
+		return []byte(id.value), nil
+	}
+	return json.Marshal(id.value)
+}
+
+// UnmarshalJSON unmarshals the ID from a JSON number or string.
+func (id *ResourceID) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		*id = ResourceID{}
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		*id = FromString(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return err
+	}
+	i, err := n.Int64()
+	if err != nil {
+		return fmt.Errorf("Resource ID %s is not an integer", n)
+	}
+	*id = ResourceID{value: strconv.FormatInt(i, 10), numeric: true}
+	return nil
+}
@@ -0,0 +1,78 @@
+package golangsdk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// ResponseSnippetSize is the number of bytes of an undecodable response body kept in
+// ErrUnexpectedResponse.
+const ResponseSnippetSize = 512
+
+// decodeJSONResponse decodes the body of resp into v, within the MaxResponseSize of the client.
+func (client *ProviderClient) decodeJSONResponse(method, url string, resp *http.Response, v interface{}) error {
+	var body io.ReadCloser = resp.Body
+	if client.MaxResponseSize > 0 {
+		body = &limitedBody{ReadCloser: resp.Body, n: client.MaxResponseSize, err: ErrResponseTooLarge{
+			URL: url, Method: method, Limit: client.MaxResponseSize,
+		}}
+	}
+
+	snippet := &snippetWriter{}
+	err := json.NewDecoder(io.TeeReader(body, snippet)).Decode(v)
+	if tooLarge, ok := err.(ErrResponseTooLarge); ok {
+		return tooLarge
+	}
+	// An empty body is reported as io.EOF, as some results expect it.
+	if err != nil && err != io.EOF {
+		return ErrUnexpectedResponse{
+			URL:         url,
+			Method:      method,
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Snippet:     snippet.buf,
+			Err:         err,
+		}
+	}
+	return err
+}
+
+// limitedBody reads at most n bytes of a response body, and fails with err when there are more.
+type limitedBody struct {
+	io.ReadCloser
+	n   int64
+	err error
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.n <= 0 {
+		// The body may end exactly at the limit.
+		var probe [1]byte
+		if n, _ := b.ReadCloser.Read(probe[:]); n > 0 {
+			return 0, b.err
+		}
+		return 0, io.EOF
+	}
+	if int64(len(p)) > b.n {
+		p = p[:b.n]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.n -= int64(n)
+	return n, err
+}
+
+// snippetWriter keeps the first ResponseSnippetSize bytes written to it.
+type snippetWriter struct {
+	buf []byte
+}
+
+func (w *snippetWriter) Write(p []byte) (int, error) {
+	if room := ResponseSnippetSize - len(w.buf); room > 0 {
+		if len(p) < room {
+			room = len(p)
+		}
+		w.buf = append(w.buf, p[:room]...)
+	}
+	return len(p), nil
+}
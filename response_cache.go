@@ -0,0 +1,163 @@
+package golangsdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CachedResponse is a successful response to a GET request kept in a ResponseCache.
+type CachedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// ResponseCache stores the responses to the GET requests of the ServiceClients it is attached
+// to. Implementations must be safe for concurrent use; NewMemoryResponseCache returns an
+// in-memory one.
+type ResponseCache interface {
+	// Get returns the response stored for key, if it hasn't expired.
+	Get(key string) (*CachedResponse, bool)
+
+	// Set stores the response for key until ttl has elapsed.
+	Set(key string, response *CachedResponse, ttl time.Duration)
+
+	// DeletePrefix removes the responses whose key starts with prefix.
+	DeletePrefix(prefix string)
+}
+
+// MemoryResponseCache is a ResponseCache keeping the responses in memory.
+type MemoryResponseCache struct {
+	mut     sync.Mutex
+	entries map[string]memoryResponseCacheEntry
+}
+
+type memoryResponseCacheEntry struct {
+	response *CachedResponse
+	expires  time.Time
+}
+
+// NewMemoryResponseCache returns an empty MemoryResponseCache.
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{
+		entries: make(map[string]memoryResponseCacheEntry),
+	}
+}
+
+// Get implements ResponseCache.
+func (c *MemoryResponseCache) Get(key string) (*CachedResponse, bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+// Set implements ResponseCache.
+func (c *MemoryResponseCache) Set(key string, response *CachedResponse, ttl time.Duration) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	c.entries[key] = memoryResponseCacheEntry{
+		response: response,
+		expires:  time.Now().Add(ttl),
+	}
+}
+
+// DeletePrefix implements ResponseCache.
+func (c *MemoryResponseCache) DeletePrefix(prefix string) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateCache removes the cached responses of the service client. It is called after each
+// request modifying a resource of the service.
+func (client *ServiceClient) InvalidateCache() {
+	if client.Cache != nil {
+		client.Cache.DeletePrefix(client.Endpoint)
+	}
+}
+
+// cacheKey returns the key of a GET request in the cache. It includes the request headers, such
+// as the microversion, which may change the response.
+func cacheKey(url string, options *RequestOpts) string {
+	headers := make([]string, 0, len(options.MoreHeaders))
+	for k, v := range options.MoreHeaders {
+		headers = append(headers, k+": "+v)
+	}
+	sort.Strings(headers)
+	return url + "\n" + strings.Join(headers, "\n")
+}
+
+// cachedRequest sends a GET request through the cache of the service client.
+func (client *ServiceClient) cachedRequest(url string, options *RequestOpts) (*http.Response, error) {
+	key := cacheKey(url, options)
+	cached, ok := client.Cache.Get(key)
+	if !ok {
+		// Keep the response body to store it, and decode it afterwards if requested.
+		opts := *options
+		opts.JSONResponse = nil
+		opts.KeepResponseBody = true
+		resp, err := client.send("GET", url, &opts)
+		if err != nil {
+			return resp, err
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		cached = &CachedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header,
+			Body:       body,
+		}
+		if resp.StatusCode == http.StatusOK {
+			client.Cache.Set(key, cached, client.CacheTTL)
+		}
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp := &http.Response{
+		Status:        http.StatusText(cached.StatusCode),
+		StatusCode:    cached.StatusCode,
+		Header:        cached.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(cached.Body)),
+		ContentLength: int64(len(cached.Body)),
+		Request:       req,
+	}
+
+	if options.JSONResponse != nil {
+		defer resp.Body.Close()
+		if cached.StatusCode == http.StatusNoContent || len(cached.Body) == 0 {
+			return resp, nil
+		}
+		if err := json.Unmarshal(cached.Body, options.JSONResponse); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -30,7 +30,9 @@ type Result struct {
 	// this will be the deserialized JSON structure.
 	Body interface{}
 
-	// Header contains the HTTP header structure from the original response.
+	// Header contains the HTTP header structure from the original response. It
+	// is kept by the request functions whether the request succeeded or not,
+	// see ParseResponse.
 	Header http.Header
 
 	// Err is an error that occurred during the operation. It's deferred until
@@ -229,6 +231,47 @@ func (r Result) PrettyPrintJSON() string {
 	return string(pretty)
 }
 
+// RequestID returns the ID the service gave to the request of the result, see
+// RequestIDFromHeader. It is empty if the service didn't return one.
+func (r Result) RequestID() string {
+	return RequestIDFromHeader(r.Header)
+}
+
+// requestIDHeaders are the headers in which services return the ID of a
+// request, in order of preference.
+var requestIDHeaders = []string{
+	"X-Openstack-Request-Id",
+	"X-Compute-Request-Id",
+	"X-Request-Id",
+	"X-Trans-Id",
+}
+
+// RequestIDFromHeader returns the ID the service gave to a request, found in
+// the X-Openstack-Request-Id header or its service-specific variants such as
+// X-Compute-Request-Id and the X-Trans-Id of Object Storage. Quote it when
+// reporting a problem to the operators of the cloud.
+func RequestIDFromHeader(h http.Header) string {
+	for _, k := range requestIDHeaders {
+		if id := h.Get(k); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// ParseResponse is an internal function to be used by request functions, to
+// keep the headers of a response in their result, whether the request
+// succeeded or not:
+//
+//	resp, err := client.Get(url, &r.Body, nil)
+//	r.Header, r.Err = golangsdk.ParseResponse(resp, err)
+func ParseResponse(resp *http.Response, err error) (http.Header, error) {
+	if resp == nil {
+		return nil, err
+	}
+	return resp.Header, err
+}
+
 // ErrResult is an internal type to be used by individual resource packages, but
 // its methods will be available on a wide variety of user-facing embedding
 // types.
@@ -242,7 +285,8 @@ type ErrResult struct {
 	Result
 }
 
-// ExtractErr is a function that extracts error information, or nil, from a result.
+// ExtractErr is a function that extracts error information, or nil, from a result. The error
+// reported by the service, if any, can be obtained from it with APIErrorFrom.
 func (r ErrResult) ExtractErr() error {
 	return r.Err
 }
@@ -2,8 +2,10 @@ package golangsdk
 
 import (
 	"io"
+	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // ServiceClient stores details required to interact with a specific service API implemented by a provider.
@@ -32,6 +34,47 @@ type ServiceClient struct {
 	// MoreHeaders allows users to set service-wide headers on requests. Put another way,
 	// values set in this field will be set on all the HTTP requests the service client sends.
 	MoreHeaders map[string]string
+
+	// RateLimiter, if set, throttles the requests sent by this service client. The same
+	// RateLimiter may be shared between several service clients to enforce a global limit.
+	RateLimiter *RateLimiter
+
+	// Cache, if set, keeps the responses to the GET requests of the service client for CacheTTL,
+	// e.g. for the listings of flavors or images which rarely change. Any other successful request
+	// of the service client invalidates its cached responses. The same Cache may be shared between
+	// several service clients.
+	Cache    ResponseCache
+	CacheTTL time.Duration
+
+	// GzipRequestMinSize, if set, compresses the JSON request bodies of at least this many bytes
+	// with gzip, e.g. large batch creations. Only set it for the services accepting gzip encoded
+	// requests.
+	GzipRequestMinSize int
+
+	// DryRun, if set, makes the service client send only its GET and HEAD requests. Any other
+	// request, such as the creations, updates and deletions of the package functions, fails with an
+	// ErrDryRun describing the request instead, which allows to preview the changes of a call.
+	DryRun bool
+}
+
+// WithHeaders returns a copy of the service client which adds the given headers to
+// every request, on top of the client's MoreHeaders. It allows to set headers on
+// individual calls of any package function without changing the shared client, e.g.:
+//
+//	servers.Get(client.WithHeaders(map[string]string{"X-Trans-Id": id}), serverID)
+//
+// Headers set here, like MoreHeaders, take precedence over the ones set by the
+// package functions.
+func (client *ServiceClient) WithHeaders(headers map[string]string) *ServiceClient {
+	c := *client
+	c.MoreHeaders = make(map[string]string, len(client.MoreHeaders)+len(headers))
+	for k, v := range client.MoreHeaders {
+		c.MoreHeaders[k] = v
+	}
+	for k, v := range headers {
+		c.MoreHeaders[k] = v
+	}
+	return &c
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -165,13 +208,84 @@ func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
 
 // Request carries out the HTTP operation for the service client
 func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	if options == nil {
+		options = new(RequestOpts)
+	}
 	if len(client.MoreHeaders) > 0 {
-		if options == nil {
-			options = new(RequestOpts)
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string, len(client.MoreHeaders))
 		}
 		for k, v := range client.MoreHeaders {
 			options.MoreHeaders[k] = v
 		}
 	}
-	return client.ProviderClient.Request(method, url, options)
+	if client.GzipRequestMinSize > 0 && options.GzipMinSize == 0 {
+		options.GzipMinSize = client.GzipRequestMinSize
+	}
+	if client.DryRun && method != "GET" && method != "HEAD" {
+		return nil, client.dryRun(method, url, options)
+	}
+
+	if client.Cache == nil || client.CacheTTL <= 0 {
+		return client.send(method, url, options)
+	}
+	switch method {
+	case "GET":
+		return client.cachedRequest(url, options)
+	case "HEAD":
+		return client.send(method, url, options)
+	}
+	resp, err := client.send(method, url, options)
+	if err == nil {
+		client.InvalidateCache()
+	}
+	return resp, err
+}
+
+// dryRun returns the ErrDryRun describing a request instead of sending it. A RawBody is consumed.
+func (client *ServiceClient) dryRun(method, url string, options *RequestOpts) error {
+	opts := *options
+	opts.GzipMinSize = 0
+	body, _, err := opts.body(false)
+	if err != nil {
+		return err
+	}
+
+	e := ErrDryRun{
+		Method: method,
+		URL:    url,
+		Header: make(http.Header),
+	}
+	if body != nil {
+		if e.Body, err = ioutil.ReadAll(body); err != nil {
+			return err
+		}
+	}
+	if options.JSONBody != nil {
+		e.Header.Set("Content-Type", applicationJSON)
+	}
+	if options.IfMatch != "" {
+		e.Header.Set("If-Match", options.IfMatch)
+	}
+	if id := options.clientRequestID(client.Context); id != "" {
+		e.Header.Set(RequestIDHeader, id)
+	}
+	for k, v := range options.MoreHeaders {
+		if v != "" {
+			e.Header.Set(k, v)
+		} else {
+			e.Header.Del(k)
+		}
+	}
+	return e
+}
+
+// send sends a request once the RateLimiter, if any, allows it.
+func (client *ServiceClient) send(method, url string, options *RequestOpts) (*http.Response, error) {
+	if client.RateLimiter != nil {
+		if err := client.RateLimiter.Wait(client.Context, method); err != nil {
+			return nil, err
+		}
+	}
+	return client.ProviderClient.request(client.Type, method, url, options)
 }
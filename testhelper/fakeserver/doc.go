@@ -0,0 +1,28 @@
+/*
+Package fakeserver provides an in-memory API server for the unit tests of
+applications built on the SDK. It serves canned JSON responses registered
+per method and path, records the requests it receives, and returns
+ServiceClients which target it, so that code calling the SDK can be tested
+without reaching a real cloud.
+
+Unlike testhelper.SetupHTTP, each Server is independent, so tests using it
+may run in parallel.
+
+Example to Fake a Load Balancer API
+
+	func TestDeleteLoadBalancer(t *testing.T) {
+		server := fakeserver.New(t)
+		server.Handle("GET", "/v2.0/lbaas/loadbalancers/1234", http.StatusOK, `
+			{"loadbalancer": {"id": "1234", "provisioning_status": "ACTIVE"}}
+		`)
+		server.Handle("DELETE", "/v2.0/lbaas/loadbalancers/1234", http.StatusNoContent, "")
+
+		client := server.ServiceClient()
+		client.ResourceBase = client.Endpoint + "v2.0/"
+
+		err := myapp.DeleteLoadBalancer(client, "1234")
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 2, len(server.Requests()))
+	}
+*/
+package fakeserver
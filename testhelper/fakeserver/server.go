@@ -0,0 +1,126 @@
+package fakeserver
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+)
+
+// TokenID is the token set on the ServiceClients returned by a Server.
+const TokenID = "cbc36478b0bd8e67e89469c7749d4127"
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is an in-memory API server serving registered handlers.
+type Server struct {
+	*httptest.Server
+
+	t        *testing.T
+	mut      sync.Mutex
+	handlers map[string]map[string]http.HandlerFunc
+	requests []Request
+}
+
+// New starts a Server, which is closed when the test completes. Requests
+// without a registered handler fail the test and receive a 404 response.
+func New(t *testing.T) *Server {
+	s := &Server{
+		t:        t,
+		handlers: make(map[string]map[string]http.HandlerFunc),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Endpoint returns the base URL of the server. It ends with a slash.
+func (s *Server) Endpoint() string {
+	return s.URL + "/"
+}
+
+// ServiceClient returns a ServiceClient which sends its requests to the
+// server, authenticated with TokenID.
+func (s *Server) ServiceClient() *golangsdk.ServiceClient {
+	return &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{TokenID: TokenID},
+		Endpoint:       s.Endpoint(),
+	}
+}
+
+// HandleFunc registers handler for the requests with the given method and
+// path. A later registration for the same method and path replaces the
+// previous one.
+func (s *Server) HandleFunc(method, path string, handler http.HandlerFunc) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.handlers[path] == nil {
+		s.handlers[path] = make(map[string]http.HandlerFunc)
+	}
+	s.handlers[path][method] = handler
+}
+
+// Handle registers a canned response for the requests with the given method
+// and path. A non-empty body is sent as JSON.
+func (s *Server) Handle(method, path string, status int, body string) {
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		if body != "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		if body != "" {
+			fmt.Fprint(w, body)
+		}
+	})
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []Request {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		s.t.Errorf("Unable to read the body of %s %s: %s", r.Method, r.URL.Path, err)
+	}
+
+	s.mut.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	handler := s.handlers[r.URL.Path][r.Method]
+	s.mut.Unlock()
+
+	if handler == nil {
+		s.t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	handler(w, r)
+}
@@ -0,0 +1,2 @@
+// fakeserver unit tests
+package testing
@@ -0,0 +1,63 @@
+package testing
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/fakeserver"
+)
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New(t)
+	server.Handle("GET", "/things/1", http.StatusOK, `{"thing": {"id": "1"}}`)
+	server.Handle("DELETE", "/things/1", http.StatusNoContent, "")
+
+	client := server.ServiceClient()
+
+	var thing struct {
+		Thing struct {
+			ID string `json:"id"`
+		} `json:"thing"`
+	}
+	_, err := client.Get(client.ServiceURL("things", "1"), &thing, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "1", thing.Thing.ID)
+
+	_, err = client.Delete(client.ServiceURL("things", "1"), nil)
+	th.AssertNoErr(t, err)
+
+	requests := server.Requests()
+	th.AssertEquals(t, 2, len(requests))
+	th.AssertEquals(t, "GET", requests[0].Method)
+	th.AssertEquals(t, "/things/1", requests[0].Path)
+	th.AssertEquals(t, fakeserver.TokenID, requests[0].Header.Get("X-Auth-Token"))
+	th.AssertEquals(t, "DELETE", requests[1].Method)
+}
+
+func TestRequestBody(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New(t)
+	server.HandleFunc("POST", "/things", func(w http.ResponseWriter, r *http.Request) {
+		th.TestJSONRequest(t, r, `{"thing": {"name": "foo"}}`)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	client := server.ServiceClient()
+	body := map[string]interface{}{"thing": map[string]string{"name": "foo"}}
+	_, err := client.Post(client.ServiceURL("things"), body, nil, &golangsdk.RequestOpts{
+		OkCodes: []int{201},
+	})
+	th.AssertNoErr(t, err)
+
+	requests := server.Requests()
+	th.AssertEquals(t, 1, len(requests))
+	var actual map[string]interface{}
+	th.AssertNoErr(t, json.Unmarshal(requests[0].Body, &actual))
+	th.AssertJSONEquals(t, `{"thing": {"name": "foo"}}`, actual)
+}
@@ -0,0 +1,28 @@
+/*
+Package recorder records the HTTP responses of a real cloud in JSON files and
+serves them back to the unit tests, so that their fixtures can be refreshed
+by running the tests again instead of being written by hand.
+
+In Record mode, the requests are sent and the recording is written by Stop,
+with the credentials and tokens scrubbed from the saved headers. In Replay
+mode, which is the default of ModeFromEnv, no request leaves the process.
+
+Example to Record and Replay a Test
+
+	func TestListLoadBalancers(t *testing.T) {
+		rec, err := recorder.New("testdata/list_loadbalancers.json", recorder.ModeFromEnv())
+		th.AssertNoErr(t, err)
+		defer func() {
+			th.AssertNoErr(t, rec.Stop())
+		}()
+
+		provider, err := openstack.NewClient(authURL)
+		th.AssertNoErr(t, err)
+		provider.HTTPClient = http.Client{Transport: rec}
+		// Authenticate and build the network client as usual.
+
+		allPages, err := loadbalancers.List(networkClient, loadbalancers.ListOpts{}).AllPages()
+		th.AssertNoErr(t, err)
+	}
+*/
+package recorder
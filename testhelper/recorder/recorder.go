@@ -0,0 +1,289 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode tells whether a Recorder sends the requests and saves their
+// responses, or serves the saved responses.
+type Mode int
+
+const (
+	// Replay serves the responses saved in the recording, without sending
+	// any request.
+	Replay Mode = iota
+
+	// Record sends the requests and saves their responses in the recording.
+	Record
+)
+
+// RecordEnv is the environment variable which switches ModeFromEnv to Record.
+const RecordEnv = "GOLANGSDK_RECORD"
+
+// ModeFromEnv returns Record if the RecordEnv environment variable is set to
+// a non-empty value, and Replay otherwise. It allows to refresh the
+// recordings of the tests by running them once against a real cloud:
+//
+//	GOLANGSDK_RECORD=1 go test ./...
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return Record
+	}
+	return Replay
+}
+
+// ScrubbedValue replaces the values of the scrubbed headers in a recording.
+const ScrubbedValue = "SCRUBBED"
+
+// DefaultScrubHeaders are the headers scrubbed from the recordings when the
+// ScrubHeaders of a Recorder are not set. They carry the credentials of the
+// requests and the tokens of the responses.
+var DefaultScrubHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Auth-Key",
+	"X-Auth-Token",
+	"X-Security-Token",
+	"X-Subject-Token",
+}
+
+// Interaction is a request and its response, as saved in a recording.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request saved in a recording.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response saved in a recording. Body holds a text body
+// and BinaryBody any other one, such as a compressed body.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BinaryBody []byte      `json:"binary_body,omitempty"`
+}
+
+type recording struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper which records the responses of the
+// requests in a JSON file, and serves them back in Replay mode. Set it as the
+// Transport of the HTTPClient of a ProviderClient:
+//
+//	provider.HTTPClient = http.Client{Transport: rec}
+//
+// In Replay mode, a request is served the first response not served yet
+// which was recorded for the same method, path and query, which allows to
+// replay a resource polled until it reaches a status.
+type Recorder struct {
+	// Transport sends the requests in Record mode. It defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// ScrubHeaders are the request and response headers whose values are
+	// replaced by ScrubbedValue in the recording. It defaults to
+	// DefaultScrubHeaders.
+	ScrubHeaders []string
+
+	// Scrub, if set, is called on each interaction before it is saved, e.g.
+	// to remove the secrets found in a body.
+	Scrub func(*Interaction)
+
+	path string
+	mode Mode
+
+	mut          sync.Mutex
+	interactions []Interaction
+	served       []bool
+}
+
+// New returns a Recorder using the recording at path. In Replay mode, the
+// recording is loaded and must exist. In Record mode, it is written by Stop.
+func New(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		path: path,
+		mode: mode,
+	}
+	if mode == Record {
+		return r, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec recording
+	if err := json.Unmarshal(b, &rec); err != nil {
+		return nil, fmt.Errorf("Unable to parse the recording %s: %s", path, err)
+	}
+	r.interactions = rec.Interactions
+	r.served = make([]bool, len(rec.Interactions))
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop writes the recording in Record mode. It does nothing in Replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != Record {
+		return nil
+	}
+
+	r.mut.Lock()
+	b, err := json.MarshalIndent(recording{Interactions: r.interactions}, "", "  ")
+	r.mut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == Record {
+		return r.record(req)
+	}
+	return r.replay(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	i := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: r.scrub(req.Header),
+			Body:   string(reqBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.scrub(resp.Header),
+		},
+	}
+	if utf8.Valid(respBody) {
+		i.Response.Body = string(respBody)
+	} else {
+		i.Response.BinaryBody = respBody
+	}
+	if r.Scrub != nil {
+		r.Scrub(&i)
+	}
+
+	r.mut.Lock()
+	r.interactions = append(r.interactions, i)
+	r.mut.Unlock()
+
+	return resp, nil
+}
+
+// scrub returns a copy of header whose ScrubHeaders are replaced by
+// ScrubbedValue.
+func (r *Recorder) scrub(header http.Header) http.Header {
+	scrubbed := header.Clone()
+	names := r.ScrubHeaders
+	if names == nil {
+		names = DefaultScrubHeaders
+	}
+	for _, name := range names {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, ScrubbedValue)
+		}
+	}
+	return scrubbed
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+
+	for n, i := range r.interactions {
+		if r.served[n] || i.Request.Method != req.Method || !sameResource(i.Request.URL, req) {
+			continue
+		}
+		r.served[n] = true
+
+		body := []byte(i.Response.Body)
+		if i.Response.BinaryBody != nil {
+			body = i.Response.BinaryBody
+		}
+		header := i.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("No recorded response left for [%s %s] in %s", req.Method, req.URL, r.path)
+}
+
+// sameResource reports whether the recorded URL has the path and query of
+// the request, whatever its host.
+func sameResource(recorded string, req *http.Request) bool {
+	u, err := req.URL.Parse(recorded)
+	if err != nil {
+		return false
+	}
+	return u.EscapedPath() == req.URL.EscapedPath() && u.RawQuery == req.URL.RawQuery
+}
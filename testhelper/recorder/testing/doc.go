@@ -0,0 +1,2 @@
+// recorder unit tests
+package testing
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+	"github.com/huaweicloud/golangsdk/testhelper/fakeserver"
+	"github.com/huaweicloud/golangsdk/testhelper/recorder"
+)
+
+type thing struct {
+	Thing struct {
+		Status string `json:"status"`
+	} `json:"thing"`
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "things.json")
+
+	server := fakeserver.New(t)
+	statuses := []string{"BUILD", "ACTIVE"}
+	server.HandleFunc("GET", "/things/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "secret")
+		w.Write([]byte(`{"thing": {"status": "` + statuses[0] + `"}}`))
+		statuses = statuses[1:]
+	})
+
+	rec, err := recorder.New(path, recorder.Record)
+	th.AssertNoErr(t, err)
+	client := server.ServiceClient()
+	client.HTTPClient = http.Client{Transport: rec}
+
+	var got thing
+	for _, status := range []string{"BUILD", "ACTIVE"} {
+		_, err = client.Get(client.ServiceURL("things", "1"), &got, nil)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, status, got.Thing.Status)
+	}
+	th.AssertNoErr(t, rec.Stop())
+
+	saved, err := ioutil.ReadFile(path)
+	th.AssertNoErr(t, err)
+	for _, secret := range []string{fakeserver.TokenID, "secret"} {
+		if strings.Contains(string(saved), secret) {
+			t.Errorf("The recording contains %q:\n%s", secret, saved)
+		}
+	}
+
+	// Replay the recording against an endpoint which can't be reached.
+	server.Close()
+	rec, err = recorder.New(path, recorder.Replay)
+	th.AssertNoErr(t, err)
+	client = &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{HTTPClient: http.Client{Transport: rec}},
+		Endpoint:       "http://replay.invalid/",
+	}
+
+	for _, status := range []string{"BUILD", "ACTIVE"} {
+		resp, err := client.Get(client.ServiceURL("things", "1"), &got, nil)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, status, got.Thing.Status)
+		th.AssertEquals(t, recorder.ScrubbedValue, resp.Header.Get("X-Subject-Token"))
+	}
+
+	_, err = client.Get(client.ServiceURL("things", "1"), &got, nil)
+	if err == nil {
+		t.Fatal("Expected an error once the recorded responses are used up")
+	}
+}
@@ -90,17 +90,20 @@ func TestBuildQueryString(t *testing.T) {
 
 func TestBuildHeaders(t *testing.T) {
 	testStruct := struct {
-		Accept        string `h:"Accept"`
-		ContentLength int64  `h:"Content-Length"`
-		Num           int    `h:"Number" required:"true"`
-		Style         bool   `h:"Style"`
+		Accept        string    `h:"Accept"`
+		ContentLength int64     `h:"Content-Length"`
+		Num           int       `h:"Number" required:"true"`
+		Style         bool      `h:"Style"`
+		Since         time.Time `h:"If-Modified-Since"`
 	}{
 		Accept:        "application/json",
 		ContentLength: 256,
 		Num:           4,
 		Style:         true,
+		Since:         time.Date(2020, 6, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
 	}
-	expected := map[string]string{"Accept": "application/json", "Number": "4", "Style": "true", "Content-Length": "256"}
+	expected := map[string]string{"Accept": "application/json", "Number": "4", "Style": "true", "Content-Length": "256",
+		"If-Modified-Since": "Mon, 01 Jun 2020 10:00:00 GMT"}
 	actual, err := golangsdk.BuildHeaders(&testStruct)
 	th.CheckNoErr(t, err)
 	th.CheckDeepEquals(t, expected, actual)
@@ -1,8 +1,10 @@
 package testing
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math"
 	"net"
@@ -47,6 +49,27 @@ func TestUserAgent(t *testing.T) {
 	th.CheckEquals(t, expected, actual)
 }
 
+func TestUserAgentProductTokens(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	p := &golangsdk.ProviderClient{}
+	p.UserAgent.Prepend(golangsdk.ProductToken("terraform provider", "1.2.0", "linux; amd64"))
+	p.UserAgent.Append("plugin-a/0.1")
+	p.UserAgent.Append(golangsdk.ProductToken("plugin-b", ""))
+
+	expected := "terraform-provider/1.2.0 (linux  amd64) golangsdk/2.0.0 plugin-a/0.1 plugin-b"
+	th.CheckEquals(t, expected, p.UserAgent.Join())
+	th.CheckEquals(t, "tool/1.0 (linux; amd64)", golangsdk.ProductToken("tool", "1.0", "linux", "amd64"))
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "User-Agent", expected)
+		w.WriteHeader(http.StatusOK)
+	})
+	_, err := p.Request("GET", th.Endpoint(), &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+}
+
 func TestConcurrentReauth(t *testing.T) {
 	var info = struct {
 		numreauths int
@@ -130,6 +153,288 @@ func TestConcurrentReauth(t *testing.T) {
 	th.AssertEquals(t, 1, info.numreauths)
 }
 
+func TestReauthWithTokenStore(t *testing.T) {
+	store := golangsdk.NewMemoryTokenStore()
+	th.AssertNoErr(t, store.SaveToken("old-token"))
+
+	p := new(golangsdk.ProviderClient)
+	p.UseTokenLock()
+	p.TokenStore = store
+
+	// The stored token is only loaded by the first request.
+	th.AssertEquals(t, "", p.Token())
+
+	p.ReauthFunc = func() error {
+		p.TokenID = "new-token"
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var tokens []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("X-Auth-Token"))
+		if r.Header.Get("X-Auth-Token") != "new-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"old-token", "new-token"}, tokens)
+
+	token, err := store.LoadToken()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "new-token", token)
+}
+
+func TestTokenStoreNotUsedByTokenCreation(t *testing.T) {
+	store := golangsdk.NewMemoryTokenStore()
+	th.AssertNoErr(t, store.SaveToken("stale-token"))
+
+	p := new(golangsdk.ProviderClient)
+	p.UseTokenLock()
+	p.TokenStore = store
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var tokens []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		tokens = append(tokens, r.Header.Get("X-Auth-Token"))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	// Like the token creations, the request sends its own, empty, token.
+	_, err := p.Request("POST", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"X-Auth-Token": ""},
+		OkCodes:     []int{200},
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{""}, tokens)
+	th.AssertEquals(t, "", p.Token())
+}
+
+type recordingInstrumentation struct {
+	mut    sync.Mutex
+	starts []golangsdk.RequestEvent
+	ends   []golangsdk.RequestEvent
+}
+
+func (i *recordingInstrumentation) OnRequestStart(e golangsdk.RequestEvent) {
+	i.mut.Lock()
+	defer i.mut.Unlock()
+	i.starts = append(i.starts, e)
+}
+
+func (i *recordingInstrumentation) OnRequestEnd(e golangsdk.RequestEvent) {
+	i.mut.Lock()
+	defer i.mut.Unlock()
+	i.ends = append(i.ends, e)
+}
+
+func TestInstrumentation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	th.Mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	instrumentation := new(recordingInstrumentation)
+	sc := client.ServiceClient()
+	sc.Type = "compute"
+	sc.Instrumentation = instrumentation
+
+	_, err := sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	_, err = sc.Get(sc.ServiceURL("missing"), nil, nil)
+	if _, ok := err.(golangsdk.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %#v", err)
+	}
+
+	th.AssertEquals(t, 2, len(instrumentation.starts))
+	th.AssertEquals(t, 2, len(instrumentation.ends))
+
+	start := instrumentation.starts[0]
+	th.AssertEquals(t, "compute", start.Service)
+	th.AssertEquals(t, "GET", start.Method)
+	th.AssertEquals(t, sc.ServiceURL("route"), start.URL)
+
+	end := instrumentation.ends[0]
+	th.AssertEquals(t, http.StatusOK, end.StatusCode)
+	th.AssertNoErr(t, end.Err)
+	if end.Duration <= 0 {
+		t.Errorf("expected a positive duration, got %s", end.Duration)
+	}
+
+	end = instrumentation.ends[1]
+	th.AssertEquals(t, http.StatusNotFound, end.StatusCode)
+	th.CheckDeepEquals(t, err, end.Err)
+}
+
+func TestRequestID(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var sent []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, r.Header.Get("X-OpenStack-Request-ID"))
+		w.WriteHeader(http.StatusOK)
+	})
+	th.Mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	instrumentation := new(recordingInstrumentation)
+	sc := client.ServiceClient()
+	sc.Instrumentation = instrumentation
+
+	_, err := sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	sc.Context = golangsdk.ContextWithRequestID(context.Background(), "req-context")
+	_, err = sc.Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	_, err = sc.Get(sc.ServiceURL("route"), nil, &golangsdk.RequestOpts{RequestID: "req-opts"})
+	th.AssertNoErr(t, err)
+	_, err = sc.WithRequestID("req-client").Get(sc.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []string{"", "req-context", "req-opts", "req-client"}, sent)
+	th.AssertEquals(t, "req-client", instrumentation.starts[3].RequestID)
+	th.AssertEquals(t, "req-client", instrumentation.ends[3].RequestID)
+
+	_, err = sc.Get(sc.ServiceURL("missing"), nil, nil)
+	respErr, ok := err.(golangsdk.ErrDefault404)
+	if !ok {
+		t.Fatalf("expected ErrDefault404, got %#v", err)
+	}
+	th.AssertEquals(t, "req-context", respErr.ClientRequestID)
+	if !strings.Contains(respErr.ErrUnexpectedResponseCode.Error(), "req-context") {
+		t.Errorf("expected the request ID in %q", respErr.ErrUnexpectedResponseCode.Error())
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	cases := map[string]struct {
+		body     string
+		expected *golangsdk.APIError
+	}{
+		"huawei": {
+			`{"error_code": "ELB.8902", "error_msg": "Load balancer is in PENDING_UPDATE"}`,
+			&golangsdk.APIError{Code: "ELB.8902", Message: "Load balancer is in PENDING_UPDATE"},
+		},
+		"neutron": {
+			`{"NeutronError": {"type": "StateInvalid", "message": "Invalid state PENDING_UPDATE", "detail": ""}}`,
+			&golangsdk.APIError{Code: "StateInvalid", Message: "Invalid state PENDING_UPDATE"},
+		},
+		"nova": {
+			`{"conflictingRequest": {"code": 409, "message": "Cannot delete a locked server"}}`,
+			&golangsdk.APIError{Code: "409", Message: "Cannot delete a locked server"},
+		},
+		"octavia": {
+			`{"faultcode": "Client", "faultstring": "Load Balancer is immutable", "debuginfo": null}`,
+			&golangsdk.APIError{Code: "Client", Message: "Load Balancer is immutable"},
+		},
+		"string": {
+			`{"error": "Conflict"}`,
+			&golangsdk.APIError{Message: "Conflict"},
+		},
+		"text": {
+			`Conflict`,
+			nil,
+		},
+	}
+
+	for name, c := range cases {
+		body := c.body
+		th.Mux.HandleFunc("/"+name, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, body)
+		})
+
+		sc := client.ServiceClient()
+		_, err := sc.Delete(sc.ServiceURL(name), nil)
+		respErr, ok := err.(golangsdk.ErrUnexpectedResponseCode)
+		if !ok {
+			t.Fatalf("%s: expected ErrUnexpectedResponseCode, got %#v", name, err)
+		}
+		th.CheckDeepEquals(t, c.expected, respErr.APIError)
+	}
+
+	th.Mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"itemNotFound": {"code": 404, "message": "Instance could not be found"}}`)
+	})
+	sc := client.ServiceClient()
+	_, err := sc.Delete(sc.ServiceURL("missing"), nil)
+	th.CheckDeepEquals(t, &golangsdk.APIError{Code: "404", Message: "Instance could not be found"}, golangsdk.APIErrorFrom(err))
+	th.CheckDeepEquals(t, (*golangsdk.APIError)(nil), golangsdk.APIErrorFrom(fmt.Errorf("network down")))
+}
+
+type spanKey struct{}
+
+type testSpan struct {
+	parent string
+	ended  *golangsdk.RequestEvent
+}
+
+func (s *testSpan) End(e golangsdk.RequestEvent) {
+	s.ended = &e
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (tr *testTracer) StartSpan(ctx context.Context, e golangsdk.RequestEvent) (context.Context, golangsdk.Span) {
+	parent, _ := ctx.Value(spanKey{}).(string)
+	span := &testSpan{parent: parent}
+	tr.spans = append(tr.spans, span)
+	return context.WithValue(ctx, spanKey{}, fmt.Sprintf("span-%d", len(tr.spans))), span
+}
+
+func (tr *testTracer) Inject(ctx context.Context, header http.Header) {
+	header.Set("traceparent", ctx.Value(spanKey{}).(string))
+}
+
+func TestTracer(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "traceparent", "span-1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	tracer := new(testTracer)
+	sc := client.ServiceClient()
+	sc.Tracer = tracer
+	sc.Context = context.WithValue(context.Background(), spanKey{}, "root")
+
+	_, err := sc.Post(sc.ServiceURL("route"), nil, nil, &golangsdk.RequestOpts{OkCodes: []int{202}})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(tracer.spans))
+	span := tracer.spans[0]
+	th.AssertEquals(t, "root", span.parent)
+	if span.ended == nil {
+		t.Fatal("expected the span to be ended")
+	}
+	th.AssertEquals(t, http.StatusAccepted, span.ended.StatusCode)
+	th.AssertEquals(t, "POST", span.ended.Method)
+}
+
 func TestRequestWithContext(t *testing.T) {
 	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintln(w, "OK")
@@ -332,3 +637,360 @@ func TestRequestRetryContext(t *testing.T) {
 	t.Logf("retryCounter: %d, p.MaxBackoffRetries: %d", retryCounter, p.MaxBackoffRetries)
 	th.AssertEquals(t, retryCounter, p.MaxBackoffRetries-1)
 }
+
+func TestConcurrentReauthSharesFailure(t *testing.T) {
+	var numreauths int32
+
+	p := new(golangsdk.ProviderClient)
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+	p.ReauthFunc = func() error {
+		atomic.AddInt32(&numreauths, 1)
+		time.Sleep(100 * time.Millisecond)
+		return fmt.Errorf("identity service unavailable")
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	// Hold the responses until all the requests have been received.
+	var received int32
+	allReceived := make(chan struct{})
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&received, 1) == 10 {
+			close(allReceived)
+		}
+		<-allReceived
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), &golangsdk.RequestOpts{})
+			if _, ok := err.(*golangsdk.ErrUnableToReauthenticate); !ok {
+				t.Errorf("Expected ErrUnableToReauthenticate, got %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The requests get their 401 together, so they all wait for the same
+	// reauthentication and share its failure.
+	th.AssertEquals(t, int32(1), atomic.LoadInt32(&numreauths))
+}
+
+func TestRequestRetryReplaysBody(t *testing.T) {
+	p := &golangsdk.ProviderClient{}
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+	p.RetryBackoffFunc = func(context.Context, *golangsdk.ErrUnexpectedResponseCode, error, uint) error {
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var bodies []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies)%2 == 1 {
+			http.Error(w, "retry later", http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := p.Request("PUT", th.Endpoint()+"route", &golangsdk.RequestOpts{
+		GetBody: func() (io.Reader, error) {
+			return strings.NewReader("content"), nil
+		},
+	})
+	th.AssertNoErr(t, err)
+
+	_, err = p.Request("PUT", th.Endpoint()+"route", &golangsdk.RequestOpts{
+		RawBody: strings.NewReader("seekable"),
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"content", "content", "seekable", "seekable"}, bodies)
+
+	// A reader that cannot be rewound is sent only once.
+	_, err = p.Request("PUT", th.Endpoint()+"route", &golangsdk.RequestOpts{
+		RawBody: ioutil.NopCloser(strings.NewReader("once")),
+	})
+	if _, ok := err.(golangsdk.ErrDefault429); !ok {
+		t.Fatalf("expected ErrDefault429, got %v", err)
+	}
+	th.CheckEquals(t, 5, len(bodies))
+}
+
+func TestRequestTimeout(t *testing.T) {
+	p := &golangsdk.ProviderClient{
+		Timeout: 50 * time.Millisecond,
+	}
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	th.Mux.HandleFunc("/fast", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "content")
+	})
+
+	_, err := p.Request("GET", th.Endpoint()+"slow", &golangsdk.RequestOpts{})
+	if err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected a deadline error, got %v", err)
+	}
+
+	_, err = p.Request("GET", th.Endpoint()+"slow", &golangsdk.RequestOpts{
+		Timeout: 2 * time.Second,
+	})
+	th.AssertNoErr(t, err)
+
+	// The deadline is kept until the response body is closed.
+	resp, err := p.Request("GET", th.Endpoint()+"fast", &golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	})
+	th.AssertNoErr(t, err)
+	b, err := ioutil.ReadAll(resp.Body)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, resp.Body.Close())
+	th.CheckEquals(t, "content", string(b))
+}
+
+func TestRequestOverLimit(t *testing.T) {
+	p := &golangsdk.ProviderClient{}
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/header", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+	})
+	th.Mux.HandleFunc("/body", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		fmt.Fprint(w, `{"overLimit": {"code": 413, "message": "This request was rate-limited.", "retryAfter": "59"}}`)
+	})
+
+	for path, delay := range map[string]time.Duration{"header": 30 * time.Second, "body": 59 * time.Second} {
+		before := time.Now()
+		_, err := p.Request("GET", th.Endpoint()+path, &golangsdk.RequestOpts{})
+		overLimit, ok := err.(golangsdk.ErrOverLimit)
+		if !ok {
+			t.Fatalf("expected ErrOverLimit, got %v", err)
+		}
+		if overLimit.RetryAfter.Before(before.Add(delay)) || overLimit.RetryAfter.After(time.Now().Add(delay)) {
+			t.Errorf("%s: unexpected RetryAfter %s", path, overLimit.RetryAfter)
+		}
+	}
+}
+
+func TestRequestGzip(t *testing.T) {
+	p := &golangsdk.ProviderClient{
+		HTTPClient: http.Client{Transport: &http.Transport{DisableCompression: true}},
+		AcceptGzip: true,
+	}
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/nodes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "Accept-Encoding", "gzip")
+
+		body := io.Reader(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			th.AssertNoErr(t, err)
+			body = zr
+		}
+		b, err := ioutil.ReadAll(body)
+		th.AssertNoErr(t, err)
+
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusAccepted)
+		zw := gzip.NewWriter(w)
+		fmt.Fprintf(zw, `{"encoding": "%s", "received": %s}`, r.Header.Get("Content-Encoding"), b)
+		zw.Close()
+	})
+
+	for _, tc := range []struct {
+		minSize  int
+		encoding string
+	}{
+		{0, ""},
+		{1024, ""},
+		{16, "gzip"},
+	} {
+		var actual struct {
+			Encoding string            `json:"encoding"`
+			Received map[string]string `json:"received"`
+		}
+		_, err := p.Request("POST", th.Endpoint()+"nodes", &golangsdk.RequestOpts{
+			JSONBody:     map[string]string{"address": "10.0.0.1"},
+			JSONResponse: &actual,
+			GzipMinSize:  tc.minSize,
+		})
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, tc.encoding, actual.Encoding)
+		th.CheckDeepEquals(t, map[string]string{"address": "10.0.0.1"}, actual.Received)
+	}
+}
+
+func TestRequestConditional(t *testing.T) {
+	p := &golangsdk.ProviderClient{}
+	p.UseTokenLock()
+	p.SetToken(client.TokenID)
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	const etag = `"686897696a7c876b7e"`
+	th.Mux.HandleFunc("/object", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Header.Get("If-None-Match") == etag:
+			w.WriteHeader(http.StatusNotModified)
+		case r.Header.Get("If-Match") != "" && r.Header.Get("If-Match") != etag:
+			w.WriteHeader(http.StatusPreconditionFailed)
+		default:
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+
+	resp, err := p.Request("GET", th.Endpoint()+"object", &golangsdk.RequestOpts{IfNoneMatch: `"outdated"`})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, etag, resp.Header.Get("ETag"))
+
+	_, err = p.Request("GET", th.Endpoint()+"object", &golangsdk.RequestOpts{IfNoneMatch: etag})
+	if _, ok := err.(golangsdk.ErrNotModified); !ok {
+		t.Errorf("expected ErrNotModified, got %v", err)
+	}
+
+	_, err = p.Request("PUT", th.Endpoint()+"object", &golangsdk.RequestOpts{IfMatch: `"outdated"`})
+	if _, ok := err.(golangsdk.ErrDefault412); !ok {
+		t.Errorf("expected ErrDefault412, got %v", err)
+	}
+}
+
+func TestRefreshBeforeExpiry(t *testing.T) {
+	var reauths int
+	p := &golangsdk.ProviderClient{
+		RefreshBeforeExpiry: 5 * time.Minute,
+	}
+	p.UseTokenLock()
+	p.SetToken("old_token")
+	p.SetTokenExpiry(time.Now().Add(time.Minute))
+	p.ReauthFunc = func() error {
+		reauths++
+		// The token lock is held during the reauthentication.
+		p.TokenID = "new_token"
+		p.TokenExpiresAt = time.Now().Add(time.Hour)
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", "new_token")
+		w.WriteHeader(http.StatusOK)
+	})
+
+	th.CheckEquals(t, true, p.TokenExpiresWithin(5*time.Minute))
+	for i := 0; i < 2; i++ {
+		_, err := p.Request("GET", th.Endpoint()+"route", &golangsdk.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 1, reauths)
+	th.CheckEquals(t, false, p.TokenExpiresWithin(5*time.Minute))
+}
+
+func TestRequestUnexpectedResponse(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/html", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<html><body>502 Bad Gateway</body></html>")
+	})
+	th.Mux.HandleFunc("/truncated", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"server": {"id": "1234"`)
+	})
+
+	p := &golangsdk.ProviderClient{}
+	var body map[string]interface{}
+	_, err := p.Request("GET", th.Endpoint()+"html", &golangsdk.RequestOpts{JSONResponse: &body})
+	e, ok := err.(golangsdk.ErrUnexpectedResponse)
+	if !ok {
+		t.Fatalf("expected ErrUnexpectedResponse, got %v", err)
+	}
+	th.CheckEquals(t, "text/html", e.ContentType)
+	th.CheckEquals(t, http.StatusOK, e.StatusCode)
+	th.CheckEquals(t, "<html><body>502 Bad Gateway</body></html>", string(e.Snippet))
+
+	_, err = p.Request("GET", th.Endpoint()+"truncated", &golangsdk.RequestOpts{JSONResponse: &body})
+	e, ok = err.(golangsdk.ErrUnexpectedResponse)
+	if !ok {
+		t.Fatalf("expected ErrUnexpectedResponse, got %v", err)
+	}
+	th.CheckEquals(t, io.ErrUnexpectedEOF, e.Err)
+}
+
+func TestRequestMaxResponseSize(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/small", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "1234"}`)
+	})
+	th.Mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"id": "%s"}`, strings.Repeat("x", 100))
+	})
+	th.Mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, strings.Repeat("x", 100))
+	})
+
+	p := &golangsdk.ProviderClient{MaxResponseSize: int64(len(`{"id": "1234"}`))}
+	var body map[string]interface{}
+	_, err := p.Request("GET", th.Endpoint()+"small", &golangsdk.RequestOpts{JSONResponse: &body})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "1234", body["id"])
+
+	_, err = p.Request("GET", th.Endpoint()+"large", &golangsdk.RequestOpts{JSONResponse: &body})
+	if _, ok := err.(golangsdk.ErrResponseTooLarge); !ok {
+		t.Errorf("expected ErrResponseTooLarge, got %v", err)
+	}
+
+	resp, err := p.Request("GET", th.Endpoint()+"large", &golangsdk.RequestOpts{KeepResponseBody: true})
+	th.AssertNoErr(t, err)
+	_, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if _, ok := err.(golangsdk.ErrResponseTooLarge); !ok {
+		t.Errorf("expected ErrResponseTooLarge, got %v", err)
+	}
+
+	_, err = p.Request("GET", th.Endpoint()+"error", &golangsdk.RequestOpts{})
+	e, ok := err.(golangsdk.ErrDefault500)
+	if !ok {
+		t.Fatalf("expected ErrDefault500, got %v", err)
+	}
+	th.CheckEquals(t, int(p.MaxResponseSize), len(e.Body))
+}
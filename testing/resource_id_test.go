@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/huaweicloud/golangsdk"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestResourceIDJSON(t *testing.T) {
+	var s struct {
+		Int    golangsdk.ResourceID `json:"int"`
+		String golangsdk.ResourceID `json:"string"`
+		Null   golangsdk.ResourceID `json:"null"`
+	}
+	body := `{"int":42,"string":"5d2e2c3b-2c0c-4c3b-9e0c-6a3b8d2f1e7a","null":null}`
+	th.AssertNoErr(t, json.Unmarshal([]byte(body), &s))
+
+	th.AssertEquals(t, golangsdk.FromInt(42), s.Int)
+	th.AssertEquals(t, golangsdk.FromString("5d2e2c3b-2c0c-4c3b-9e0c-6a3b8d2f1e7a"), s.String)
+	th.AssertEquals(t, true, s.Null.IsZero())
+
+	i, err := s.Int.Int()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 42, i)
+	_, err = s.String.Int()
+	th.AssertEquals(t, true, err != nil)
+
+	b, err := json.Marshal(s)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, `{"int":42,"string":"5d2e2c3b-2c0c-4c3b-9e0c-6a3b8d2f1e7a","null":null}`, string(b))
+}
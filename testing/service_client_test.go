@@ -1,9 +1,12 @@
 package testing
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/huaweicloud/golangsdk"
 	th "github.com/huaweicloud/golangsdk/testhelper"
@@ -32,3 +35,166 @@ func TestMoreHeaders(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, resp.Request.Header.Get("custom"), "header")
 }
+
+func TestWithHeaders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := new(golangsdk.ServiceClient)
+	c.MoreHeaders = map[string]string{
+		"custom": "header",
+	}
+	c.ProviderClient = new(golangsdk.ProviderClient)
+
+	url := fmt.Sprintf("%s/route", th.Endpoint())
+	resp, err := c.WithHeaders(map[string]string{"X-Trans-Id": "tx123"}).Get(url, nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "header", resp.Request.Header.Get("custom"))
+	th.AssertEquals(t, "tx123", resp.Request.Header.Get("X-Trans-Id"))
+
+	// The original client is left unchanged.
+	resp, err = c.Get(url, nil, nil)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", resp.Request.Header.Get("X-Trans-Id"))
+}
+
+func TestDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var methods []string
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	c := new(golangsdk.ServiceClient)
+	c.MoreHeaders = map[string]string{
+		"custom": "header",
+	}
+	c.ProviderClient = new(golangsdk.ProviderClient)
+	c.DryRun = true
+
+	url := fmt.Sprintf("%s/route", th.Endpoint())
+	_, err := c.Get(url, nil, nil)
+	th.AssertNoErr(t, err)
+
+	_, err = c.Put(url, map[string]interface{}{"name": "web"}, nil, nil)
+	dryRun, ok := err.(golangsdk.ErrDryRun)
+	if !ok {
+		t.Fatalf("expected ErrDryRun, got %#v", err)
+	}
+	th.AssertEquals(t, "PUT", dryRun.Method)
+	th.AssertEquals(t, url, dryRun.URL)
+	th.AssertEquals(t, "header", dryRun.Header.Get("custom"))
+	th.AssertEquals(t, "application/json", dryRun.Header.Get("Content-Type"))
+	th.AssertJSONEquals(t, `{"name": "web"}`, json.RawMessage(dryRun.Body))
+
+	_, err = c.Delete(url, nil)
+	dryRun, ok = err.(golangsdk.ErrDryRun)
+	if !ok {
+		t.Fatalf("expected ErrDryRun, got %#v", err)
+	}
+	th.AssertEquals(t, "DELETE", dryRun.Method)
+	th.AssertEquals(t, 0, len(dryRun.Body))
+
+	th.CheckDeepEquals(t, []string{"GET"}, methods)
+}
+
+func TestRateLimiterErrorOnLimit(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	limiter := golangsdk.NewRateLimiter(0.001, 2)
+	limiter.ErrorOnLimit = true
+	limiter.MethodWeights = map[string]float64{"HEAD": 0}
+
+	c := new(golangsdk.ServiceClient)
+	c.ProviderClient = new(golangsdk.ProviderClient)
+	c.RateLimiter = limiter
+
+	url := fmt.Sprintf("%s/route", th.Endpoint())
+	for i := 0; i < 2; i++ {
+		_, err := c.Get(url, nil, nil)
+		th.AssertNoErr(t, err)
+	}
+
+	_, err := c.Get(url, nil, nil)
+	if _, ok := err.(golangsdk.ErrRateLimited); !ok {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+
+	_, err = c.Head(url, &golangsdk.RequestOpts{OkCodes: []int{200}})
+	th.AssertNoErr(t, err)
+}
+
+func TestRateLimiterBlocking(t *testing.T) {
+	limiter := golangsdk.NewRateLimiter(50, 1)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		th.AssertNoErr(t, limiter.Wait(context.Background(), "GET"))
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Fatalf("expected requests to be throttled, took %s", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter.Rate = 0.001
+	th.AssertEquals(t, context.Canceled, limiter.Wait(ctx, "GET"))
+}
+
+func TestResponseCache(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	gets := 0
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			gets++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"flavors": [{"id": "%d"}]}`, gets)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	c := &golangsdk.ServiceClient{
+		ProviderClient: new(golangsdk.ProviderClient),
+		Endpoint:       th.Endpoint(),
+		Cache:          golangsdk.NewMemoryResponseCache(),
+		CacheTTL:       time.Minute,
+	}
+
+	get := func() string {
+		var s struct {
+			Flavors []struct {
+				ID string `json:"id"`
+			} `json:"flavors"`
+		}
+		_, err := c.Get(c.ServiceURL("flavors"), &s, nil)
+		th.AssertNoErr(t, err)
+		return s.Flavors[0].ID
+	}
+
+	th.CheckEquals(t, "1", get())
+	th.CheckEquals(t, "1", get())
+	th.CheckEquals(t, 1, gets)
+
+	// A mutation invalidates the cached responses.
+	_, err := c.Post(c.ServiceURL("flavors"), map[string]string{}, nil, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2", get())
+	th.CheckEquals(t, 2, gets)
+
+	c.InvalidateCache()
+	th.CheckEquals(t, "3", get())
+}
@@ -0,0 +1,172 @@
+package testing
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/huaweicloud/golangsdk"
+	th "github.com/huaweicloud/golangsdk/testhelper"
+)
+
+func TestNewTransportWithCACert(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	// Without the CA, the self-signed certificate is rejected.
+	transport, err := golangsdk.NewTransport(golangsdk.TransportOpts{})
+	th.AssertNoErr(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	if err == nil {
+		t.Fatal("expected a certificate error")
+	}
+
+	transport, err = golangsdk.NewTransport(golangsdk.TransportOpts{CACert: caCert})
+	th.AssertNoErr(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.CheckEquals(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestNewTransportInvalidCACert(t *testing.T) {
+	_, err := golangsdk.NewTransport(golangsdk.TransportOpts{CACert: []byte("not a certificate")})
+	if err == nil {
+		t.Fatal("expected an error for an invalid CA certificate")
+	}
+
+	_, err = golangsdk.NewTransport(golangsdk.TransportOpts{CACertFile: "/nonexistent/ca.pem"})
+	if err == nil {
+		t.Fatal("expected an error for a missing CA certificate file")
+	}
+}
+
+// newConnCountingServer starts a server counting the connections opened to it.
+func newConnCountingServer(connections *int64) *httptest.Server {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	ts.Config.ConnState = func(_ net.Conn, s http.ConnState) {
+		if s == http.StateNew {
+			atomic.AddInt64(connections, 1)
+		}
+	}
+	ts.Start()
+	return ts
+}
+
+// fetchConcurrently sends n concurrent requests, like the page fetches of
+// several listings running at once.
+func fetchConcurrently(p *golangsdk.ProviderClient, url string, n int) error {
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := p.Request("GET", url, &golangsdk.RequestOpts{})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestSharedTransport(t *testing.T) {
+	var connections int64
+	ts := newConnCountingServer(&connections)
+	defer ts.Close()
+
+	// Clients with the same settings share their connections. The timeout
+	// keeps them apart from the transport used by the other tests.
+	for i := 0; i < 3; i++ {
+		p := &golangsdk.ProviderClient{IdleConnTimeout: 42 * time.Second}
+		_, err := p.Request("GET", ts.URL, &golangsdk.RequestOpts{})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, int64(1), atomic.LoadInt64(&connections))
+
+	// Different settings use another transport.
+	p := &golangsdk.ProviderClient{IdleConnTimeout: 43 * time.Second}
+	_, err := p.Request("GET", ts.URL, &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int64(2), atomic.LoadInt64(&connections))
+}
+
+// countingTransport counts the requests going through it.
+type countingTransport struct {
+	http.RoundTripper
+	requests int64
+}
+
+func (t *countingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	atomic.AddInt64(&t.requests, 1)
+	return t.RoundTripper.RoundTrip(r)
+}
+
+func TestDefaultTransportWithoutSettings(t *testing.T) {
+	ts := newConnCountingServer(new(int64))
+	defer ts.Close()
+
+	defaultTransport := http.DefaultTransport
+	counting := &countingTransport{RoundTripper: defaultTransport}
+	http.DefaultTransport = counting
+	defer func() { http.DefaultTransport = defaultTransport }()
+
+	// Without any transport setting, the client keeps http.DefaultTransport.
+	p := &golangsdk.ProviderClient{}
+	_, err := p.Request("GET", ts.URL, &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int64(1), atomic.LoadInt64(&counting.requests))
+
+	// A setting switches it to a shared transport.
+	p = &golangsdk.ProviderClient{MaxIdleConnsPerHost: golangsdk.DefaultMaxIdleConnsPerHost}
+	_, err = p.Request("GET", ts.URL, &golangsdk.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int64(1), atomic.LoadInt64(&counting.requests))
+}
+
+func benchmarkConnectionChurn(b *testing.B, p *golangsdk.ProviderClient) {
+	var connections int64
+	ts := newConnCountingServer(&connections)
+	defer ts.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := fetchConcurrently(p, ts.URL, 16); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(atomic.LoadInt64(&connections))/float64(b.N), "conns/op")
+}
+
+// BenchmarkConnectionChurnDefaultTransport sends bursts of concurrent
+// requests with http.DefaultTransport, which only keeps two idle connections
+// per host.
+func BenchmarkConnectionChurnDefaultTransport(b *testing.B) {
+	benchmarkConnectionChurn(b, &golangsdk.ProviderClient{
+		HTTPClient: http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()},
+	})
+}
+
+// BenchmarkConnectionChurnSharedTransport sends the same bursts with the
+// transport shared by the ProviderClients.
+func BenchmarkConnectionChurnSharedTransport(b *testing.B) {
+	benchmarkConnectionChurn(b, &golangsdk.ProviderClient{MaxIdleConnsPerHost: golangsdk.DefaultMaxIdleConnsPerHost})
+}
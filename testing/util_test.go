@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"errors"
 	"os"
 	"path/filepath"
@@ -53,6 +54,67 @@ func TestWaitForPredicateExceed(t *testing.T) {
 	th.AssertEquals(t, "A timeout occurred", err.Error())
 }
 
+func TestBackoffDelay(t *testing.T) {
+	b := golangsdk.Backoff{Initial: time.Second, Max: 5 * time.Second}
+	th.AssertEquals(t, time.Second, b.Delay(1))
+	th.AssertEquals(t, 2*time.Second, b.Delay(2))
+	th.AssertEquals(t, 4*time.Second, b.Delay(3))
+	th.AssertEquals(t, 5*time.Second, b.Delay(4))
+
+	b.Multiplier = 1
+	th.AssertEquals(t, time.Second, b.Delay(10))
+
+	b.Jitter = 0.5
+	for attempt := 1; attempt < 10; attempt++ {
+		if d := b.Delay(attempt); d < 500*time.Millisecond || d > time.Second {
+			t.Errorf("Delay %s out of the jitter range", d)
+		}
+	}
+}
+
+func TestWaitForBackoff(t *testing.T) {
+	statuses := []string{"PENDING_UPDATE", "PENDING_UPDATE", "ACTIVE"}
+	var events []golangsdk.WaitEvent
+
+	err := golangsdk.WaitForBackoff(context.Background(), golangsdk.Backoff{Initial: time.Millisecond},
+		func() (bool, string, error) {
+			status := statuses[0]
+			statuses = statuses[1:]
+			return status == "ACTIVE", status, nil
+		},
+		func(e golangsdk.WaitEvent) {
+			events = append(events, e)
+		})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 3, len(events))
+	th.AssertEquals(t, 1, events[0].Attempt)
+	th.AssertEquals(t, "PENDING_UPDATE", events[0].Status)
+	th.AssertEquals(t, false, events[0].Done)
+	th.AssertEquals(t, 3, events[2].Attempt)
+	th.AssertEquals(t, "ACTIVE", events[2].Status)
+	th.AssertEquals(t, true, events[2].Done)
+	if events[2].Elapsed < 3*time.Millisecond {
+		t.Errorf("Expected at least 3ms elapsed, got %s", events[2].Elapsed)
+	}
+}
+
+func TestWaitForBackoffContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := golangsdk.WaitForBackoff(ctx, golangsdk.Backoff{Initial: time.Millisecond}, func() (bool, string, error) {
+		return false, "PENDING_CREATE", nil
+	}, nil)
+	th.AssertEquals(t, context.DeadlineExceeded, err)
+
+	failure := errors.New("failed")
+	err = golangsdk.WaitForBackoff(context.Background(), golangsdk.Backoff{}, func() (bool, string, error) {
+		return false, "", failure
+	}, nil)
+	th.AssertEquals(t, failure, err)
+}
+
 func TestNormalizeURL(t *testing.T) {
 	urls := []string{
 		"NoSlashAtEnd",
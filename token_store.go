@@ -0,0 +1,42 @@
+package golangsdk
+
+import "sync"
+
+// TokenStore is the interface used by a ProviderClient to persist its auth
+// token. It allows long-running applications to share a token between several
+// ProviderClients, or to keep it in an external cache (a file, a secret store,
+// etc.) so that it survives restarts.
+type TokenStore interface {
+	// LoadToken returns the stored token, or an empty string if there is none.
+	LoadToken() (string, error)
+
+	// SaveToken stores the given token, replacing the previous one.
+	SaveToken(token string) error
+}
+
+// MemoryTokenStore is a TokenStore which keeps the token in memory. It is safe
+// for concurrent use and can be shared between several ProviderClients.
+type MemoryTokenStore struct {
+	mut   sync.RWMutex
+	token string
+}
+
+// NewMemoryTokenStore returns an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return new(MemoryTokenStore)
+}
+
+// LoadToken implements TokenStore.
+func (s *MemoryTokenStore) LoadToken() (string, error) {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.token, nil
+}
+
+// SaveToken implements TokenStore.
+func (s *MemoryTokenStore) SaveToken(token string) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.token = token
+	return nil
+}
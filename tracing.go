@@ -0,0 +1,55 @@
+package golangsdk
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer integrates a distributed tracing system, such as OpenTelemetry, with
+// a ProviderClient. The SDK only depends on this interface; an adapter for a
+// given tracing library is provided by the application.
+//
+// For every request, the ProviderClient starts a span from its Context,
+// injects the propagation headers of that span into the request, and ends the
+// span once the request has completed. To make the spans children of an
+// application span, set the ProviderClient's Context to a context carrying
+// that span.
+type Tracer interface {
+	// StartSpan starts a span for the request described by event, as a child
+	// of the span carried by ctx if any. It returns a context carrying the new
+	// span.
+	StartSpan(ctx context.Context, event RequestEvent) (context.Context, Span)
+
+	// Inject writes the propagation headers (e.g. traceparent) of the span
+	// carried by ctx into header.
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// End ends the span. StatusCode, Duration and Err of event are set and
+	// may be recorded as span attributes.
+	End(event RequestEvent)
+}
+
+// startSpan starts the span of a request and adds its propagation headers to
+// the request options.
+func (client *ProviderClient) startSpan(ctx context.Context, event RequestEvent, options *RequestOpts) Span {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, span := client.Tracer.StartSpan(ctx, event)
+
+	header := make(http.Header)
+	client.Tracer.Inject(ctx, header)
+	if len(header) > 0 {
+		if options.MoreHeaders == nil {
+			options.MoreHeaders = make(map[string]string, len(header))
+		}
+		for k := range header {
+			options.MoreHeaders[k] = header.Get(k)
+		}
+	}
+	return span
+}
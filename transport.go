@@ -0,0 +1,190 @@
+package golangsdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// DefaultMaxIdleConnsPerHost is the number of idle connections kept per host by the transports
+// shared by the ProviderClients. It is large enough for the concurrent requests of a client to
+// reuse their connections, instead of closing all but two of them like http.DefaultTransport.
+const DefaultMaxIdleConnsPerHost = 32
+
+// TransportOpts configures the http.Transport built by NewTransport. It
+// covers the settings usually needed to reach endpoints behind a corporate
+// proxy or with self-signed certificates. The zero value gives a transport
+// equivalent to http.DefaultTransport.
+type TransportOpts struct {
+	// Proxy returns the proxy to use for a request. It defaults to
+	// http.ProxyFromEnvironment.
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// CACertFile is a PEM bundle of the certificate authorities used to
+	// verify the servers, instead of the system pool. CACert holds the same
+	// content in memory; both may be given.
+	CACertFile string
+	CACert     []byte
+
+	// ClientCertFile and ClientKeyFile are the PEM encoded certificate and
+	// key presented to servers which require client authentication.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// InsecureSkipVerify disables the verification of the server
+	// certificates. It should only be used for testing.
+	InsecureSkipVerify bool
+
+	// MaxIdleConnsPerHost is the maximum number of idle keep-alive
+	// connections kept per host. Zero means http.DefaultMaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is how long an idle keep-alive connection is kept.
+	// It defaults to 90 seconds.
+	IdleConnTimeout time.Duration
+
+	// DisableKeepAlives disables the reuse of connections between requests.
+	DisableKeepAlives bool
+
+	// ConnectTimeout limits the time spent establishing a connection,
+	// including the TLS handshake. It defaults to 30 seconds for the
+	// connection and 10 seconds for the handshake.
+	ConnectTimeout time.Duration
+
+	// DisableHTTP2 restricts the transport to HTTP/1.1.
+	DisableHTTP2 bool
+}
+
+// NewTransport returns an http.Transport configured with opts. It can be set
+// as the Transport of a ProviderClient's HTTPClient, which is used by every
+// ServiceClient built from it.
+func NewTransport(opts TransportOpts) (*http.Transport, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	caCert := opts.CACert
+	if opts.CACertFile != "" {
+		content, err := ioutil.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading CA certificate %s: %s", opts.CACertFile, err)
+		}
+		caCert = append(caCert, '\n')
+		caCert = append(caCert, content...)
+	}
+	if len(caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("No valid CA certificate found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	proxy := opts.Proxy
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+
+	idleConnTimeout := opts.IdleConnTimeout
+	if idleConnTimeout == 0 {
+		idleConnTimeout = 90 * time.Second
+	}
+
+	dialTimeout, handshakeTimeout := 30*time.Second, 10*time.Second
+	if opts.ConnectTimeout > 0 {
+		dialTimeout, handshakeTimeout = opts.ConnectTimeout, opts.ConnectTimeout
+	}
+
+	transport := &http.Transport{
+		Proxy:             proxy,
+		ForceAttemptHTTP2: !opts.DisableHTTP2,
+		DialContext: (&net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   handshakeTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   opts.MaxIdleConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		DisableKeepAlives:     opts.DisableKeepAlives,
+	}
+	if opts.DisableHTTP2 {
+		// A non-nil empty map prevents the upgrade to HTTP/2.
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport, nil
+}
+
+// sharedTransportKey holds the settings of a shared transport.
+type sharedTransportKey struct {
+	connectTimeout      time.Duration
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	disableHTTP2        bool
+}
+
+var (
+	sharedTransportsMut sync.Mutex
+	sharedTransports    = make(map[sharedTransportKey]*http.Transport)
+)
+
+// httpClient returns the HTTP client of the requests. If HTTPClient has no transport and one of
+// the transport settings of the client is set, it uses the transport shared by the clients with
+// the same settings. Otherwise, the requests go through http.DefaultTransport as before.
+func (client *ProviderClient) httpClient() *http.Client {
+	if client.HTTPClient.Transport != nil {
+		return &client.HTTPClient
+	}
+	if client.ConnectTimeout == 0 && client.MaxIdleConnsPerHost == 0 &&
+		client.IdleConnTimeout == 0 && !client.DisableHTTP2 {
+		return &client.HTTPClient
+	}
+
+	key := sharedTransportKey{
+		connectTimeout:      client.ConnectTimeout,
+		maxIdleConnsPerHost: client.MaxIdleConnsPerHost,
+		idleConnTimeout:     client.IdleConnTimeout,
+		disableHTTP2:        client.DisableHTTP2,
+	}
+	if key.maxIdleConnsPerHost == 0 {
+		key.maxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
+
+	c := client.HTTPClient
+	c.Transport = sharedTransport(key)
+	return &c
+}
+
+func sharedTransport(key sharedTransportKey) *http.Transport {
+	sharedTransportsMut.Lock()
+	defer sharedTransportsMut.Unlock()
+
+	if t, ok := sharedTransports[key]; ok {
+		return t
+	}
+	// The options use the system certificates, which can't fail.
+	t, _ := NewTransport(TransportOpts{
+		ConnectTimeout:      key.connectTimeout,
+		MaxIdleConnsPerHost: key.maxIdleConnsPerHost,
+		IdleConnTimeout:     key.idleConnTimeout,
+		DisableHTTP2:        key.disableHTTP2,
+	})
+	sharedTransports[key] = t
+	return t
+}
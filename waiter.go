@@ -0,0 +1,108 @@
+package golangsdk
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff is the policy of WaitForBackoff: the first poll is done at once, and
+// the delay before each next poll starts at Initial and is multiplied by
+// Multiplier after each poll, up to Max. The zero value polls after 1s, 2s,
+// 4s, and so on up to a minute between polls.
+type Backoff struct {
+	// Initial is the delay after the first poll. It defaults to a second.
+	Initial time.Duration
+
+	// Max caps the delay between two polls. It defaults to a minute.
+	Max time.Duration
+
+	// Multiplier is the factor applied to the delay after each poll. It
+	// defaults to 2; use 1 to poll at a fixed interval.
+	Multiplier float64
+
+	// Jitter, between 0 and 1, is the fraction of each delay which is
+	// randomized, so that the clients waiting on the same event don't poll
+	// together. A Jitter of 0.2 gives delays between 80% and 100% of the
+	// computed one.
+	Jitter float64
+}
+
+// Delay returns the delay after the given poll, counted from 1.
+func (b Backoff) Delay(attempt int) time.Duration {
+	initial := b.Initial
+	if initial <= 0 {
+		initial = time.Second
+	}
+	max := b.Max
+	if max <= 0 {
+		max = time.Minute
+	}
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	if b.Jitter > 0 {
+		delay -= delay * math.Min(b.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+// WaitEvent describes a poll of WaitForBackoff to its observer.
+type WaitEvent struct {
+	// Attempt is the number of the poll, counted from 1.
+	Attempt int
+
+	// Elapsed is the time spent waiting so far.
+	Elapsed time.Duration
+
+	// Status is the status returned by the predicate, e.g. the status of the
+	// resource being waited for.
+	Status string
+
+	// Done and Err are the result of the predicate.
+	Done bool
+	Err  error
+}
+
+// WaitObserver is called by WaitForBackoff after every poll, e.g. to report
+// the progress of a long operation.
+type WaitObserver func(event WaitEvent)
+
+// WaitForBackoff polls a predicate until it is done, fails, or ctx is done,
+// waiting between polls as set by backoff. The predicate returns whether the
+// wait is over, along with a status which is passed to the observer, if not
+// nil. It returns the error of the predicate, or the error of ctx.
+//
+// Unlike WaitFor, the predicate is called in the current goroutine: it should
+// bound the time it takes, e.g. with the Timeout of the ProviderClient.
+func WaitForBackoff(ctx context.Context, backoff Backoff, predicate func() (bool, string, error), observer WaitObserver) error {
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		done, status, err := predicate()
+		if observer != nil {
+			observer(WaitEvent{
+				Attempt: attempt,
+				Elapsed: time.Since(start),
+				Status:  status,
+				Done:    done,
+				Err:     err,
+			})
+		}
+		if done || err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff.Delay(attempt)):
+		}
+	}
+}